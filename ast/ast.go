@@ -31,15 +31,17 @@ type File struct {
 type Node interface {
 	fmt.Stringer
 	// Start returns the start position of the node within the input stream.
-	Start() int
+	Start() token.Pos
 }
 
 // A Decl node represents a declaration, and has one of the following underlying
 // types.
 //
+//    *BadDecl
 //    *FuncDecl
 //    *VarDecl
 //    *TypeDef
+//    *EnumConst
 //
 // Pseudo-code representation of a declaration.
 //
@@ -64,6 +66,10 @@ type Decl interface {
 	// Underlying type for type definitions.
 	//
 	//    Type
+	//
+	// Underlying type for enumeration constants.
+	//
+	//    Expr
 	Value() Node
 	// isDecl ensures that only declaration nodes can be assigned to the Decl
 	// interface.
@@ -72,6 +78,15 @@ type Decl interface {
 
 // Declaration nodes.
 type (
+	// A BadDecl node is a placeholder for a declaration containing syntax errors
+	// for which a correct declaration node cannot be created.
+	BadDecl struct {
+		// Start position of the bad declaration.
+		From token.Pos
+		// Position of the token which ended the bad declaration; i.e. `;` or `}`.
+		To token.Pos
+	}
+
 	// A FuncDecl node represents a function declaration.
 	//
 	// Examples.
@@ -94,6 +109,7 @@ type (
 	//
 	//    int x;
 	//    char buf[128];
+	//    int a[] = {1, 2, 3};
 	VarDecl struct {
 		// Variable type.
 		VarType Type
@@ -111,7 +127,7 @@ type (
 	//    typedef int foo;
 	TypeDef struct {
 		// Position of `typedef` keyword.
-		Typedef int
+		Typedef token.Pos
 		// Underlying type of type definition.
 		DeclType Type
 		// Type name.
@@ -119,16 +135,45 @@ type (
 		// Underlying type of type definition.
 		Val types.Type
 	}
+
+	// An EnumConst node represents an enumeration constant, as declared by the
+	// enumerator list of an enumeration type.
+	//
+	// Examples.
+	//
+	//    RED
+	//    GREEN = 5
+	EnumConst struct {
+		// Constant name.
+		ConstName *Ident
+		// Position of assignment operator `=`; or NoPos if the value is implicit.
+		Assign token.Pos
+		// Constant value expression; or nil if implicit.
+		ValExpr Expr
+		// Constant value. The value is evaluated during semantic analysis; the
+		// implicit value of an enumeration constant is one greater than the
+		// value of the preceding enumeration constant, or 0 if first.
+		Val int
+	}
 )
 
 // A Stmt node represents a statement, and has one of the following underlying
 // types.
 //
+//    *BadStmt
 //    *BlockStmt
+//    *BreakStmt
+//    *CaseStmt
+//    *ContinueStmt
+//    *DoWhileStmt
 //    *EmptyStmt
 //    *ExprStmt
+//    *ForStmt
+//    *GotoStmt
 //    *IfStmt
+//    *LabeledStmt
 //    *ReturnStmt
+//    *SwitchStmt
 //    *WhileStmt
 type Stmt interface {
 	Node
@@ -139,6 +184,15 @@ type Stmt interface {
 
 // Statement nodes.
 type (
+	// A BadStmt node is a placeholder for a statement containing syntax errors
+	// for which a correct statement node cannot be created.
+	BadStmt struct {
+		// Start position of the bad statement.
+		From token.Pos
+		// Position of the token which ended the bad statement; i.e. `;` or `}`.
+		To token.Pos
+	}
+
 	// A BlockStmt node represents a block statement.
 	//
 	// Examples.
@@ -147,11 +201,63 @@ type (
 	//    { int x; x = 42; }
 	BlockStmt struct {
 		// Position of left-brace `{`.
-		Lbrace int
+		Lbrace token.Pos
 		// List of block items contained within the block.
 		Items []BlockItem
 		// Position of right-brace `}`.
-		Rbrace int
+		Rbrace token.Pos
+	}
+
+	// A BreakStmt node represents a break statement.
+	//
+	// Examples.
+	//
+	//    break;
+	BreakStmt struct {
+		// Position of `break` keyword.
+		Break token.Pos
+	}
+
+	// A CaseStmt node represents a case or default labeled statement of a
+	// switch statement.
+	//
+	// Examples.
+	//
+	//    case 1: x = 2;
+	//    default: return 0;
+	CaseStmt struct {
+		// Position of `case` or `default` keyword.
+		Case token.Pos
+		// Case label value; or nil if default label.
+		Val Expr
+		// Position of colon `:`.
+		Colon token.Pos
+		// Labeled statement.
+		Body Stmt
+	}
+
+	// A ContinueStmt node represents a continue statement.
+	//
+	// Examples.
+	//
+	//    continue;
+	ContinueStmt struct {
+		// Position of `continue` keyword.
+		Continue token.Pos
+	}
+
+	// A DoWhileStmt node represents a do-while statement.
+	//
+	// Examples.
+	//
+	//    do { i++; } while (i < 10);
+	DoWhileStmt struct {
+		// Position of `do` keyword.
+		Do token.Pos
+		// Loop body.
+		Body Stmt
+		// Condition.
+		Cond Expr
 	}
 
 	// An EmptyStmt node represents an empty statement (i.e. ";").
@@ -161,7 +267,7 @@ type (
 	//    ;
 	EmptyStmt struct {
 		// Position of semicolon `;`.
-		Semicolon int
+		Semicolon token.Pos
 	}
 
 	// An ExprStmt node represents a stand-alone expression in a statement list.
@@ -175,6 +281,37 @@ type (
 		X Expr
 	}
 
+	// A ForStmt node represents a for statement.
+	//
+	// Examples.
+	//
+	//    for (i = 0; i < 10; i = i + 1) { x = x + i; }
+	//    for (;;) {}
+	ForStmt struct {
+		// Position of `for` keyword.
+		For token.Pos
+		// Initialization expression; or nil.
+		Init Expr
+		// Condition; or nil.
+		Cond Expr
+		// Post iteration expression; or nil.
+		Post Expr
+		// Loop body.
+		Body Stmt
+	}
+
+	// A GotoStmt node represents a goto statement.
+	//
+	// Examples.
+	//
+	//    goto loop;
+	GotoStmt struct {
+		// Position of `goto` keyword.
+		Goto token.Pos
+		// Target label.
+		Label *Ident
+	}
+
 	// An IfStmt node represents an if statement.
 	//
 	// Examples.
@@ -183,7 +320,7 @@ type (
 	//    if (i < max) { i; } else { max; }
 	IfStmt struct {
 		// Position of `if` keyword.
-		If int
+		If token.Pos
 		// Condition.
 		Cond Expr
 		// True branch.
@@ -192,6 +329,22 @@ type (
 		Else Stmt
 	}
 
+	// A LabeledStmt node represents a labeled statement; the target of goto
+	// statements.
+	//
+	// Examples.
+	//
+	//    loop: x++;
+	//    out: return 0;
+	LabeledStmt struct {
+		// Label.
+		Label *Ident
+		// Position of colon `:`.
+		Colon token.Pos
+		// Labeled statement.
+		Stmt Stmt
+	}
+
 	// A ReturnStmt node represents a return statement.
 	//
 	// Examples.
@@ -200,11 +353,25 @@ type (
 	//    return 42;
 	ReturnStmt struct {
 		// Position of `return` keyword.
-		Return int
+		Return token.Pos
 		// Result expression; or nil if void return.
 		Result Expr
 	}
 
+	// A SwitchStmt node represents a switch statement.
+	//
+	// Examples.
+	//
+	//    switch (x) { case 1: y = 2; break; default: y = 3; }
+	SwitchStmt struct {
+		// Position of `switch` keyword.
+		Switch token.Pos
+		// Controlling expression.
+		Tag Expr
+		// Switch body.
+		Body Stmt
+	}
+
 	// A WhileStmt node represents a while statement.
 	//
 	// Examples.
@@ -212,7 +379,7 @@ type (
 	//    while (i < 10) { i++; }
 	WhileStmt struct {
 		// Position of `while` keyword.
-		While int
+		While token.Pos
 		// Condition.
 		Cond Expr
 		// Loop body.
@@ -238,9 +405,15 @@ type BlockItem interface {
 //    *BasicLit
 //    *BinaryExpr
 //    *CallExpr
+//    *CastExpr
+//    *CondExpr
 //    *Ident
 //    *IndexExpr
+//    *InitList
 //    *ParenExpr
+//    *PostfixExpr
+//    *SelectorExpr
+//    *SizeofExpr
 //    *UnaryExpr
 type Expr interface {
 	Node
@@ -257,15 +430,17 @@ type (
 	//
 	//    42
 	//    'a'
+	//    "foo"
 	BasicLit struct {
 		// Position of basic literal.
-		ValPos int
+		ValPos token.Pos
 		// Basic literal type, one of the following.
 		//
 		//    token.CharLit
 		//    token.IntLit
+		//    token.StringLit
 		Kind token.Kind
-		// Basic literal value; e.g. 123, 'a'.
+		// Basic literal value; e.g. 123, 'a', "foo".
 		Val string
 	}
 
@@ -275,16 +450,23 @@ type (
 	//
 	//    x + y
 	//    x = 42
+	//    x += 2
 	BinaryExpr struct {
 		// First operand.
 		X Expr
 		// Position of operator.
-		OpPos int
+		OpPos token.Pos
 		// Operator, one of the following.
 		//    token.Add      // +
 		//    token.Sub      // -
 		//    token.Mul      // *
 		//    token.Div      // /
+		//    token.Rem      // %
+		//    token.Shl      // <<
+		//    token.Shr      // >>
+		//    token.And      // &
+		//    token.Or       // |
+		//    token.Xor      // ^
 		//    token.Lt       // <
 		//    token.Gt       // >
 		//    token.Le       // <=
@@ -292,7 +474,18 @@ type (
 		//    token.Ne       // !=
 		//    token.Eq       // ==
 		//    token.Land     // &&
-		//    token.Assign   // =
+		//    token.Lor      // ||
+		//    token.Assign    // =
+		//    token.AddAssign // +=
+		//    token.SubAssign // -=
+		//    token.MulAssign // *=
+		//    token.DivAssign // /=
+		//    token.RemAssign // %=
+		//    token.ShlAssign // <<=
+		//    token.ShrAssign // >>=
+		//    token.AndAssign // &=
+		//    token.XorAssign // ^=
+		//    token.OrAssign  // |=
 		Op token.Kind
 		// Second operand.
 		Y Expr
@@ -308,11 +501,47 @@ type (
 		// Function name.
 		Name *Ident
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Function arguments.
 		Args []Expr
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
+	}
+
+	// A CondExpr node represents a conditional expression; Cond ? X : Y.
+	//
+	// Examples.
+	//
+	//    x < y ? x : y
+	//    n ? f(n) : 0
+	CondExpr struct {
+		// Condition.
+		Cond Expr
+		// Position of question mark `?`.
+		Question token.Pos
+		// Result if the condition is non-zero.
+		X Expr
+		// Position of colon `:`.
+		Colon token.Pos
+		// Result if the condition is zero.
+		Y Expr
+	}
+
+	// A CastExpr node represents a cast expression; (Type)X.
+	//
+	// Examples.
+	//
+	//    (char)x
+	//    (struct node*)p
+	CastExpr struct {
+		// Position of left-parenthesis `(`.
+		Lparen token.Pos
+		// Type name of the cast.
+		Type Type
+		// Position of right-parenthesis `)`.
+		Rparen token.Pos
+		// Operand.
+		X Expr
 	}
 
 	// An Ident node represents an identifier.
@@ -323,7 +552,7 @@ type (
 	//    int
 	Ident struct {
 		// Position of identifier.
-		NamePos int
+		NamePos token.Pos
 		// Identifier name.
 		Name string
 		// Corresponding function, variable or type definition. The declaration
@@ -337,25 +566,102 @@ type (
 	// Examples.
 	//
 	//    buf[i]
+	//    m[i][j]
 	IndexExpr struct {
-		// Array name.
-		Name *Ident
+		// Array or pointer expression.
+		X Expr
 		// Position of left-bracket `[`.
-		Lbracket int
+		Lbracket token.Pos
 		// Array index.
 		Index Expr
 		// Position of right-bracket `]`.
-		Rbracket int
+		Rbracket token.Pos
+	}
+
+	// An InitList node represents a brace-enclosed initializer list of an array
+	// or structure variable definition.
+	//
+	// Examples.
+	//
+	//    {1, 2, 3}
+	//    {{1, 2}, {3, 4}}
+	InitList struct {
+		// Position of left-brace `{`.
+		Lbrace token.Pos
+		// Initializer list elements.
+		Elems []Expr
+		// Position of right-brace `}`.
+		Rbrace token.Pos
 	}
 
 	// A ParenExpr node represents a parenthesised expression.
 	ParenExpr struct {
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Parenthesised expression.
 		X Expr
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
+	}
+
+	// A PostfixExpr node represents a postfix increment or decrement
+	// expression; X op.
+	//
+	// Examples.
+	//
+	//    i++
+	//    buf[i]--
+	PostfixExpr struct {
+		// Operand.
+		X Expr
+		// Position of postfix operator.
+		OpPos token.Pos
+		// Operator, one of the following.
+		//    token.Inc // ++
+		//    token.Dec // --
+		Op token.Kind
+	}
+
+	// A SelectorExpr node represents a structure member access expression; X.Sel
+	// or X->Sel.
+	//
+	// Examples.
+	//
+	//    p.x
+	//    node->next
+	SelectorExpr struct {
+		// Structure or pointer to structure operand.
+		X Expr
+		// Position of member access operator.
+		OpPos token.Pos
+		// Operator, one of the following.
+		//    token.Period // .
+		//    token.Arrow  // ->
+		Op token.Kind
+		// Field name.
+		Sel *Ident
+	}
+
+	// A SizeofExpr node represents a sizeof expression of either an expression
+	// or a parenthesized type name.
+	//
+	// Examples.
+	//
+	//    sizeof x
+	//    sizeof(int*)
+	SizeofExpr struct {
+		// Position of `sizeof` keyword.
+		Sizeof token.Pos
+		// Operand expression; or nil if type operand.
+		X Expr
+		// Position of left-parenthesis `(` of type operand; or NoPos if
+		// expression operand.
+		Lparen token.Pos
+		// Operand type; or nil if expression operand.
+		Type Type
+		// Position of right-parenthesis `)` of type operand; or NoPos if
+		// expression operand.
+		Rparen token.Pos
 	}
 
 	// An UnaryExpr node represents an unary expression; op X.
@@ -364,12 +670,19 @@ type (
 	//
 	//    -42
 	//    !(x == 3 || x == 10)
+	//    ++i
+	//    *p
 	UnaryExpr struct {
 		// Position of unary operator.
-		OpPos int
+		OpPos token.Pos
 		// Operator, one of the following.
 		//    token.Sub   // -
 		//    token.Not   // !
+		//    token.Tilde // ~
+		//    token.Inc   // ++
+		//    token.Dec   // --
+		//    token.Mul   // *
+		//    token.And   // &
 		Op token.Kind
 		// Operand.
 		X Expr
@@ -382,6 +695,9 @@ type (
 //    *ArrayType
 //    *FuncType
 //    *Ident
+//    *PointerType
+//    *StructType
+//    *EnumType
 type Type interface {
 	Node
 	// isType ensures that only type nodes can be assigned to the Type interface.
@@ -396,15 +712,21 @@ type (
 	//
 	//    int[]
 	//    char[128]
+	//    char[N*2]
 	ArrayType struct {
 		// Element type.
 		Elem Type
 		// Position of left-bracket `[`.
-		Lbracket int
-		// Array length.
+		Lbracket token.Pos
+		// Array length expression; or nil if unsized.
+		LenExpr Expr
+		// Array length; or 0 if unsized. The length of integer literal length
+		// expressions is known after parsing, and the length of other constant
+		// expressions is evaluated during semantic analysis. The length of an
+		// unsized array with an initializer is inferred from the initializer.
 		Len int
 		// Position of right-bracket `]`.
-		Rbracket int
+		Rbracket token.Pos
 	}
 
 	// A FuncType node represents a function signature.
@@ -413,23 +735,118 @@ type (
 	//
 	//    int(void)
 	//    int(int a, int b)
+	//    int(char fmt[], ...)
 	FuncType struct {
 		// Return type.
 		Result Type
 		// Position of left-parenthesis `(`.
-		Lparen int
+		Lparen token.Pos
 		// Function parameters.
 		Params []*VarDecl
+		// Position of ellipsis `...`; or NoPos if the function takes a fixed
+		// number of arguments.
+		Ellipsis token.Pos
 		// Position of right-parenthesis `)`.
-		Rparen int
+		Rparen token.Pos
+	}
+
+	// A PointerType node represents a pointer type.
+	//
+	// Examples.
+	//
+	//    int*
+	//    char**
+	PointerType struct {
+		// Element type.
+		Elem Type
+		// Position of asterisk `*`.
+		Star token.Pos
+	}
+
+	// A StructType node represents a structure type.
+	//
+	// Examples.
+	//
+	//    struct point
+	//    struct point {int x; int y;}
+	//    struct {int x; int y;}
+	StructType struct {
+		// Position of `struct` keyword.
+		Struct token.Pos
+		// Structure tag; or nil if anonymous. The tag refers to the type
+		// definition of the structure type, as added during the semantic
+		// analysis phase.
+		Tag *Ident
+		// Position of left-brace `{`; or NoPos if the structure type refers to a
+		// tagged structure type defined elsewhere.
+		Lbrace token.Pos
+		// Structure fields.
+		Fields []*VarDecl
+		// Position of right-brace `}`; or NoPos if the structure type refers to a
+		// tagged structure type defined elsewhere.
+		Rbrace token.Pos
+	}
+
+	// An EnumType node represents an enumeration type.
+	//
+	// Examples.
+	//
+	//    enum color
+	//    enum color {RED, GREEN = 5, BLUE}
+	//    enum {N = 10}
+	EnumType struct {
+		// Position of `enum` keyword.
+		Enum token.Pos
+		// Enumeration tag; or nil if anonymous. The tag refers to the type
+		// definition of the enumeration type, as added during the semantic
+		// analysis phase.
+		Tag *Ident
+		// Position of left-brace `{`; or NoPos if the enumeration type refers to
+		// a tagged enumeration type defined elsewhere.
+		Lbrace token.Pos
+		// Enumeration constants.
+		Consts []*EnumConst
+		// Position of right-brace `}`; or NoPos if the enumeration type refers to
+		// a tagged enumeration type defined elsewhere.
+		Rbrace token.Pos
 	}
 )
 
 func (n *ArrayType) String() string {
-	if n.Len > 0 {
-		return fmt.Sprintf("%v[%d]", n.Elem, n.Len)
+	elem, dims := n.dims()
+	return fmt.Sprintf("%v%v", elem, dims)
+}
+
+// dims returns the innermost element type of the array type, and the string
+// representation of its dimensions in declaration order; e.g. "[2][3]" for an
+// array of 2 arrays of 3 elements.
+func (n *ArrayType) dims() (Type, string) {
+	buf := new(bytes.Buffer)
+	var elem Type = n
+	for {
+		array, ok := elem.(*ArrayType)
+		if !ok {
+			break
+		}
+		switch {
+		case array.LenExpr != nil:
+			fmt.Fprintf(buf, "[%v]", array.LenExpr)
+		case array.Len > 0:
+			fmt.Fprintf(buf, "[%d]", array.Len)
+		default:
+			buf.WriteString("[]")
+		}
+		elem = array.Elem
 	}
-	return fmt.Sprintf("%v[]", n.Elem)
+	return elem, buf.String()
+}
+
+func (n *BadDecl) String() string {
+	return "<bad declaration>"
+}
+
+func (n *BadStmt) String() string {
+	return "<bad statement>"
 }
 
 func (n *BasicLit) String() string {
@@ -450,6 +867,17 @@ func (n *BlockStmt) String() string {
 	return buf.String()
 }
 
+func (n *BreakStmt) String() string {
+	return "break;"
+}
+
+func (n *CaseStmt) String() string {
+	if n.Val != nil {
+		return fmt.Sprintf("case %v: %v", n.Val, n.Body)
+	}
+	return fmt.Sprintf("default: %v", n.Body)
+}
+
 func (n *CallExpr) String() string {
 	buf := new(bytes.Buffer)
 	buf.WriteString(n.Name.String())
@@ -464,10 +892,44 @@ func (n *CallExpr) String() string {
 	return buf.String()
 }
 
+func (n *ContinueStmt) String() string {
+	return "continue;"
+}
+
+func (n *DoWhileStmt) String() string {
+	return fmt.Sprintf("do %v while (%v);", n.Body, n.Cond)
+}
+
 func (n *EmptyStmt) String() string {
 	return ";"
 }
 
+func (n *EnumConst) String() string {
+	if n.ValExpr != nil {
+		return fmt.Sprintf("%v = %v", n.ConstName, n.ValExpr)
+	}
+	return n.ConstName.String()
+}
+
+func (n *EnumType) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("enum")
+	if n.Tag != nil {
+		fmt.Fprintf(buf, " %v", n.Tag)
+	}
+	if n.Lbrace.IsValid() {
+		buf.WriteString(" {")
+		for i, c := range n.Consts {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(c.String())
+		}
+		buf.WriteString("}")
+	}
+	return buf.String()
+}
+
 func (n *ExprStmt) String() string {
 	return fmt.Sprintf("%v;", n.X)
 }
@@ -480,6 +942,25 @@ func (n *File) String() string {
 	return buf.String()
 }
 
+func (n *ForStmt) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("for (")
+	if n.Init != nil {
+		buf.WriteString(n.Init.String())
+	}
+	buf.WriteString("; ")
+	if n.Cond != nil {
+		buf.WriteString(n.Cond.String())
+	}
+	buf.WriteString("; ")
+	if n.Post != nil {
+		buf.WriteString(n.Post.String())
+	}
+	buf.WriteString(") ")
+	buf.WriteString(n.Body.String())
+	return buf.String()
+}
+
 func (n *FuncDecl) String() string {
 	buf := new(bytes.Buffer)
 	fmt.Fprintf(buf, "%v %v(", n.FuncType.Result, n.FuncName)
@@ -493,6 +974,9 @@ func (n *FuncDecl) String() string {
 			buf.WriteString(param.VarName.String())
 		}
 	}
+	if n.FuncType.IsVariadic() {
+		buf.WriteString(", ...")
+	}
 	buf.WriteString(")")
 	if n.Body != nil {
 		buf.WriteString(" ")
@@ -511,10 +995,25 @@ func (n *FuncType) String() string {
 		}
 		buf.WriteString(param.VarType.String())
 	}
+	if n.IsVariadic() {
+		buf.WriteString(", ...")
+	}
 	buf.WriteString(")")
 	return buf.String()
 }
 
+func (n *GotoStmt) String() string {
+	return fmt.Sprintf("goto %v;", n.Label)
+}
+
+func (n *CastExpr) String() string {
+	return fmt.Sprintf("(%v)%v", n.Type, n.X)
+}
+
+func (n *CondExpr) String() string {
+	return fmt.Sprintf("%v ? %v : %v", n.Cond, n.X, n.Y)
+}
+
 func (n *Ident) String() string {
 	return n.Name
 }
@@ -527,13 +1026,42 @@ func (n *IfStmt) String() string {
 }
 
 func (n *IndexExpr) String() string {
-	return fmt.Sprintf("%v[%v]", n.Name, n.Index)
+	return fmt.Sprintf("%v[%v]", n.X, n.Index)
+}
+
+func (n *InitList) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, elem := range n.Elems {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(elem.String())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func (n *LabeledStmt) String() string {
+	return fmt.Sprintf("%v: %v", n.Label, n.Stmt)
 }
 
 func (n *ParenExpr) String() string {
 	return fmt.Sprintf("(%v)", n.X)
 }
 
+func (n *PointerType) String() string {
+	return fmt.Sprintf("%v*", n.Elem)
+}
+
+func (n *PostfixExpr) String() string {
+	return fmt.Sprintf("%v%v", n.X, n.Op)
+}
+
+func (n *SelectorExpr) String() string {
+	return fmt.Sprintf("%v%v%v", n.X, n.Op, n.Sel)
+}
+
 func (n *ReturnStmt) String() string {
 	if n.Result != nil {
 		return fmt.Sprintf("return %v;", n.Result)
@@ -541,6 +1069,36 @@ func (n *ReturnStmt) String() string {
 	return "return;"
 }
 
+func (n *SizeofExpr) String() string {
+	if n.Lparen.IsValid() {
+		return fmt.Sprintf("sizeof(%v)", n.Type)
+	}
+	return fmt.Sprintf("sizeof %v", n.X)
+}
+
+func (n *StructType) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("struct")
+	if n.Tag != nil {
+		fmt.Fprintf(buf, " %v", n.Tag)
+	}
+	if n.Lbrace.IsValid() {
+		buf.WriteString(" {")
+		for i, field := range n.Fields {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(field.String())
+		}
+		buf.WriteString("}")
+	}
+	return buf.String()
+}
+
+func (n *SwitchStmt) String() string {
+	return fmt.Sprintf("switch (%v) %v", n.Tag, n.Body)
+}
+
 func (n *TypeDef) String() string {
 	return fmt.Sprintf("typedef %v %v;", n.DeclType, n.TypeName)
 }
@@ -552,11 +1110,19 @@ func (n *UnaryExpr) String() string {
 func (n *VarDecl) String() string {
 	switch typ := n.VarType.(type) {
 	case *ArrayType:
-		if typ.Len > 0 {
-			return fmt.Sprintf("%v %v[%d];", typ.Elem, n.VarName, typ.Len)
+		elem, dims := typ.dims()
+		if n.Val != nil {
+			return fmt.Sprintf("%v %v%v = %v;", elem, n.VarName, dims, n.Val)
 		}
-		return fmt.Sprintf("%v %v[];", typ.Elem, n.VarName)
+		return fmt.Sprintf("%v %v%v;", elem, n.VarName, dims)
 	default:
+		if n.VarName == nil {
+			// Structure declaration without declarator.
+			return fmt.Sprintf("%v;", typ)
+		}
+		if n.Val != nil {
+			return fmt.Sprintf("%v %v = %v;", typ, n.VarName, n.Val)
+		}
 		return fmt.Sprintf("%v %v;", typ, n.VarName)
 	}
 }
@@ -566,42 +1132,77 @@ func (n *WhileStmt) String() string {
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ArrayType) Start() int {
+func (n *ArrayType) Start() token.Pos {
 	return n.Elem.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BasicLit) Start() int {
+func (n *BadDecl) Start() token.Pos {
+	return n.From
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *BadStmt) Start() token.Pos {
+	return n.From
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *BasicLit) Start() token.Pos {
 	return n.ValPos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BinaryExpr) Start() int {
+func (n *BinaryExpr) Start() token.Pos {
 	return n.X.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *BlockStmt) Start() int {
+func (n *BlockStmt) Start() token.Pos {
 	return n.Lbrace
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *CallExpr) Start() int {
+func (n *BreakStmt) Start() token.Pos {
+	return n.Break
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *CallExpr) Start() token.Pos {
 	return n.Name.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *EmptyStmt) Start() int {
+func (n *ContinueStmt) Start() token.Pos {
+	return n.Continue
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *DoWhileStmt) Start() token.Pos {
+	return n.Do
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *EmptyStmt) Start() token.Pos {
 	return n.Semicolon
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ExprStmt) Start() int {
+func (n *EnumConst) Start() token.Pos {
+	return n.ConstName.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *EnumType) Start() token.Pos {
+	return n.Enum
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *ExprStmt) Start() token.Pos {
 	return n.X.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *File) Start() int {
+func (n *File) Start() token.Pos {
 	if len(n.Decls) > 0 {
 		return n.Decls[0].Start()
 	}
@@ -609,83 +1210,173 @@ func (n *File) Start() int {
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *FuncDecl) Start() int {
+func (n *ForStmt) Start() token.Pos {
+	return n.For
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *FuncDecl) Start() token.Pos {
 	return n.FuncType.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *FuncType) Start() int {
+func (n *FuncType) Start() token.Pos {
 	return n.Result.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *Ident) Start() int {
+func (n *GotoStmt) Start() token.Pos {
+	return n.Goto
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *CaseStmt) Start() token.Pos {
+	return n.Case
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *CastExpr) Start() token.Pos {
+	return n.Lparen
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *CondExpr) Start() token.Pos {
+	return n.Cond.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *Ident) Start() token.Pos {
 	return n.NamePos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *IfStmt) Start() int {
+func (n *IfStmt) Start() token.Pos {
 	return n.If
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *IndexExpr) Start() int {
-	return n.Name.Start()
+func (n *IndexExpr) Start() token.Pos {
+	return n.X.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *InitList) Start() token.Pos {
+	return n.Lbrace
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ParenExpr) Start() int {
+func (n *LabeledStmt) Start() token.Pos {
+	return n.Label.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *ParenExpr) Start() token.Pos {
 	return n.Lparen
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *ReturnStmt) Start() int {
+func (n *PointerType) Start() token.Pos {
+	return n.Elem.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *PostfixExpr) Start() token.Pos {
+	return n.X.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *SelectorExpr) Start() token.Pos {
+	return n.X.Start()
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *ReturnStmt) Start() token.Pos {
 	return n.Return
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *TypeDef) Start() int {
+func (n *SwitchStmt) Start() token.Pos {
+	return n.Switch
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *TypeDef) Start() token.Pos {
 	return n.Typedef
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *UnaryExpr) Start() int {
+func (n *SizeofExpr) Start() token.Pos {
+	return n.Sizeof
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *StructType) Start() token.Pos {
+	return n.Struct
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *UnaryExpr) Start() token.Pos {
 	return n.OpPos
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *VarDecl) Start() int {
+func (n *VarDecl) Start() token.Pos {
 	return n.VarType.Start()
 }
 
 // Start returns the start position of the node within the input stream.
-func (n *WhileStmt) Start() int {
+func (n *WhileStmt) Start() token.Pos {
 	return n.While
 }
 
 // Verify that all nodes implement the Node interface.
 var (
 	_ Node = &ArrayType{}
+	_ Node = &BadDecl{}
+	_ Node = &BadStmt{}
 	_ Node = &BasicLit{}
 	_ Node = &BinaryExpr{}
 	_ Node = &BlockStmt{}
+	_ Node = &BreakStmt{}
 	_ Node = &CallExpr{}
+	_ Node = &CaseStmt{}
+	_ Node = &CastExpr{}
+	_ Node = &CondExpr{}
+	_ Node = &ContinueStmt{}
+	_ Node = &DoWhileStmt{}
 	_ Node = &EmptyStmt{}
+	_ Node = &EnumConst{}
+	_ Node = &EnumType{}
 	_ Node = &ExprStmt{}
 	_ Node = &File{}
+	_ Node = &ForStmt{}
 	_ Node = &FuncDecl{}
 	_ Node = &FuncType{}
+	_ Node = &GotoStmt{}
 	_ Node = &Ident{}
 	_ Node = &IfStmt{}
 	_ Node = &IndexExpr{}
+	_ Node = &InitList{}
+	_ Node = &LabeledStmt{}
 	_ Node = &ParenExpr{}
+	_ Node = &PointerType{}
+	_ Node = &PostfixExpr{}
 	_ Node = &ReturnStmt{}
+	_ Node = &SelectorExpr{}
+	_ Node = &SizeofExpr{}
+	_ Node = &StructType{}
+	_ Node = &SwitchStmt{}
 	_ Node = &TypeDef{}
 	_ Node = &UnaryExpr{}
 	_ Node = &VarDecl{}
 	_ Node = &WhileStmt{}
 )
 
+// Type returns the type of the declared identifier.
+func (n *BadDecl) Type() types.Type {
+	return &types.Basic{Kind: types.Invalid}
+}
+
 // Type returns the type of the declared identifier.
 func (n *FuncDecl) Type() types.Type {
 	// TODO: Consider caching the types.Type.
@@ -709,6 +1400,23 @@ func (n *TypeDef) Type() types.Type {
 	return n.Val
 }
 
+// Type returns the type of the declared identifier.
+func (n *EnumConst) Type() types.Type {
+	// "An identifier declared as an enumeration constant has type int." [C99
+	// draft 6.4.4.3.2]
+	return &types.Basic{Kind: types.Int}
+}
+
+// IsVariadic reports whether the function takes a variable number of arguments.
+func (n *FuncType) IsVariadic() bool {
+	return n.Ellipsis.IsValid()
+}
+
+// Name returns the name of the declared identifier.
+func (n *BadDecl) Name() *Ident {
+	return nil
+}
+
 // Name returns the name of the declared identifier.
 func (n *FuncDecl) Name() *Ident {
 	return n.FuncName
@@ -724,6 +1432,17 @@ func (n *TypeDef) Name() *Ident {
 	return n.TypeName
 }
 
+// Name returns the name of the declared identifier.
+func (n *EnumConst) Name() *Ident {
+	return n.ConstName
+}
+
+// Value returns the initializing value of the defined identifier; which is
+// always nil for bad declarations.
+func (n *BadDecl) Value() Node {
+	return nil
+}
+
 // Value returns the initializing value of the defined identifier; or nil if
 // declaration or tentative definition.
 //
@@ -766,58 +1485,112 @@ func (n *TypeDef) Value() Node {
 	return nil
 }
 
+// Value returns the value expression of the enumeration constant; or nil if
+// implicit.
+//
+// Underlying type for enumeration constants.
+//
+//    Expr
+func (n *EnumConst) Value() Node {
+	// ref: https://golang.org/doc/faq#nil_error
+	if n.ValExpr != nil {
+		return n.ValExpr
+	}
+	return nil
+}
+
 // isDecl ensures that only declaration nodes can be assigned to the Decl
 // interface.
-func (n *FuncDecl) isDecl() {}
-func (n *VarDecl) isDecl()  {}
-func (n *TypeDef) isDecl()  {}
+func (n *BadDecl) isDecl()   {}
+func (n *FuncDecl) isDecl()  {}
+func (n *VarDecl) isDecl()   {}
+func (n *TypeDef) isDecl()   {}
+func (n *EnumConst) isDecl() {}
 
 // Verify that the declaration nodes implement the Decl interface.
 var (
+	_ Decl = &BadDecl{}
 	_ Decl = &FuncDecl{}
 	_ Decl = &VarDecl{}
 	_ Decl = &TypeDef{}
+	_ Decl = &EnumConst{}
 )
 
 // isStmt ensures that only statement nodes can be assigned to the Stmt
 // interface.
-func (n *BlockStmt) isStmt()  {}
-func (n *EmptyStmt) isStmt()  {}
-func (n *ExprStmt) isStmt()   {}
-func (n *IfStmt) isStmt()     {}
-func (n *ReturnStmt) isStmt() {}
-func (n *WhileStmt) isStmt()  {}
+func (n *BadStmt) isStmt()      {}
+func (n *BlockStmt) isStmt()    {}
+func (n *BreakStmt) isStmt()    {}
+func (n *CaseStmt) isStmt()     {}
+func (n *ContinueStmt) isStmt() {}
+func (n *DoWhileStmt) isStmt()  {}
+func (n *EmptyStmt) isStmt()    {}
+func (n *ExprStmt) isStmt()     {}
+func (n *ForStmt) isStmt()      {}
+func (n *GotoStmt) isStmt()     {}
+func (n *IfStmt) isStmt()       {}
+func (n *LabeledStmt) isStmt()  {}
+func (n *ReturnStmt) isStmt()   {}
+func (n *SwitchStmt) isStmt()   {}
+func (n *WhileStmt) isStmt()    {}
 
 // Verify that the statement nodes implement the Stmt interface.
 var (
+	_ Stmt = &BadStmt{}
 	_ Stmt = &BlockStmt{}
+	_ Stmt = &BreakStmt{}
+	_ Stmt = &CaseStmt{}
+	_ Stmt = &ContinueStmt{}
+	_ Stmt = &DoWhileStmt{}
 	_ Stmt = &EmptyStmt{}
 	_ Stmt = &ExprStmt{}
+	_ Stmt = &ForStmt{}
+	_ Stmt = &GotoStmt{}
 	_ Stmt = &IfStmt{}
+	_ Stmt = &LabeledStmt{}
 	_ Stmt = &ReturnStmt{}
+	_ Stmt = &SwitchStmt{}
 	_ Stmt = &WhileStmt{}
 )
 
 // isBlockItem ensures that only block item nodes can be assigned to the
 // BlockItem interface.
-func (n *BlockStmt) isBlockItem()  {}
-func (n *EmptyStmt) isBlockItem()  {}
-func (n *ExprStmt) isBlockItem()   {}
-func (n *FuncDecl) isBlockItem()   {}
-func (n *IfStmt) isBlockItem()     {}
-func (n *ReturnStmt) isBlockItem() {}
-func (n *TypeDef) isBlockItem()    {}
-func (n *VarDecl) isBlockItem()    {}
-func (n *WhileStmt) isBlockItem()  {}
+func (n *BadStmt) isBlockItem()      {}
+func (n *BlockStmt) isBlockItem()    {}
+func (n *BreakStmt) isBlockItem()    {}
+func (n *CaseStmt) isBlockItem()     {}
+func (n *ContinueStmt) isBlockItem() {}
+func (n *DoWhileStmt) isBlockItem()  {}
+func (n *EmptyStmt) isBlockItem()    {}
+func (n *ExprStmt) isBlockItem()     {}
+func (n *ForStmt) isBlockItem()      {}
+func (n *FuncDecl) isBlockItem()     {}
+func (n *GotoStmt) isBlockItem()     {}
+func (n *IfStmt) isBlockItem()       {}
+func (n *LabeledStmt) isBlockItem()  {}
+func (n *ReturnStmt) isBlockItem()   {}
+func (n *SwitchStmt) isBlockItem()   {}
+func (n *TypeDef) isBlockItem()      {}
+func (n *VarDecl) isBlockItem()      {}
+func (n *WhileStmt) isBlockItem()    {}
 
 // Verify that the block item nodes implement the BlockItem interface.
 var (
+	_ BlockItem = &BadStmt{}
 	_ BlockItem = &BlockStmt{}
+	_ BlockItem = &BreakStmt{}
+	_ BlockItem = &CaseStmt{}
+	_ BlockItem = &ContinueStmt{}
+	_ BlockItem = &DoWhileStmt{}
 	_ BlockItem = &EmptyStmt{}
 	_ BlockItem = &ExprStmt{}
+	_ BlockItem = &ForStmt{}
 	_ BlockItem = &FuncDecl{}
+	_ BlockItem = &GotoStmt{}
 	_ BlockItem = &IfStmt{}
+	_ BlockItem = &LabeledStmt{}
 	_ BlockItem = &ReturnStmt{}
+	_ BlockItem = &SwitchStmt{}
 	_ BlockItem = &TypeDef{}
 	_ BlockItem = &VarDecl{}
 	_ BlockItem = &WhileStmt{}
@@ -825,33 +1598,51 @@ var (
 
 // isExpr ensures that only expression nodes can be assigned to the Expr
 // interface.
-func (n *BasicLit) isExpr()   {}
-func (n *BinaryExpr) isExpr() {}
-func (n *CallExpr) isExpr()   {}
-func (n *Ident) isExpr()      {}
-func (n *IndexExpr) isExpr()  {}
-func (n *ParenExpr) isExpr()  {}
-func (n *UnaryExpr) isExpr()  {}
+func (n *BasicLit) isExpr()     {}
+func (n *BinaryExpr) isExpr()   {}
+func (n *CallExpr) isExpr()     {}
+func (n *CastExpr) isExpr()     {}
+func (n *CondExpr) isExpr()     {}
+func (n *Ident) isExpr()        {}
+func (n *IndexExpr) isExpr()    {}
+func (n *InitList) isExpr()     {}
+func (n *ParenExpr) isExpr()    {}
+func (n *PostfixExpr) isExpr()  {}
+func (n *SelectorExpr) isExpr() {}
+func (n *SizeofExpr) isExpr()   {}
+func (n *UnaryExpr) isExpr()    {}
 
 // Verify that the expression nodes implement the Expr interface.
 var (
 	_ Expr = &BasicLit{}
 	_ Expr = &BinaryExpr{}
 	_ Expr = &CallExpr{}
+	_ Expr = &CastExpr{}
+	_ Expr = &CondExpr{}
 	_ Expr = &Ident{}
 	_ Expr = &IndexExpr{}
+	_ Expr = &InitList{}
 	_ Expr = &ParenExpr{}
+	_ Expr = &PostfixExpr{}
+	_ Expr = &SelectorExpr{}
+	_ Expr = &SizeofExpr{}
 	_ Expr = &UnaryExpr{}
 )
 
 // isType ensures that only type nodes can be assigned to the Type interface.
-func (n *Ident) isType()     {}
-func (n *ArrayType) isType() {}
-func (n *FuncType) isType()  {}
+func (n *Ident) isType()       {}
+func (n *ArrayType) isType()   {}
+func (n *FuncType) isType()    {}
+func (n *PointerType) isType() {}
+func (n *StructType) isType()  {}
+func (n *EnumType) isType()    {}
 
 // Verify that the type nodes implement the Type interface.
 var (
 	_ Type = &Ident{}
 	_ Type = &ArrayType{}
 	_ Type = &FuncType{}
+	_ Type = &PointerType{}
+	_ Type = &StructType{}
+	_ Type = &EnumType{}
 )
@@ -1,12 +1,49 @@
 // Package astutil implements utility functions for handling parse trees.
 package astutil
 
-import "github.com/mewmew/uc/ast"
+import (
+	"fmt"
+
+	"github.com/mewmew/uc/ast"
+)
 
 // IsDef reports whether the given declaration is a definition.
 func IsDef(decl ast.Decl) bool {
-	if _, ok := decl.(*ast.VarDecl); ok {
+	switch decl.(type) {
+	case *ast.VarDecl, *ast.EnumConst:
 		return true
 	}
 	return decl.Value() != nil
 }
+
+// SwitchCases returns the case and default labeled statements of the given
+// switch statement, in order of occurrence. The labeled statements of nested
+// switch statements and nested functions are not included.
+func SwitchCases(stmt *ast.SwitchStmt) []*ast.CaseStmt {
+	var cases []*ast.CaseStmt
+	// depth tracks the number of enclosing switch statements and functions,
+	// including stmt itself.
+	depth := 0
+	before := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.SwitchStmt, *ast.FuncDecl:
+			depth++
+		case *ast.CaseStmt:
+			if depth == 1 {
+				cases = append(cases, n)
+			}
+		}
+		return nil
+	}
+	after := func(n ast.Node) error {
+		switch n.(type) {
+		case *ast.SwitchStmt, *ast.FuncDecl:
+			depth--
+		}
+		return nil
+	}
+	if err := WalkBeforeAfter(stmt, before, after); err != nil {
+		panic(fmt.Sprintf("unable to walk switch statement; %v", err))
+	}
+	return cases
+}
@@ -27,6 +27,10 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		}
 
 	// Declarations.
+	case *ast.BadDecl:
+		if n != nil {
+			return walkBadDecl(n, before, after)
+		}
 	case *ast.FuncDecl:
 		if n != nil {
 			return walkFuncDecl(n, before, after)
@@ -39,12 +43,36 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkTypeDef(n, before, after)
 		}
+	case *ast.EnumConst:
+		if n != nil {
+			return walkEnumConst(n, before, after)
+		}
 
 	// Statements.
+	case *ast.BadStmt:
+		if n != nil {
+			return walkBadStmt(n, before, after)
+		}
 	case *ast.BlockStmt:
 		if n != nil {
 			return walkBlockStmt(n, before, after)
 		}
+	case *ast.BreakStmt:
+		if n != nil {
+			return walkBreakStmt(n, before, after)
+		}
+	case *ast.CaseStmt:
+		if n != nil {
+			return walkCaseStmt(n, before, after)
+		}
+	case *ast.ContinueStmt:
+		if n != nil {
+			return walkContinueStmt(n, before, after)
+		}
+	case *ast.DoWhileStmt:
+		if n != nil {
+			return walkDoWhileStmt(n, before, after)
+		}
 	case *ast.EmptyStmt:
 		if n != nil {
 			return walkEmptyStmt(n, before, after)
@@ -53,14 +81,30 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkExprStmt(n, before, after)
 		}
+	case *ast.ForStmt:
+		if n != nil {
+			return walkForStmt(n, before, after)
+		}
+	case *ast.GotoStmt:
+		if n != nil {
+			return walkGotoStmt(n, before, after)
+		}
 	case *ast.IfStmt:
 		if n != nil {
 			return walkIfStmt(n, before, after)
 		}
+	case *ast.LabeledStmt:
+		if n != nil {
+			return walkLabeledStmt(n, before, after)
+		}
 	case *ast.ReturnStmt:
 		if n != nil {
 			return walkReturnStmt(n, before, after)
 		}
+	case *ast.SwitchStmt:
+		if n != nil {
+			return walkSwitchStmt(n, before, after)
+		}
 	case *ast.WhileStmt:
 		if n != nil {
 			return walkWhileStmt(n, before, after)
@@ -79,6 +123,14 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkCallExpr(n, before, after)
 		}
+	case *ast.CastExpr:
+		if n != nil {
+			return walkCastExpr(n, before, after)
+		}
+	case *ast.CondExpr:
+		if n != nil {
+			return walkCondExpr(n, before, after)
+		}
 	case *ast.Ident:
 		if n != nil {
 			return walkIdent(n, before, after)
@@ -87,10 +139,26 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkIndexExpr(n, before, after)
 		}
+	case *ast.InitList:
+		if n != nil {
+			return walkInitList(n, before, after)
+		}
 	case *ast.ParenExpr:
 		if n != nil {
 			return walkParenExpr(n, before, after)
 		}
+	case *ast.PostfixExpr:
+		if n != nil {
+			return walkPostfixExpr(n, before, after)
+		}
+	case *ast.SelectorExpr:
+		if n != nil {
+			return walkSelectorExpr(n, before, after)
+		}
+	case *ast.SizeofExpr:
+		if n != nil {
+			return walkSizeofExpr(n, before, after)
+		}
 	case *ast.UnaryExpr:
 		if n != nil {
 			return walkUnaryExpr(n, before, after)
@@ -105,6 +173,18 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkFuncType(n, before, after)
 		}
+	case *ast.PointerType:
+		if n != nil {
+			return walkPointerType(n, before, after)
+		}
+	case *ast.StructType:
+		if n != nil {
+			return walkStructType(n, before, after)
+		}
+	case *ast.EnumType:
+		if n != nil {
+			return walkEnumType(n, before, after)
+		}
 
 	case nil:
 		// Nothing to do.
@@ -136,6 +216,18 @@ func walkFile(file *ast.File, before, after func(ast.Node) error) error {
 
 // === [ Top-level declarations ] ===
 
+// walkBadDecl walks the parse tree of the given bad declaration in depth first
+// order.
+func walkBadDecl(decl *ast.BadDecl, before, after func(ast.Node) error) error {
+	if err := before(decl); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(decl); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkFuncDecl walks the parse tree of the given function declaration in depth
 // first order.
 func walkFuncDecl(decl *ast.FuncDecl, before, after func(ast.Node) error) error {
@@ -196,8 +288,36 @@ func walkTypeDef(def *ast.TypeDef, before, after func(ast.Node) error) error {
 	return nil
 }
 
+// walkEnumConst walks the parse tree of the given enumeration constant in depth
+// first order. The constant name is not walked, as the scope of an enumeration
+// constant begins after its value expression.
+func walkEnumConst(decl *ast.EnumConst, before, after func(ast.Node) error) error {
+	if err := before(decl); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(decl.ValExpr, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(decl); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // === [ Statements ] ===
 
+// walkBadStmt walks the parse tree of the given bad statement in depth first
+// order.
+func walkBadStmt(stmt *ast.BadStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkBlockStmt walks the parse tree of the given block statement in depth
 // first order.
 func walkBlockStmt(block *ast.BlockStmt, before, after func(ast.Node) error) error {
@@ -215,6 +335,66 @@ func walkBlockStmt(block *ast.BlockStmt, before, after func(ast.Node) error) err
 	return nil
 }
 
+// walkBreakStmt walks the parse tree of the given break statement in depth
+// first order.
+func walkBreakStmt(stmt *ast.BreakStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkCaseStmt walks the parse tree of the given case statement in depth first
+// order.
+func walkCaseStmt(stmt *ast.CaseStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Val, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Body, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkContinueStmt walks the parse tree of the given continue statement in
+// depth first order.
+func walkContinueStmt(stmt *ast.ContinueStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkDoWhileStmt walks the parse tree of the given do-while statement in depth
+// first order.
+func walkDoWhileStmt(stmt *ast.DoWhileStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Body, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Cond, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkEmptyStmt walks the parse tree of the given empty statement in depth
 // first order.
 func walkEmptyStmt(stmt *ast.EmptyStmt, before, after func(ast.Node) error) error {
@@ -242,6 +422,43 @@ func walkExprStmt(stmt *ast.ExprStmt, before, after func(ast.Node) error) error
 	return nil
 }
 
+// walkForStmt walks the parse tree of the given for statement in depth first
+// order.
+func walkForStmt(stmt *ast.ForStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Init, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Cond, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Post, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Body, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkGotoStmt walks the parse tree of the given goto statement in depth first
+// order. The label identifier is not walked, as labels have a separate name
+// space from other identifiers.
+func walkGotoStmt(stmt *ast.GotoStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkIfStmt walks the parse tree of the given if statement in depth first
 // order.
 func walkIfStmt(stmt *ast.IfStmt, before, after func(ast.Node) error) error {
@@ -263,6 +480,22 @@ func walkIfStmt(stmt *ast.IfStmt, before, after func(ast.Node) error) error {
 	return nil
 }
 
+// walkLabeledStmt walks the parse tree of the given labeled statement in depth
+// first order. The label identifier is not walked, as labels have a separate
+// name space from other identifiers.
+func walkLabeledStmt(stmt *ast.LabeledStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Stmt, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkReturnStmt walks the parse tree of the given return statement in depth
 // first order.
 func walkReturnStmt(stmt *ast.ReturnStmt, before, after func(ast.Node) error) error {
@@ -278,6 +511,24 @@ func walkReturnStmt(stmt *ast.ReturnStmt, before, after func(ast.Node) error) er
 	return nil
 }
 
+// walkSwitchStmt walks the parse tree of the given switch statement in depth
+// first order.
+func walkSwitchStmt(stmt *ast.SwitchStmt, before, after func(ast.Node) error) error {
+	if err := before(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Tag, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(stmt.Body, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(stmt); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkWhileStmt walks the parse tree of the given while statement in depth
 // first order.
 func walkWhileStmt(stmt *ast.WhileStmt, before, after func(ast.Node) error) error {
@@ -348,6 +599,45 @@ func walkCallExpr(call *ast.CallExpr, before, after func(ast.Node) error) error
 	return nil
 }
 
+// walkCastExpr walks the parse tree of the given cast expression in depth first
+// order.
+func walkCastExpr(expr *ast.CastExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Type, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkCondExpr walks the parse tree of the given conditional expression in
+// depth first order.
+func walkCondExpr(expr *ast.CondExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Cond, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Y, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkIdent walks the parse tree of the given identifier expression in depth
 // first order.
 func walkIdent(ident *ast.Ident, before, after func(ast.Node) error) error {
@@ -366,7 +656,7 @@ func walkIndexExpr(expr *ast.IndexExpr, before, after func(ast.Node) error) erro
 	if err := before(expr); err != nil {
 		return errutil.Err(err)
 	}
-	if err := WalkBeforeAfter(expr.Name, before, after); err != nil {
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
 		return errutil.Err(err)
 	}
 	if err := WalkBeforeAfter(expr.Index, before, after); err != nil {
@@ -378,6 +668,23 @@ func walkIndexExpr(expr *ast.IndexExpr, before, after func(ast.Node) error) erro
 	return nil
 }
 
+// walkInitList walks the parse tree of the given initializer list in depth
+// first order.
+func walkInitList(list *ast.InitList, before, after func(ast.Node) error) error {
+	if err := before(list); err != nil {
+		return errutil.Err(err)
+	}
+	for _, elem := range list.Elems {
+		if err := WalkBeforeAfter(elem, before, after); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if err := after(list); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkParenExpr walks the parse tree of the given parenthesized expression in
 // depth first order.
 func walkParenExpr(expr *ast.ParenExpr, before, after func(ast.Node) error) error {
@@ -393,6 +700,55 @@ func walkParenExpr(expr *ast.ParenExpr, before, after func(ast.Node) error) erro
 	return nil
 }
 
+// walkPostfixExpr walks the parse tree of the given postfix expression in depth
+// first order.
+func walkPostfixExpr(expr *ast.PostfixExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkSelectorExpr walks the parse tree of the given selector expression in
+// depth first order. The field name is not walked, as it is not resolved in the
+// lexical scope of the expression.
+func walkSelectorExpr(expr *ast.SelectorExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkSizeofExpr walks the parse tree of the given sizeof expression in depth
+// first order.
+func walkSizeofExpr(expr *ast.SizeofExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Type, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkUnaryExpr walks the parse tree of the given unary expression in depth
 // first order.
 func walkUnaryExpr(expr *ast.UnaryExpr, before, after func(ast.Node) error) error {
@@ -419,6 +775,9 @@ func walkArrayType(arr *ast.ArrayType, before, after func(ast.Node) error) error
 	if err := WalkBeforeAfter(arr.Elem, before, after); err != nil {
 		return errutil.Err(err)
 	}
+	if err := WalkBeforeAfter(arr.LenExpr, before, after); err != nil {
+		return errutil.Err(err)
+	}
 	if err := after(arr); err != nil {
 		return errutil.Err(err)
 	}
@@ -444,3 +803,54 @@ func walkFuncType(fn *ast.FuncType, before, after func(ast.Node) error) error {
 	}
 	return nil
 }
+
+// walkPointerType walks the parse tree of the given pointer type in depth first
+// order.
+func walkPointerType(ptr *ast.PointerType, before, after func(ast.Node) error) error {
+	if err := before(ptr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(ptr.Elem, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(ptr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkStructType walks the parse tree of the given structure type in depth
+// first order. The structure tag is not walked, as structure tags have a
+// namespace of their own.
+func walkStructType(typ *ast.StructType, before, after func(ast.Node) error) error {
+	if err := before(typ); err != nil {
+		return errutil.Err(err)
+	}
+	for _, field := range typ.Fields {
+		if err := WalkBeforeAfter(field, before, after); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if err := after(typ); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkEnumType walks the parse tree of the given enumeration type in depth
+// first order. The enumeration tag is not walked, as enumeration tags share the
+// namespace of structure tags.
+func walkEnumType(typ *ast.EnumType, before, after func(ast.Node) error) error {
+	if err := before(typ); err != nil {
+		return errutil.Err(err)
+	}
+	for _, c := range typ.Consts {
+		if err := WalkBeforeAfter(c, before, after); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if err := after(typ); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
@@ -3,11 +3,11 @@
 package astx
 
 import (
-	"strconv"
-
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/gocc/util"
+	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
 )
 
@@ -54,14 +54,15 @@ func AppendDecl(list, decl interface{}) ([]ast.Decl, error) {
 }
 
 // NewFuncDecl returns a new function declaration node, based on the following
-// production rule.
+// production rules.
 //
 //    FuncDecl
 //       : FuncHeader
 //    ;
 //
 //    FuncHeader
-//       : BasicType ident "(" Params ")"
+//       : Type ident "(" Params ")"
+//       | Type ident "(" ParamList "," "..." ")"
 //    ;
 //
 //    Params
@@ -69,7 +70,8 @@ func AppendDecl(list, decl interface{}) ([]ast.Decl, error) {
 //       | ParamList
 //    ;
 //
-func NewFuncDecl(resultType, name, lparen, params, rparen interface{}) (*ast.FuncDecl, error) {
+// The ellipsis is nil for functions with a fixed number of parameters.
+func NewFuncDecl(resultType, name, lparen, params, ellipsis, rparen interface{}) (*ast.FuncDecl, error) {
 	resType, err := NewType(resultType)
 	if err != nil {
 		return nil, errutil.Newf("invalid function result type; %v", err)
@@ -90,7 +92,14 @@ func NewFuncDecl(resultType, name, lparen, params, rparen interface{}) (*ast.Fun
 	if !ok {
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
-	typ := &ast.FuncType{Result: resType, Lparen: lpar.Offset, Params: pars, Rparen: rpar.Offset}
+	typ := &ast.FuncType{Result: resType, Lparen: token.Pos(lpar.Offset), Params: pars, Ellipsis: token.NoPos, Rparen: token.Pos(rpar.Offset)}
+	if ellipsis != nil {
+		ell, ok := ellipsis.(*gocctoken.Token)
+		if !ok {
+			return nil, errutil.Newf("invalid ellipsis type; expected *gocctoken.Token, got %T", ellipsis)
+		}
+		typ.Ellipsis = token.Pos(ell.Offset)
+	}
 	return &ast.FuncDecl{FuncType: typ, FuncName: ident}, nil
 }
 
@@ -117,7 +126,7 @@ func SetFuncBody(f, body interface{}) (*ast.FuncDecl, error) {
 // production rule.
 //
 //    ScalarDecl
-//       : TypeName ident
+//       : Type ident
 //    ;
 func NewScalarDecl(typ, name interface{}) (*ast.VarDecl, error) {
 	scalarType, err := NewType(typ)
@@ -131,14 +140,40 @@ func NewScalarDecl(typ, name interface{}) (*ast.VarDecl, error) {
 	return &ast.VarDecl{VarType: scalarType, VarName: ident}, nil
 }
 
+// NewStructDecl returns a new structure declaration node without declarator,
+// based on the following production rule.
+//
+//    Decl
+//       : StructType ";"
+//    ;
+func NewStructDecl(typ interface{}) (*ast.VarDecl, error) {
+	if typ, ok := typ.(*ast.StructType); ok {
+		return &ast.VarDecl{VarType: typ}, nil
+	}
+	return nil, errutil.Newf("invalid structure declaration type; expected *ast.StructType, got %T", typ)
+}
+
+// NewEnumDecl returns a new enumeration declaration node without declarator,
+// based on the following production rule.
+//
+//    Decl
+//       : EnumType ";"
+//    ;
+func NewEnumDecl(typ interface{}) (*ast.VarDecl, error) {
+	if typ, ok := typ.(*ast.EnumType); ok {
+		return &ast.VarDecl{VarType: typ}, nil
+	}
+	return nil, errutil.Newf("invalid enumeration declaration type; expected *ast.EnumType, got %T", typ)
+}
+
 // NewArrayDecl returns a new array declaration node, based on the following
 // production rule.
 //
 //    ArrayDecl
-//       : BasicType ident "[" int_lit "]"
+//       : Type ident ArrayDims
 //    ;
-func NewArrayDecl(elem, name, lbracket, length, rbracket interface{}) (*ast.VarDecl, error) {
-	typ, err := NewArrayType(elem, lbracket, length, rbracket)
+func NewArrayDecl(elem, name, dims interface{}) (*ast.VarDecl, error) {
+	typ, err := NewArrayType(elem, dims)
 	if err != nil {
 		return nil, errutil.Newf("invalid array type; %v", err)
 	}
@@ -149,33 +184,42 @@ func NewArrayDecl(elem, name, lbracket, length, rbracket interface{}) (*ast.VarD
 	return &ast.VarDecl{VarType: typ, VarName: ident}, nil
 }
 
-// NewIntLit returns a new integer, based on the following production rule.
+// NewVarDef returns a new variable definition node, based on the following
+// production rule.
 //
-//    IntLit
-//       : int_lit
-//       | char_lit
-func NewIntLit(nToken interface{}, kind token.Kind) (int, error) {
-	nTok, ok := nToken.(*gocctoken.Token)
+//    Decl
+//       : VarDecl "=" Initializer ";"
+//    ;
+//
+// The length of an unsized array is inferred from its initializer.
+func NewVarDef(decl, val interface{}) (*ast.VarDecl, error) {
+	d, ok := decl.(*ast.VarDecl)
 	if !ok {
-		return 0, errutil.Newf("invalid integer literal type; expectd *gocctoken.Token, got %T", nToken)
+		return nil, errutil.Newf("invalid variable declaration type; expected *ast.VarDecl, got %T", decl)
 	}
-	s := string(nTok.Lit)
-	switch kind {
-	case token.IntLit:
-		n, err := strconv.Atoi(s)
-		if err != nil {
-			return 0, errutil.Err(err)
-		}
-		return n, nil
-	case token.CharLit:
-		s, err := strconv.Unquote(s)
-		if err != nil {
-			return 0, errutil.Newf("unable to unquote character literal; %v", err)
+	v, ok := val.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid variable initializer type; expected ast.Expr, got %T", val)
+	}
+	d.Val = v
+	// "If an array of unknown size is initialized, its size is determined by the
+	// largest indexed element with an explicit initializer." [C99 draft 6.7.8.22]
+	if typ, ok := d.VarType.(*ast.ArrayType); ok && typ.Len == 0 {
+		switch v := v.(type) {
+		case *ast.InitList:
+			typ.Len = len(v.Elems)
+		case *ast.BasicLit:
+			if v.Kind == token.StringLit {
+				s, err := util.StringValue([]byte(v.Val))
+				if err != nil {
+					return nil, errutil.Newf("unable to unquote string literal; %v", err)
+				}
+				// Include the terminating null character.
+				typ.Len = len(s) + 1
+			}
 		}
-		return int(s[0]), nil
-	default:
-		return 0, errutil.Newf(`invalid integer literal kind; expected "IntLit" or "CharLit", got %q`, kind)
 	}
+	return d, nil
 }
 
 // NewTypeDef returns a new type definition node, based on the following
@@ -197,7 +241,7 @@ func NewTypeDef(typedefTok, typ, name interface{}) (*ast.TypeDef, error) {
 	if err != nil {
 		return nil, errutil.Newf("invalid type definition identifier; %v", err)
 	}
-	return &ast.TypeDef{Typedef: typedef.Offset, DeclType: declType, TypeName: ident}, nil
+	return &ast.TypeDef{Typedef: token.Pos(typedef.Offset), DeclType: declType, TypeName: ident}, nil
 }
 
 // NewParamList returns a new parameter list, based on the following production
@@ -234,7 +278,6 @@ func AppendParam(list, param interface{}) ([]*ast.VarDecl, error) {
 // production rules.
 //
 //    Param
-//       // BasicType : "void" ;
 //       : Type
 //    ;
 func NewAnonParam(typ interface{}) (*ast.VarDecl, error) {
@@ -269,10 +312,10 @@ func NewReturnStmt(returnToken, result interface{}) (*ast.ReturnStmt, error) {
 		return nil, errutil.Newf("invalid return keyword type; expected *gocctoken.Token, got %T", returnToken)
 	}
 	if result == nil {
-		return &ast.ReturnStmt{Return: retTok.Offset}, nil
+		return &ast.ReturnStmt{Return: token.Pos(retTok.Offset)}, nil
 	}
 	if result, ok := result.(ast.Expr); ok {
-		return &ast.ReturnStmt{Return: retTok.Offset, Result: result}, nil
+		return &ast.ReturnStmt{Return: token.Pos(retTok.Offset), Result: result}, nil
 	}
 	return nil, errutil.Newf("invalid return statement result type; expected ast.Expr, got %T", result)
 }
@@ -296,7 +339,104 @@ func NewWhileStmt(whileToken, cond, body interface{}) (*ast.WhileStmt, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid while statement body type; expected ast.Stmt, got %T", body)
 	}
-	return &ast.WhileStmt{While: whileTok.Offset, Cond: condExpr, Body: bodyStmt}, nil
+	return &ast.WhileStmt{While: token.Pos(whileTok.Offset), Cond: condExpr, Body: bodyStmt}, nil
+}
+
+// NewDoWhileStmt returns a new do-while statement, based on the following
+// production rule.
+//
+//    Stmt
+//       : "do" Stmt "while" Condition ";"
+//    ;
+func NewDoWhileStmt(doToken, body, cond interface{}) (*ast.DoWhileStmt, error) {
+	doTok, ok := doToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid do keyword type; expected *gocctoken.Token, got %T", doToken)
+	}
+	bodyStmt, ok := body.(ast.Stmt)
+	if !ok {
+		return nil, errutil.Newf("invalid do-while statement body type; expected ast.Stmt, got %T", body)
+	}
+	condExpr, ok := cond.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid do-while statement condition type; expected ast.Expr, got %T", cond)
+	}
+	return &ast.DoWhileStmt{Do: token.Pos(doTok.Offset), Body: bodyStmt, Cond: condExpr}, nil
+}
+
+// NewForStmt returns a new for statement, based on the following production
+// rule.
+//
+//    Stmt
+//       : "for" "(" ExprOpt ";" ExprOpt ";" ExprOpt ")" Stmt
+//    ;
+func NewForStmt(forToken, init, cond, post, body interface{}) (*ast.ForStmt, error) {
+	forTok, ok := forToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid for keyword type; expected *gocctoken.Token, got %T", forToken)
+	}
+	var exprs [3]ast.Expr
+	for i, x := range []interface{}{init, cond, post} {
+		if x == nil {
+			continue
+		}
+		expr, ok := x.(ast.Expr)
+		if !ok {
+			return nil, errutil.Newf("invalid for statement clause type; expected ast.Expr, got %T", x)
+		}
+		exprs[i] = expr
+	}
+	bodyStmt, ok := body.(ast.Stmt)
+	if !ok {
+		return nil, errutil.Newf("invalid for statement body type; expected ast.Stmt, got %T", body)
+	}
+	return &ast.ForStmt{For: token.Pos(forTok.Offset), Init: exprs[0], Cond: exprs[1], Post: exprs[2], Body: bodyStmt}, nil
+}
+
+// NewBreakStmt returns a new break statement, based on the following
+// production rule.
+//
+//    Stmt
+//       : "break" ";"
+//    ;
+func NewBreakStmt(breakToken interface{}) (*ast.BreakStmt, error) {
+	breakTok, ok := breakToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid break keyword type; expected *gocctoken.Token, got %T", breakToken)
+	}
+	return &ast.BreakStmt{Break: token.Pos(breakTok.Offset)}, nil
+}
+
+// NewContinueStmt returns a new continue statement, based on the following
+// production rule.
+//
+//    Stmt
+//       : "continue" ";"
+//    ;
+func NewContinueStmt(continueToken interface{}) (*ast.ContinueStmt, error) {
+	continueTok, ok := continueToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid continue keyword type; expected *gocctoken.Token, got %T", continueToken)
+	}
+	return &ast.ContinueStmt{Continue: token.Pos(continueTok.Offset)}, nil
+}
+
+// NewGotoStmt returns a new goto statement, based on the following production
+// rule.
+//
+//    Stmt
+//       : "goto" ident ";"
+//    ;
+func NewGotoStmt(gotoToken, label interface{}) (*ast.GotoStmt, error) {
+	gotoTok, ok := gotoToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid goto keyword type; expected *gocctoken.Token, got %T", gotoToken)
+	}
+	ident, err := NewIdent(label)
+	if err != nil {
+		return nil, errutil.Newf("invalid label name; %v", err)
+	}
+	return &ast.GotoStmt{Goto: token.Pos(gotoTok.Offset), Label: ident}, nil
 }
 
 // NewIfStmt returns a new if statement, based on the following production
@@ -324,14 +464,86 @@ func NewIfStmt(ifToken, cond, trueBranch, falseBranch interface{}) (*ast.IfStmt,
 		return nil, errutil.Newf("invalid if statement body type; expected ast.Stmt, got %T", trueBranch)
 	}
 	if falseBranch == nil {
-		return &ast.IfStmt{If: ifTok.Offset, Cond: condExpr, Body: bodyStmt}, nil
+		return &ast.IfStmt{If: token.Pos(ifTok.Offset), Cond: condExpr, Body: bodyStmt}, nil
 	}
 	if elseStmt, ok := falseBranch.(ast.Stmt); ok {
-		return &ast.IfStmt{If: ifTok.Offset, Cond: condExpr, Body: bodyStmt, Else: elseStmt}, nil
+		return &ast.IfStmt{If: token.Pos(ifTok.Offset), Cond: condExpr, Body: bodyStmt, Else: elseStmt}, nil
 	}
 	return nil, errutil.Newf("invalid if statement else-body type; expected ast.Stmt, got %T", falseBranch)
 }
 
+// NewSwitchStmt returns a new switch statement, based on the following
+// production rule.
+//
+//    Stmt
+//       : "switch" Condition Stmt
+//    ;
+func NewSwitchStmt(switchToken, tag, body interface{}) (*ast.SwitchStmt, error) {
+	switchTok, ok := switchToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid switch keyword type; expected *gocctoken.Token, got %T", switchToken)
+	}
+	tagExpr, ok := tag.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid switch statement controlling expression type; expected ast.Expr, got %T", tag)
+	}
+	bodyStmt, ok := body.(ast.Stmt)
+	if !ok {
+		return nil, errutil.Newf("invalid switch statement body type; expected ast.Stmt, got %T", body)
+	}
+	return &ast.SwitchStmt{Switch: token.Pos(switchTok.Offset), Tag: tagExpr, Body: bodyStmt}, nil
+}
+
+// NewCaseStmt returns a new case statement, based on the following production
+// rules.
+//
+//    Stmt
+//       : "case" Expr ":" Stmt
+//       | "default" ":" Stmt
+//    ;
+func NewCaseStmt(caseToken, val, colon, body interface{}) (*ast.CaseStmt, error) {
+	caseTok, ok := caseToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid case keyword type; expected *gocctoken.Token, got %T", caseToken)
+	}
+	colonTok, ok := colon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid colon type; expected *gocctoken.Token, got %T", colon)
+	}
+	bodyStmt, ok := body.(ast.Stmt)
+	if !ok {
+		return nil, errutil.Newf("invalid case statement body type; expected ast.Stmt, got %T", body)
+	}
+	if val == nil {
+		return &ast.CaseStmt{Case: token.Pos(caseTok.Offset), Colon: token.Pos(colonTok.Offset), Body: bodyStmt}, nil
+	}
+	if valExpr, ok := val.(ast.Expr); ok {
+		return &ast.CaseStmt{Case: token.Pos(caseTok.Offset), Val: valExpr, Colon: token.Pos(colonTok.Offset), Body: bodyStmt}, nil
+	}
+	return nil, errutil.Newf("invalid case label type; expected ast.Expr, got %T", val)
+}
+
+// NewLabeledStmt returns a new labeled statement, based on the following
+// production rule.
+//
+//    Stmt
+//       : ident ":" Stmt
+//    ;
+func NewLabeledStmt(label, colon, stmt interface{}) (*ast.LabeledStmt, error) {
+	ident, err := NewIdent(label)
+	if err != nil {
+		return nil, errutil.Newf("invalid label name; %v", err)
+	}
+	colonTok, ok := colon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid colon type; expected *gocctoken.Token, got %T", colon)
+	}
+	if s, ok := stmt.(ast.Stmt); ok {
+		return &ast.LabeledStmt{Label: ident, Colon: token.Pos(colonTok.Offset), Stmt: s}, nil
+	}
+	return nil, errutil.Newf("invalid labeled statement type; expected ast.Stmt, got %T", stmt)
+}
+
 // NewBlockStmt returns a new block statement, based on the following production
 // rule.
 //
@@ -348,10 +560,10 @@ func NewBlockStmt(lbrace, items, rbrace interface{}) (*ast.BlockStmt, error) {
 		return nil, errutil.Newf("invalid right-brace type; expectd *gocctoken.Token, got %T", rbrace)
 	}
 	if items == nil {
-		return &ast.BlockStmt{Lbrace: lbra.Offset, Rbrace: rbra.Offset}, nil
+		return &ast.BlockStmt{Lbrace: token.Pos(lbra.Offset), Rbrace: token.Pos(rbra.Offset)}, nil
 	}
 	if items, ok := items.([]ast.BlockItem); ok {
-		return &ast.BlockStmt{Lbrace: lbra.Offset, Items: items, Rbrace: rbra.Offset}, nil
+		return &ast.BlockStmt{Lbrace: token.Pos(lbra.Offset), Items: items, Rbrace: token.Pos(rbra.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid block statements type; expected []ast.BlockItem, got %T", items)
 }
@@ -397,14 +609,24 @@ func NewEmptyStmt(semicolonToken interface{}) (*ast.EmptyStmt, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid semicolon type; expected *gocctoken.Token, got %T", semicolonToken)
 	}
-	return &ast.EmptyStmt{Semicolon: semiTok.Offset}, nil
+	return &ast.EmptyStmt{Semicolon: token.Pos(semiTok.Offset)}, nil
 }
 
 // NewBinaryExpr returns a new binary experssion node, based on the following
 // production rules.
 //
 //    Expr2R
-//       : Expr2R "=" Expr5L
+//       : Expr4L "=" Expr2R
+//       | Expr4L "+=" Expr2R
+//       | Expr4L "-=" Expr2R
+//       | Expr4L "*=" Expr2R
+//       | Expr4L "/=" Expr2R
+//       | Expr4L "%=" Expr2R
+//       | Expr4L "<<=" Expr2R
+//       | Expr4L ">>=" Expr2R
+//       | Expr4L "&=" Expr2R
+//       | Expr4L "^=" Expr2R
+//       | Expr4L "|=" Expr2R
 //    ;
 //
 //    Expr5L
@@ -441,8 +663,36 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	switch lit := string(opTok.Lit); lit {
 	case "=":
 		op = token.Assign
+	case "+=":
+		op = token.AddAssign
+	case "-=":
+		op = token.SubAssign
+	case "*=":
+		op = token.MulAssign
+	case "/=":
+		op = token.DivAssign
+	case "%=":
+		op = token.RemAssign
+	case "<<=":
+		op = token.ShlAssign
+	case ">>=":
+		op = token.ShrAssign
+	case "&=":
+		op = token.AndAssign
+	case "^=":
+		op = token.XorAssign
+	case "|=":
+		op = token.OrAssign
+	case "||":
+		op = token.Lor
 	case "&&":
 		op = token.Land
+	case "|":
+		op = token.Or
+	case "^":
+		op = token.Xor
+	case "&":
+		op = token.And
 	case "==":
 		op = token.Eq
 	case "!=":
@@ -455,6 +705,10 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 		op = token.Le
 	case ">=":
 		op = token.Ge
+	case "<<":
+		op = token.Shl
+	case ">>":
+		op = token.Shr
 	case "+":
 		op = token.Add
 	case "-":
@@ -463,8 +717,10 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 		op = token.Mul
 	case "/":
 		op = token.Div
+	case "%":
+		op = token.Rem
 	default:
-		return nil, errutil.Newf(`invalid binary operator; expected "=", "&&", "==", "!=", "<", ">", "<=", ">=", "+", "-", "*" or "/", got %q`, lit)
+		return nil, errutil.Newf(`invalid binary operator; expected "=", "+=", "-=", "*=", "/=", "%%=", "<<=", ">>=", "&=", "^=", "|=", "||", "&&", "|", "^", "&", "==", "!=", "<", ">", "<=", ">=", "<<", ">>", "+", "-", "*", "/" or "%%", got %q`, lit)
 	}
 
 	arg0, ok := x.(ast.Expr)
@@ -475,15 +731,50 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid second binary operand type; expected ast.Expr, got %T", y)
 	}
-	return &ast.BinaryExpr{X: arg0, OpPos: opTok.Offset, Op: op, Y: arg1}, nil
+	return &ast.BinaryExpr{X: arg0, OpPos: token.Pos(opTok.Offset), Op: op, Y: arg1}, nil
+}
+
+// NewCondExpr returns a new conditional expression node, based on the
+// following production rule.
+//
+//    Expr3R
+//       : Expr4L "?" Expr ":" Expr3R
+//    ;
+func NewCondExpr(cond, question, x, colon, y interface{}) (*ast.CondExpr, error) {
+	c, ok := cond.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid condition type; expected ast.Expr, got %T", cond)
+	}
+	questionTok, ok := question.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid question mark type; expected *gocctoken.Token, got %T", question)
+	}
+	arg0, ok := x.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid first conditional operand type; expected ast.Expr, got %T", x)
+	}
+	colonTok, ok := colon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid colon type; expected *gocctoken.Token, got %T", colon)
+	}
+	arg1, ok := y.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid second conditional operand type; expected ast.Expr, got %T", y)
+	}
+	return &ast.CondExpr{Cond: c, Question: token.Pos(questionTok.Offset), X: arg0, Colon: token.Pos(colonTok.Offset), Y: arg1}, nil
 }
 
 // NewUnaryExpr returns a new unary experssion node, based on the following
 // production rules.
 //
-//    Expr14
-//       : "-" Expr15
-//       | "!" Expr15
+//    UnaryExpr
+//       : "-" Expr14
+//       | "!" Expr14
+//       | "~" Expr14
+//       | "++" Expr14
+//       | "--" Expr14
+//       | "*" Expr14
+//       | "&" Expr14
 //    ;
 func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 	opTok, ok := opToken.(*gocctoken.Token)
@@ -496,21 +787,157 @@ func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 		op = token.Sub
 	case "!":
 		op = token.Not
+	case "~":
+		op = token.Tilde
+	case "++":
+		op = token.Inc
+	case "--":
+		op = token.Dec
+	case "*":
+		op = token.Mul
+	case "&":
+		op = token.And
 	default:
-		return nil, errutil.Newf(`invalid unary operator; expected "-" or "!", got %q`, lit)
+		return nil, errutil.Newf(`invalid unary operator; expected "-", "!", "~", "++", "--", "*" or "&", got %q`, lit)
 	}
 	if x, ok := x.(ast.Expr); ok {
-		return &ast.UnaryExpr{OpPos: opTok.Offset, Op: op, X: x}, nil
+		return &ast.UnaryExpr{OpPos: token.Pos(opTok.Offset), Op: op, X: x}, nil
 	}
 	return nil, errutil.Newf("invalid unary operand type; expected ast.Expr, got %T", x)
 }
 
-// NewBasicLit returns a new basic literal experssion node of the given kind,
+// NewCastExpr returns a new cast expression node, based on the following
+// production rule.
+//
+//    Expr14
+//       : "(" CastType ")" Expr14
+//    ;
+func NewCastExpr(lparen, typ, rparen, x interface{}) (*ast.CastExpr, error) {
+	lpar, ok := lparen.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-parenthesis type; expectd *gocctoken.Token, got %T", lparen)
+	}
+	t, ok := typ.(ast.Type)
+	if !ok {
+		return nil, errutil.Newf("invalid cast type; expected ast.Type, got %T", typ)
+	}
+	rpar, ok := rparen.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.CastExpr{Lparen: token.Pos(lpar.Offset), Type: t, Rparen: token.Pos(rpar.Offset), X: x}, nil
+	}
+	return nil, errutil.Newf("invalid cast operand type; expected ast.Expr, got %T", x)
+}
+
+// NewSizeofExpr returns a new sizeof expression node of an expression operand,
 // based on the following production rule.
 //
+//    UnaryExpr
+//       : "sizeof" UnaryExpr
+//    ;
+func NewSizeofExpr(sizeofToken, x interface{}) (*ast.SizeofExpr, error) {
+	sizeofTok, ok := sizeofToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid sizeof keyword type; expectd *gocctoken.Token, got %T", sizeofToken)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.SizeofExpr{Sizeof: token.Pos(sizeofTok.Offset), X: x, Lparen: token.NoPos, Rparen: token.NoPos}, nil
+	}
+	return nil, errutil.Newf("invalid sizeof operand type; expected ast.Expr, got %T", x)
+}
+
+// NewSizeofType returns a new sizeof expression node of a type operand, based
+// on the following production rule.
+//
+//    UnaryExpr
+//       : "sizeof" "(" CastType ")"
+//    ;
+func NewSizeofType(sizeofToken, lparen, typ, rparen interface{}) (*ast.SizeofExpr, error) {
+	sizeofTok, ok := sizeofToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid sizeof keyword type; expectd *gocctoken.Token, got %T", sizeofToken)
+	}
+	lpar, ok := lparen.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-parenthesis type; expectd *gocctoken.Token, got %T", lparen)
+	}
+	rpar, ok := rparen.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
+	}
+	if t, ok := typ.(ast.Type); ok {
+		return &ast.SizeofExpr{Sizeof: token.Pos(sizeofTok.Offset), Lparen: token.Pos(lpar.Offset), Type: t, Rparen: token.Pos(rpar.Offset)}, nil
+	}
+	return nil, errutil.Newf("invalid sizeof operand type; expected ast.Type, got %T", typ)
+}
+
+// NewPostfixExpr returns a new postfix experssion node, based on the following
+// production rules.
+//
+//    Expr15
+//       : Expr15 "++"
+//       | Expr15 "--"
+//    ;
+func NewPostfixExpr(x, opToken interface{}) (*ast.PostfixExpr, error) {
+	opTok, ok := opToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid postfix operator type; expectd *gocctoken.Token, got %T", opToken)
+	}
+	var op token.Kind
+	switch lit := string(opTok.Lit); lit {
+	case "++":
+		op = token.Inc
+	case "--":
+		op = token.Dec
+	default:
+		return nil, errutil.Newf(`invalid postfix operator; expected "++" or "--", got %q`, lit)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.PostfixExpr{X: x, OpPos: token.Pos(opTok.Offset), Op: op}, nil
+	}
+	return nil, errutil.Newf("invalid postfix operand type; expected ast.Expr, got %T", x)
+}
+
+// NewSelectorExpr returns a new selector experssion node, based on the
+// following production rules.
+//
 //    Expr15
+//       : Expr15 "." ident
+//       | Expr15 "->" ident
+//    ;
+func NewSelectorExpr(x, opToken, sel interface{}) (*ast.SelectorExpr, error) {
+	opTok, ok := opToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid member access operator type; expectd *gocctoken.Token, got %T", opToken)
+	}
+	var op token.Kind
+	switch lit := string(opTok.Lit); lit {
+	case ".":
+		op = token.Period
+	case "->":
+		op = token.Arrow
+	default:
+		return nil, errutil.Newf(`invalid member access operator; expected "." or "->", got %q`, lit)
+	}
+	ident, err := NewIdent(sel)
+	if err != nil {
+		return nil, errutil.Newf("invalid field name; %v", err)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.SelectorExpr{X: x, OpPos: token.Pos(opTok.Offset), Op: op, Sel: ident}, nil
+	}
+	return nil, errutil.Newf("invalid member access operand type; expected ast.Expr, got %T", x)
+}
+
+// NewBasicLit returns a new basic literal experssion node of the given kind,
+// based on the following production rule.
+//
+//    PrimaryExpr
 //       : int_lit
 //       | char_lit
+//       | string_lit
 //    ;
 func NewBasicLit(valToken interface{}, kind token.Kind) (*ast.BasicLit, error) {
 	valTok, ok := valToken.(*gocctoken.Token)
@@ -518,12 +945,24 @@ func NewBasicLit(valToken interface{}, kind token.Kind) (*ast.BasicLit, error) {
 		return nil, errutil.Newf("invalid basic literal type; expected *gocctoken.Token, got %T", valToken)
 	}
 	switch kind {
-	case token.CharLit, token.IntLit:
+	case token.CharLit:
+		// Validate escape sequences of character literals. The error is
+		// reported at the position of the literal, as the parser has already
+		// consumed the subsequent token when reducing the production rule.
+		if _, err := util.CharValue(valTok.Lit); err != nil {
+			return nil, semerrors.Newf(token.Pos(valTok.Offset), "invalid character literal %s; %v", valTok.Lit, err)
+		}
+	case token.StringLit:
+		// Validate escape sequences of string literals.
+		if _, err := util.StringValue(valTok.Lit); err != nil {
+			return nil, semerrors.Newf(token.Pos(valTok.Offset), "invalid string literal %s; %v", valTok.Lit, err)
+		}
+	case token.IntLit:
 		// Valid kind.
 	default:
-		return nil, errutil.Newf("invalid basic literal kind; expected CharLit or IntLit, got %v", kind)
+		return nil, errutil.Newf("invalid basic literal kind; expected CharLit, IntLit or StringLit, got %v", kind)
 	}
-	return &ast.BasicLit{ValPos: valTok.Offset, Kind: kind, Val: string(valTok.Lit)}, nil
+	return &ast.BasicLit{ValPos: token.Pos(valTok.Offset), Kind: kind, Val: string(valTok.Lit)}, nil
 }
 
 // NewIdent returns a new identifier experssion node, based on the following
@@ -537,20 +976,16 @@ func NewIdent(nameToken interface{}) (*ast.Ident, error) {
 	if !ok {
 		return nil, errutil.Newf("invalid identifier type; expected *gocctoken.Token, got %T", nameToken)
 	}
-	return &ast.Ident{NamePos: nameTok.Offset, Name: string(nameTok.Lit)}, nil
+	return &ast.Ident{NamePos: token.Pos(nameTok.Offset), Name: string(nameTok.Lit)}, nil
 }
 
 // NewIndexExpr returns a new index expression, based on the following
 // production rule.
 //
 //    Expr15
-//       : ident "[" Expr "]"
+//       : Expr15 "[" Expr "]"
 //    ;
-func NewIndexExpr(name, lbracket, index, rbracket interface{}) (*ast.IndexExpr, error) {
-	ident, err := NewIdent(name)
-	if err != nil {
-		return nil, errutil.Newf("invalid array name; %v", err)
-	}
+func NewIndexExpr(x, lbracket, index, rbracket interface{}) (*ast.IndexExpr, error) {
 	lbrack, ok := lbracket.(*gocctoken.Token)
 	if !ok {
 		return nil, errutil.Newf("invalid left-bracket type; expectd *gocctoken.Token, got %T", lbracket)
@@ -559,8 +994,12 @@ func NewIndexExpr(name, lbracket, index, rbracket interface{}) (*ast.IndexExpr,
 	if !ok {
 		return nil, errutil.Newf("invalid right-bracket type; expectd *gocctoken.Token, got %T", rbracket)
 	}
+	xExpr, ok := x.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid indexed expression type; expected ast.Expr, got %T", x)
+	}
 	if index, ok := index.(ast.Expr); ok {
-		return &ast.IndexExpr{Name: ident, Lbracket: lbrack.Offset, Index: index, Rbracket: rbrack.Offset}, nil
+		return &ast.IndexExpr{X: xExpr, Lbracket: token.Pos(lbrack.Offset), Index: index, Rbracket: token.Pos(rbrack.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid index expression type; expected ast.Expr, got %T", index)
 }
@@ -585,10 +1024,10 @@ func NewCallExpr(name, lparen, args, rparen interface{}) (*ast.CallExpr, error)
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
 	if args == nil {
-		return &ast.CallExpr{Name: ident, Lparen: lpar.Offset, Rparen: rpar.Offset}, nil
+		return &ast.CallExpr{Name: ident, Lparen: token.Pos(lpar.Offset), Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	if args, ok := args.([]ast.Expr); ok {
-		return &ast.CallExpr{Name: ident, Lparen: lpar.Offset, Args: args, Rparen: rpar.Offset}, nil
+		return &ast.CallExpr{Name: ident, Lparen: token.Pos(lpar.Offset), Args: args, Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid function arguments type; expected []ast.Expr, got %T", args)
 }
@@ -609,17 +1048,43 @@ func NewParenExpr(lparen, x, rparen interface{}) (*ast.ParenExpr, error) {
 		return nil, errutil.Newf("invalid right-parenthesis type; expectd *gocctoken.Token, got %T", rparen)
 	}
 	if x, ok := x.(ast.Expr); ok {
-		return &ast.ParenExpr{Lparen: lpar.Offset, X: x, Rparen: rpar.Offset}, nil
+		return &ast.ParenExpr{Lparen: token.Pos(lpar.Offset), X: x, Rparen: token.Pos(rpar.Offset)}, nil
 	}
 	return nil, errutil.Newf("invalid parenthesized expression type; expected ast.Expr, got %T", x)
 }
 
+// NewInitList returns a new initializer list, based on the following production
+// rules.
+//
+//    Initializer
+//       : "{" InitializerList "}"
+//       | "{" InitializerList "," "}"
+//    ;
+func NewInitList(lbrace, elems, rbrace interface{}) (*ast.InitList, error) {
+	lbraceTok, ok := lbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-brace type; expectd *gocctoken.Token, got %T", lbrace)
+	}
+	rbraceTok, ok := rbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-brace type; expectd *gocctoken.Token, got %T", rbrace)
+	}
+	if elems, ok := elems.([]ast.Expr); ok {
+		return &ast.InitList{Lbrace: token.Pos(lbraceTok.Offset), Elems: elems, Rbrace: token.Pos(rbraceTok.Offset)}, nil
+	}
+	return nil, errutil.Newf("invalid initializer list elements type; expected []ast.Expr, got %T", elems)
+}
+
 // NewExprList returns a new expression list, based on the following production
-// rule.
+// rules.
 //
 //    ExprList
 //       : Expr
 //    ;
+//
+//    InitializerList
+//       : Initializer
+//    ;
 func NewExprList(x interface{}) ([]ast.Expr, error) {
 	if x, ok := x.(ast.Expr); ok {
 		return []ast.Expr{x}, nil
@@ -628,11 +1093,15 @@ func NewExprList(x interface{}) ([]ast.Expr, error) {
 }
 
 // AppendExpr appends x to the expression list, based on the following
-// production rule.
+// production rules.
 //
 //    ExprList
 //       : ExprList "," Expr
 //    ;
+//
+//    InitializerList
+//       : InitializerList "," Initializer
+//    ;
 func AppendExpr(list, x interface{}) ([]ast.Expr, error) {
 	lst, ok := list.([]ast.Expr)
 	if !ok {
@@ -0,0 +1,104 @@
+package astx
+
+import (
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/errors"
+	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/token"
+)
+
+// NewBadDecl returns a new bad declaration, based on the following production
+// rules.
+//
+//    ExternalDecl
+//       : error ";"
+//       | error "}"
+//    ;
+func NewBadDecl(errAttrib, syncToken interface{}) (*ast.BadDecl, error) {
+	from, to, err := badRange(errAttrib, syncToken)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return &ast.BadDecl{From: from, To: to}, nil
+}
+
+// NewBadStmt returns a new bad statement, based on the following production
+// rule.
+//
+//    BlockItem
+//       : error ";"
+//    ;
+func NewBadStmt(errAttrib, syncToken interface{}) (*ast.BadStmt, error) {
+	from, to, err := badRange(errAttrib, syncToken)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return &ast.BadStmt{From: from, To: to}, nil
+}
+
+// NewBadBlockStmt returns a new block statement, which ends with a bad
+// statement spanning from the syntax error to the closing brace of the block,
+// based on the following production rules.
+//
+//    BlockStmt
+//       : "{" error "}"
+//       | "{" BlockItemList error "}"
+//    ;
+func NewBadBlockStmt(lbrace, items, errAttrib, rbrace interface{}) (*ast.BlockStmt, error) {
+	bad, err := NewBadStmt(errAttrib, rbrace)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	if items == nil {
+		items = []ast.BlockItem{}
+	}
+	items, err = AppendBlockItem(items, bad)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return NewBlockStmt(lbrace, items, rbrace)
+}
+
+// badRange returns the start position of the syntax error recovered from, and
+// the position of the synchronizing token which ended the recovery.
+func badRange(errAttrib, syncToken interface{}) (from, to token.Pos, err error) {
+	e, ok := errAttrib.(*errors.Error)
+	if !ok {
+		return 0, 0, errutil.Newf("invalid error type; expected *errors.Error, got %T", errAttrib)
+	}
+	syncTok, ok := syncToken.(*gocctoken.Token)
+	if !ok {
+		return 0, 0, errutil.Newf("invalid synchronizing token type; expected *gocctoken.Token, got %T", syncToken)
+	}
+	to = token.Pos(syncTok.Offset)
+	// The erroneous construct starts at the first symbol discarded during error
+	// recovery; or at the error token if no symbols were discarded.
+	from = token.Pos(e.ErrorToken.Offset)
+	if len(e.ErrorSymbols) > 0 {
+		if pos, ok := symbolStart(e.ErrorSymbols[0]); ok {
+			from = pos
+		}
+	}
+	return from, to, nil
+}
+
+// symbolStart returns the start position of the given grammar symbol, as
+// discarded during error recovery. The boolean return value indicates success.
+func symbolStart(sym interface{}) (token.Pos, bool) {
+	switch sym := sym.(type) {
+	case *gocctoken.Token:
+		return token.Pos(sym.Offset), true
+	case ast.Node:
+		return sym.Start(), true
+	case []*ast.VarDecl:
+		if len(sym) > 0 {
+			return sym[0].Start(), true
+		}
+	case []ast.Expr:
+		if len(sym) > 0 {
+			return sym[0].Start(), true
+		}
+	}
+	return 0, false
+}
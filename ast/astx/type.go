@@ -1,9 +1,14 @@
 package astx
 
 import (
+	"math"
+	"strings"
+
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/gocc/util"
+	"github.com/mewmew/uc/token"
 )
 
 // NewType returns a new type of µC.
@@ -14,35 +19,340 @@ func NewType(typ interface{}) (ast.Type, error) {
 	return nil, errutil.Newf("invalid type; expected ast.Type, got %T", typ)
 }
 
+// NewTypeKeyword returns a new identifier of the basic type denoted by the
+// given sequence of type keywords, based on the following production rules.
+//
+//    TypeKeyword
+//       : "unsigned"
+//       | "unsigned" IntTypeKeyword
+//    ;
+//
+//    IntTypeKeyword
+//       : "short" "int"
+//       | "long" "int"
+//       | "long" "long"
+//       | "long" "long" "int"
+//    ;
+//
+// The identifier is named by the canonical name of the basic type in the
+// universe scope; e.g. "unsigned int" for `unsigned` and "long long" for
+// `long long int`.
+func NewTypeKeyword(keywords ...interface{}) (*ast.Ident, error) {
+	var names []string
+	var pos token.Pos
+	for i, keyword := range keywords {
+		var name string
+		switch keyword := keyword.(type) {
+		case *gocctoken.Token:
+			name = string(keyword.Lit)
+			if i == 0 {
+				pos = token.Pos(keyword.Offset)
+			}
+		case *ast.Ident:
+			name = keyword.Name
+		default:
+			return nil, errutil.Newf("invalid type keyword type; expected *gocctoken.Token or *ast.Ident, got %T", keyword)
+		}
+		names = append(names, strings.Fields(name)...)
+	}
+	// "int" is implied by "unsigned", and may be omitted after "short" and
+	// "long".
+	var canonical []string
+	for _, name := range names {
+		if name != "int" {
+			canonical = append(canonical, name)
+		}
+	}
+	if len(canonical) == 0 || canonical[len(canonical)-1] == "unsigned" {
+		canonical = append(canonical, "int")
+	}
+	return &ast.Ident{NamePos: pos, Name: strings.Join(canonical, " ")}, nil
+}
+
 // NewArrayType returns a new array type based on the given element type and
-// length.
-func NewArrayType(elem, lbracket, length, rbracket interface{}) (*ast.ArrayType, error) {
-	len, ok := length.(int)
+// array dimensions. The dimensions are specified in declaration order, and
+// nested array types are created for multi-dimensional arrays; e.g. the
+// declaration "int a[2][3]" has an array type of 2 elements, each of which is
+// an array of 3 integers.
+func NewArrayType(elem, dims interface{}) (*ast.ArrayType, error) {
+	elemType, err := NewType(elem)
+	if err != nil {
+		return nil, errutil.Newf("invalid array element type; %v", err)
+	}
+	ds, ok := dims.([]*ast.ArrayType)
+	if !ok || len(ds) == 0 {
+		return nil, errutil.Newf("invalid array dimensions type; expected non-empty []*ast.ArrayType, got %T", dims)
+	}
+	for i := len(ds) - 1; i >= 0; i-- {
+		ds[i].Elem = elemType
+		elemType = ds[i]
+	}
+	return ds[0], nil
+}
+
+// NewArrayDims returns a new array dimension list, based on the following
+// production rules.
+//
+//    ArrayDims
+//       : "[" Expr "]"
+//       | "[" "]"
+//    ;
+func NewArrayDims(lbracket, length, rbracket interface{}) ([]*ast.ArrayType, error) {
+	dim, err := newArrayDim(lbracket, length, rbracket)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return []*ast.ArrayType{dim}, nil
+}
+
+// AppendArrayDim appends an array dimension to the array dimension list, based
+// on the following production rule.
+//
+//    ArrayDims
+//       : ArrayDims "[" Expr "]"
+//    ;
+func AppendArrayDim(list, lbracket, length, rbracket interface{}) ([]*ast.ArrayType, error) {
+	lst, ok := list.([]*ast.ArrayType)
 	if !ok {
-		return nil, errutil.Newf("invalid array length type; %T", length)
+		return nil, errutil.Newf("invalid array dimension list type; expected []*ast.ArrayType, got %T", list)
+	}
+	dim, err := newArrayDim(lbracket, length, rbracket)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return append(lst, dim), nil
+}
+
+// newArrayDim returns a new array type of the given length expression, without
+// element type. The length of unsized arrays is specified by the integer 0.
+func newArrayDim(lbracket, length, rbracket interface{}) (*ast.ArrayType, error) {
+	var lenExpr ast.Expr
+	var len int
+	switch length := length.(type) {
+	case ast.Expr:
+		lenExpr = length
+		// The length of integer literal length expressions is known after
+		// parsing; the length of other constant expressions is evaluated during
+		// semantic analysis.
+		if lit, ok := length.(*ast.BasicLit); ok && lit.Kind == token.IntLit {
+			if x, err := util.UintValue([]byte(lit.Val)); err == nil && x <= math.MaxInt32 {
+				len = int(x)
+			}
+		}
+	case int:
+		if length != 0 {
+			return nil, errutil.Newf("invalid array length; expected 0 for unsized arrays, got %d", length)
+		}
+	default:
+		return nil, errutil.Newf("invalid array length type; expected ast.Expr or int, got %T", length)
 	}
 
-	var lbrack, rbrack int
+	var lbrack, rbrack token.Pos
 	switch lbracket := lbracket.(type) {
 	case *gocctoken.Token:
-		lbrack = lbracket.Offset
+		lbrack = token.Pos(lbracket.Offset)
 	case int:
-		lbrack = lbracket
+		lbrack = token.Pos(lbracket)
 	default:
 		return nil, errutil.Newf("invalid left-bracket type; expectd *gocctoken.Token or int, got %T", lbracket)
 	}
 	switch rbracket := rbracket.(type) {
 	case *gocctoken.Token:
-		rbrack = rbracket.Offset
+		rbrack = token.Pos(rbracket.Offset)
 	case int:
-		rbrack = rbracket
+		rbrack = token.Pos(rbracket)
 	default:
 		return nil, errutil.Newf("invalid right-bracket type; expectd *gocctoken.Token or int, got %T", rbracket)
 	}
+	return &ast.ArrayType{Lbracket: lbrack, LenExpr: lenExpr, Len: len, Rbracket: rbrack}, nil
+}
 
+// NewPointerType returns a new pointer type based on the given element type.
+func NewPointerType(elem, star interface{}) (*ast.PointerType, error) {
+	starTok, ok := star.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid asterisk type; expectd *gocctoken.Token, got %T", star)
+	}
 	elemType, err := NewType(elem)
 	if err != nil {
-		return nil, errutil.Newf("invalid array element type; %v", err)
+		return nil, errutil.Newf("invalid pointer element type; %v", err)
+	}
+	return &ast.PointerType{Elem: elemType, Star: token.Pos(starTok.Offset)}, nil
+}
+
+// NewStructType returns a new structure type, based on the following
+// production rules.
+//
+//    StructType
+//       : "struct" ident "{" FieldList "}"
+//       | "struct" "{" FieldList "}"
+//       | "struct" ident
+//    ;
+func NewStructType(structToken, tag, lbrace, fields, rbrace interface{}) (*ast.StructType, error) {
+	structTok, ok := structToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid struct keyword type; expectd *gocctoken.Token, got %T", structToken)
+	}
+	typ := &ast.StructType{Struct: token.Pos(structTok.Offset), Lbrace: token.NoPos, Rbrace: token.NoPos}
+	if tag != nil {
+		ident, err := NewIdent(tag)
+		if err != nil {
+			return nil, errutil.Newf("invalid structure tag; %v", err)
+		}
+		typ.Tag = ident
+	}
+	if lbrace == nil {
+		// Reference to tagged structure type.
+		return typ, nil
+	}
+	lbraceTok, ok := lbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-brace type; expectd *gocctoken.Token, got %T", lbrace)
+	}
+	rbraceTok, ok := rbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-brace type; expectd *gocctoken.Token, got %T", rbrace)
+	}
+	if fields, ok := fields.([]*ast.VarDecl); ok {
+		typ.Lbrace = token.Pos(lbraceTok.Offset)
+		typ.Fields = fields
+		typ.Rbrace = token.Pos(rbraceTok.Offset)
+		return typ, nil
+	}
+	return nil, errutil.Newf("invalid structure fields type; expected []*ast.VarDecl, got %T", fields)
+}
+
+// NewFieldList returns a new structure field list, based on the following
+// production rule.
+//
+//    FieldList
+//       : VarDecl ";"
+//    ;
+func NewFieldList(field interface{}) ([]*ast.VarDecl, error) {
+	if field, ok := field.(*ast.VarDecl); ok {
+		return []*ast.VarDecl{field}, nil
+	}
+	return nil, errutil.Newf("invalid field list field type; expected *ast.VarDecl, got %T", field)
+}
+
+// AppendField appends field to the structure field list, based on the following
+// production rule.
+//
+//    FieldList
+//       : FieldList VarDecl ";"
+//    ;
+func AppendField(list, field interface{}) ([]*ast.VarDecl, error) {
+	lst, ok := list.([]*ast.VarDecl)
+	if !ok {
+		return nil, errutil.Newf("invalid field list type; expected []*ast.VarDecl, got %T", list)
+	}
+	if field, ok := field.(*ast.VarDecl); ok {
+		return append(lst, field), nil
+	}
+	return nil, errutil.Newf("invalid field list field type; expected *ast.VarDecl, got %T", field)
+}
+
+// NewEnumType returns a new enumeration type, based on the following production
+// rules.
+//
+//    EnumType
+//       : "enum" ident "{" EnumConstList "}"
+//       | "enum" ident "{" EnumConstList "," "}"
+//       | "enum" "{" EnumConstList "}"
+//       | "enum" "{" EnumConstList "," "}"
+//       | "enum" ident
+//    ;
+func NewEnumType(enumToken, tag, lbrace, consts, rbrace interface{}) (*ast.EnumType, error) {
+	enumTok, ok := enumToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid enum keyword type; expected *gocctoken.Token, got %T", enumToken)
+	}
+	typ := &ast.EnumType{Enum: token.Pos(enumTok.Offset), Lbrace: token.NoPos, Rbrace: token.NoPos}
+	if tag != nil {
+		ident, err := NewIdent(tag)
+		if err != nil {
+			return nil, errutil.Newf("invalid enumeration tag; %v", err)
+		}
+		typ.Tag = ident
+	}
+	if lbrace == nil {
+		// Reference to tagged enumeration type.
+		return typ, nil
+	}
+	lbraceTok, ok := lbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-brace type; expected *gocctoken.Token, got %T", lbrace)
+	}
+	rbraceTok, ok := rbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-brace type; expected *gocctoken.Token, got %T", rbrace)
+	}
+	if consts, ok := consts.([]*ast.EnumConst); ok {
+		typ.Lbrace = token.Pos(lbraceTok.Offset)
+		typ.Consts = consts
+		typ.Rbrace = token.Pos(rbraceTok.Offset)
+		return typ, nil
+	}
+	return nil, errutil.Newf("invalid enumeration constants type; expected []*ast.EnumConst, got %T", consts)
+}
+
+// NewEnumConst returns a new enumeration constant, based on the following
+// production rules.
+//
+//    EnumConst
+//       : ident
+//       | ident "=" ConstExpr
+//    ;
+func NewEnumConst(name, assign, val interface{}) (*ast.EnumConst, error) {
+	ident, err := NewIdent(name)
+	if err != nil {
+		return nil, errutil.Newf("invalid enumeration constant name; %v", err)
+	}
+	decl := &ast.EnumConst{ConstName: ident, Assign: token.NoPos}
+	// The name of the enumeration constant refers to its own declaration.
+	ident.Decl = decl
+	if assign == nil {
+		// Implicit value.
+		return decl, nil
+	}
+	assignTok, ok := assign.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid assignment operator type; expected *gocctoken.Token, got %T", assign)
+	}
+	if val, ok := val.(ast.Expr); ok {
+		decl.Assign = token.Pos(assignTok.Offset)
+		decl.ValExpr = val
+		return decl, nil
+	}
+	return nil, errutil.Newf("invalid enumeration constant value type; expected ast.Expr, got %T", val)
+}
+
+// NewEnumConstList returns a new enumeration constant list, based on the
+// following production rule.
+//
+//    EnumConstList
+//       : EnumConst
+//    ;
+func NewEnumConstList(c interface{}) ([]*ast.EnumConst, error) {
+	if c, ok := c.(*ast.EnumConst); ok {
+		return []*ast.EnumConst{c}, nil
+	}
+	return nil, errutil.Newf("invalid enumeration constant list constant type; expected *ast.EnumConst, got %T", c)
+}
+
+// AppendEnumConst appends c to the enumeration constant list, based on the
+// following production rule.
+//
+//    EnumConstList
+//       : EnumConstList "," EnumConst
+//    ;
+func AppendEnumConst(list, c interface{}) ([]*ast.EnumConst, error) {
+	lst, ok := list.([]*ast.EnumConst)
+	if !ok {
+		return nil, errutil.Newf("invalid enumeration constant list type; expected []*ast.EnumConst, got %T", list)
+	}
+	if c, ok := c.(*ast.EnumConst); ok {
+		return append(lst, c), nil
 	}
-	return &ast.ArrayType{Elem: elemType, Lbracket: lbrack, Len: len, Rbracket: rbrack}, nil
+	return nil, errutil.Newf("invalid enumeration constant list constant type; expected *ast.EnumConst, got %T", c)
 }
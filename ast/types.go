@@ -3,9 +3,15 @@ package ast
 import (
 	"fmt"
 
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
+// NewType returns a new type equivalent to the given type node.
+func NewType(n Type) types.Type {
+	return newType(n)
+}
+
 // newType returns a new type equivalent to the given type node.
 func newType(n Node) types.Type {
 	switch n := n.(type) {
@@ -16,7 +22,45 @@ func newType(n Node) types.Type {
 		for i := range n.Params {
 			params[i] = newField(n.Params[i])
 		}
-		return &types.Func{Result: newType(n.Result), Params: params}
+		return &types.Func{Result: newType(n.Result), Params: params, Variadic: n.IsVariadic()}
+	case *PointerType:
+		return &types.Pointer{Elem: newType(n.Elem)}
+	case *StructType:
+		if n.Tag == nil {
+			return &types.Struct{Fields: newFields(n.Fields)}
+		}
+		def, ok := n.Tag.Decl.(*TypeDef)
+		if !ok {
+			// Unresolved structure tag.
+			return &types.Struct{Tag: n.Tag.Name}
+		}
+		// The type of a tagged structure type is stored in the type definition of
+		// its tag, so that the fields may refer to the structure type itself.
+		if def.Val == nil {
+			def.Val = &types.Struct{Tag: n.Tag.Name, Incomplete: true}
+		}
+		typ, ok := def.Val.(*types.Struct)
+		if !ok {
+			// Invalid structure tag.
+			return def.Val
+		}
+		// The structure type is completed once the type definition of its tag
+		// refers to the structure definition; i.e. the structure type may have
+		// been used before its definition, as in `struct node *next;`.
+		if st := def.DeclType.(*StructType); typ.Incomplete && st.Lbrace.IsValid() {
+			typ.Incomplete = false
+			typ.Fields = newFields(st.Fields)
+		}
+		return typ
+	case *EnumType:
+		// "Each enumerated type shall be compatible with char, a signed integer
+		// type, or an unsigned integer type. The choice of type is
+		// implementation-defined" [C99 draft 6.7.2.2.4]
+		//
+		// In accordance with Clang and GCC, enumerated types are compatible with
+		// int, as the values of all enumeration constants are representable as
+		// int.
+		return &types.Basic{Kind: types.Int}
 	case *Ident:
 		if n.Decl == nil {
 			return newBasic(n)
@@ -36,37 +80,33 @@ func newField(decl *VarDecl) *types.Field {
 	return typ
 }
 
+// newFields returns new field types equivalent to the given structure fields.
+func newFields(decls []*VarDecl) []*types.Field {
+	fields := make([]*types.Field, len(decls))
+	for i := range decls {
+		fields[i] = newField(decls[i])
+	}
+	return fields
+}
+
 // universePos specifies a pseudo-position used for identifiers declared in the
 // universe scope.
-const universePos = -1
+const universePos = token.NoPos
 
 // newBasic returns a new basic type equivalent to the given identifier.
 func newBasic(ident *Ident) types.Type {
 	// TODO: Check if we may come up with a cleaner solution. At least, this
 	// works for now.
-	switch ident.Name {
-	case "char":
-		charIdent := &Ident{NamePos: universePos, Name: "char"}
-		charType := &types.Basic{Kind: types.Char}
-		charDecl := &TypeDef{DeclType: charIdent, TypeName: charIdent, Val: charType}
-		charIdent.Decl = charDecl
-		ident.Decl = charDecl
-		return charType
-	case "int":
-		intIdent := &Ident{NamePos: universePos, Name: "int"}
-		intType := &types.Basic{Kind: types.Int}
-		intDecl := &TypeDef{DeclType: intIdent, TypeName: intIdent, Val: intType}
-		intIdent.Decl = intDecl
-		ident.Decl = intDecl
-		return intType
-	case "void":
-		voidIdent := &Ident{NamePos: universePos, Name: "void"}
-		voidType := &types.Basic{Kind: types.Void}
-		voidDecl := &TypeDef{DeclType: voidIdent, TypeName: voidIdent, Val: voidType}
-		voidIdent.Decl = voidDecl
-		ident.Decl = voidDecl
-		return voidType
-	default:
-		panic(fmt.Sprintf("support for user-defined basic type %q not yet fully supported", ident.Name))
+	for _, kind := range types.BasicKinds {
+		typ := &types.Basic{Kind: kind}
+		if ident.Name != typ.String() {
+			continue
+		}
+		basicIdent := &Ident{NamePos: universePos, Name: ident.Name}
+		basicDecl := &TypeDef{DeclType: basicIdent, TypeName: basicIdent, Val: typ}
+		basicIdent.Decl = basicDecl
+		ident.Decl = basicDecl
+		return typ
 	}
+	panic(fmt.Sprintf("support for user-defined basic type %q not yet fully supported", ident.Name))
 }
@@ -34,9 +34,11 @@ import (
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
 	handscanner "github.com/mewmew/uc/hand/scanner"
 	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/preproc"
 	"github.com/mewmew/uc/sem"
 	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
+	"github.com/mewmew/uc/token"
 )
 
 func usage() {
@@ -70,10 +72,6 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
-	// TODO: Remove once nested functions are supported. For now, disallow during
-	// semantic analysis.
-	semcheck.NoNestedFunctions = true
-
 	// Parse input.
 	for _, path := range flag.Args() {
 		err := compileFile(path, outputPath, goccLexer)
@@ -101,37 +99,62 @@ func compileFile(path string, outputPath string, goccLexer bool) error {
 
 	fmt.Fprintf(os.Stderr, "Compiling %q\n", path)
 
+	// Preprocess input.
+	res, err := preproc.Preprocess(path, buf, nil)
+	if err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
+	}
+	buf = res.Output
+
+	fset := token.NewFileSet()
+	srcFile := res.AddFile(fset, path)
 	var s parser.Scanner
 	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
+		s = goccscanner.NewFromFile(srcFile, buf)
 	} else {
-		s = handscanner.NewFromBytes(buf)
+		s = handscanner.NewFromFile(srcFile, buf)
 	}
 
+	input := string(buf)
+	src := semerrors.NewFileSource(srcFile, input)
+
 	// Parse input.
 	p := parser.NewParser()
-	f, err := p.Parse(s)
+	f, err := p.ParseAll(s)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(goccerrors.List); ok {
+			// Unwrap Gocc errors.
+			return parser.NewErrorList(src, errs)
 		}
 		return errutil.Err(err)
 	}
 	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
 	info, err := sem.Check(file)
 	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+		e, ok := err.(*errutil.ErrInfo)
+		if !ok {
+			return errutil.Err(err)
 		}
-		return errutil.Err(err)
+		// Unwrap errutil error.
+		errs, ok := e.Err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		// Unwrap semantic analysis errors, and add input source information.
+		errs.SetSource(src)
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
 	}
 
 	// Generate LLVM IR module based on the syntax tree of the given file.
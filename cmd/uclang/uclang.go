@@ -5,16 +5,24 @@
 //
 // If FILE is -, read standard input.
 //
+//   -I value
+//        add directory to include search path
+//   -Werror
+//        treat warnings as errors
 //   -debug
 //        enable debug output
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -max-errors int
+//        maximum number of errors to report (0 reports all) (default 20)
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
 //        disable support for nested functions
 //   -o string
 //        output path
+//   -w
+//        inhibit all warnings
 package main
 
 import (
@@ -23,6 +31,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/kr/pretty"
 	"github.com/mewkiz/pkg/errutil"
@@ -33,9 +42,11 @@ import (
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
 	handscanner "github.com/mewmew/uc/hand/scanner"
 	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/preproc"
 	"github.com/mewmew/uc/sem"
 	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
+	"github.com/mewmew/uc/token"
 )
 
 func usage() {
@@ -51,6 +62,23 @@ If FILE is -, read standard input.
 // debug specifies whether to enable debug output.
 var debug bool
 
+// includePaths specifies the directories searched for included files.
+var includePaths stringsFlag
+
+// stringsFlag is a repeatable command line flag of string values.
+type stringsFlag []string
+
+// String returns the string representation of the flag values.
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+// Set appends the given value to the flag values.
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 func main() {
 	var (
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
@@ -61,9 +89,13 @@ func main() {
 		// outputPath specifies the output path for the generated LLVM IR.
 		outputPath string
 	)
+	flag.Var(&includePaths, "I", "add directory to include search path")
 	flag.BoolVar(&debug, "debug", false, "enable debug output")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.IntVar(&semerrors.MaxErrors, "max-errors", semerrors.MaxErrors, "maximum number of errors to report (0 reports all)")
+	flag.BoolVar(&semerrors.NoWarnings, "w", false, "inhibit all warnings")
+	flag.BoolVar(&semerrors.WarningsAsErrors, "Werror", false, "treat warnings as errors")
 	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.StringVar(&outputPath, "o", "", "output path")
 	flag.Usage = usage
@@ -73,10 +105,6 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
-	// TODO: Remove once nested functions are supported. For now, disallow during
-	// semantic analysis.
-	semcheck.NoNestedFunctions = true
-
 	// Parse input.
 	output := os.Stdout
 	if len(outputPath) > 0 {
@@ -113,37 +141,62 @@ func compileFile(path string, output io.Writer, goccLexer bool) error {
 
 	fmt.Fprintf(os.Stderr, "Compiling %q\n", path)
 
+	// Preprocess input.
+	res, err := preproc.Preprocess(path, buf, includePaths)
+	if err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
+	}
+	buf = res.Output
+
+	fset := token.NewFileSet()
+	srcFile := res.AddFile(fset, path)
 	var s parser.Scanner
 	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
+		s = goccscanner.NewFromFile(srcFile, buf)
 	} else {
-		s = handscanner.NewFromBytes(buf)
+		s = handscanner.NewFromFile(srcFile, buf)
 	}
 
+	input := string(buf)
+	src := semerrors.NewFileSource(srcFile, input)
+
 	// Parse input.
 	p := parser.NewParser()
-	f, err := p.Parse(s)
+	f, err := p.ParseAll(s)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(goccerrors.List); ok {
+			// Unwrap Gocc errors.
+			return parser.NewErrorList(src, errs)
 		}
 		return errutil.Err(err)
 	}
 	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
 	info, err := sem.Check(file)
 	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+		e, ok := err.(*errutil.ErrInfo)
+		if !ok {
+			return errutil.Err(err)
 		}
-		return errutil.Err(err)
+		// Unwrap errutil error.
+		errs, ok := e.Err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		// Unwrap semantic analysis errors, and add input source information.
+		errs.SetSource(src)
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
 	}
 
 	// Generate LLVM IR module based on the syntax tree of the given file.
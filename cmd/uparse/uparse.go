@@ -7,6 +7,8 @@
 //
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -no-colors
+//        disable colors in output
 package main
 
 import (
@@ -24,6 +26,9 @@ import (
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
 	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/preproc"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 )
 
 func usage() {
@@ -41,10 +46,14 @@ func main() {
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
 	)
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
 	flag.Usage = usage
 	flag.Parse()
+	semerrors.UseColor = !noColors
 	if flag.NArg() == 0 {
 		flag.Usage()
 		os.Exit(1)
@@ -72,20 +81,40 @@ func parseFile(path string, goccLexer bool) error {
 	} else {
 		fmt.Fprintf(os.Stderr, "Parsing %q\n", path)
 	}
+	// Preprocess input.
+	res, err := preproc.Preprocess(path, buf, nil)
+	if err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
+	}
+	buf = res.Output
+
+	fset := token.NewFileSet()
+	srcFile := res.AddFile(fset, path)
 	var s parser.Scanner
 	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
+		s = goccscanner.NewFromFile(srcFile, buf)
 	} else {
-		s = handscanner.NewFromBytes(buf)
+		s = handscanner.NewFromFile(srcFile, buf)
 	}
 
+	input := string(buf)
+	src := semerrors.NewFileSource(srcFile, input)
+
 	// Parse input.
 	p := parser.NewParser()
-	file, err := p.Parse(s)
+	file, err := p.ParseAll(s)
 	if err != nil {
-		if err, ok := err.(*errors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(errors.List); ok {
+			// Unwrap Gocc errors.
+			return parser.NewErrorList(src, errs)
 		}
 		return errutil.Err(err)
 	}
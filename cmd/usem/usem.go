@@ -5,12 +5,18 @@
 //
 // If FILE is -, read standard input.
 //
+//   -Werror
+//        treat warnings as errors
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -max-errors int
+//        maximum number of errors to report (0 reports all) (default 20)
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
 //        disable support for nested functions
+//   -w
+//        inhibit all warnings
 package main
 
 import (
@@ -26,9 +32,11 @@ import (
 	"github.com/mewmew/uc/gocc/parser"
 	goccscanner "github.com/mewmew/uc/gocc/scanner"
 	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/preproc"
 	"github.com/mewmew/uc/sem"
 	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
+	"github.com/mewmew/uc/token"
 )
 
 func usage() {
@@ -51,6 +59,9 @@ func main() {
 	)
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.IntVar(&semerrors.MaxErrors, "max-errors", semerrors.MaxErrors, "maximum number of errors to report (0 reports all)")
+	flag.BoolVar(&semerrors.NoWarnings, "w", false, "inhibit all warnings")
+	flag.BoolVar(&semerrors.WarningsAsErrors, "Werror", false, "treat warnings as errors")
 	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.Usage = usage
 	flag.Parse()
@@ -61,16 +72,25 @@ func main() {
 	}
 
 	// Parse input.
+	failed := false
 	for _, path := range flag.Args() {
 		err := checkFile(path, goccLexer)
 		if err != nil {
-			if _, ok := err.(*semerrors.Error); ok {
-				elog.Print(err)
+			if errs, ok := err.(semerrors.List); ok {
+				elog.Print(errs)
+				if !errs.HasErrors() {
+					// Only warnings reported.
+					continue
+				}
 			} else {
 				log.Print(err)
 			}
+			failed = true
 		}
 	}
+	if failed {
+		os.Exit(1)
+	}
 }
 
 // checkFile performs a static semantic analysis check on the given file.
@@ -96,33 +116,52 @@ func checkFile(path string, goccLexer bool) error {
 		path = "<stdin>"
 	}
 	fmt.Fprintf(os.Stderr, "Checking %q\n", path)
+	// Preprocess input.
+	res, err := preproc.Preprocess(path, buf, nil)
+	if err != nil {
+		errs, ok := err.(semerrors.List)
+		if !ok {
+			return errutil.Err(err)
+		}
+		if errs.HasErrors() {
+			return errs
+		}
+		// Report warnings, and continue compilation.
+		fmt.Fprintln(os.Stderr, errs)
+	}
+	buf = res.Output
+
+	fset := token.NewFileSet()
+	srcFile := res.AddFile(fset, path)
 	var s parser.Scanner
 	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
+		s = goccscanner.NewFromFile(srcFile, buf)
 	} else {
-		s = handscanner.NewFromBytes(buf)
+		s = handscanner.NewFromFile(srcFile, buf)
 	}
 
+	input := string(buf)
+	src := semerrors.NewFileSource(srcFile, input)
+
 	// Parse input.
 	p := parser.NewParser()
-	f, err := p.Parse(s)
+	f, err := p.ParseAll(s)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		if errs, ok := err.(goccerrors.List); ok {
+			// Unwrap Gocc errors.
+			return parser.NewErrorList(src, errs)
 		}
 		return errutil.Err(err)
 	}
 	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
 	if _, err := sem.Check(file); err != nil {
 		if err, ok := err.(*errutil.ErrInfo); ok {
 			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
+			if errs, ok := err.Err.(semerrors.List); ok {
+				// Unwrap semantic analysis errors, and add input source
+				// information.
+				errs.SetSource(src)
+				return errs
 			}
 		}
 		return errutil.Err(err)
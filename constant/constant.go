@@ -0,0 +1,307 @@
+// Package constant implements the evaluation of integer constant expressions of
+// µC.
+package constant
+
+import (
+	"fmt"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/util"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// A Value represents the value of an integer constant expression, truncated to
+// the size of its type.
+type Value int64
+
+// An UndefinedError reports an operation of undefined behaviour in a constant
+// expression; e.g. division by zero. Such operations are only erroneous where an
+// integer constant expression is required, such as in array sizes.
+type UndefinedError struct {
+	// Binary expression of the operation.
+	Expr *ast.BinaryExpr
+	// Description of the undefined behaviour; e.g. "division by zero".
+	Desc string
+}
+
+// Error returns an error message describing the undefined operation.
+func (e *UndefinedError) Error() string {
+	return fmt.Sprintf("%s in constant expression %v", e.Desc, e.Expr)
+}
+
+// Fold folds the given expression to a constant value, based on the previously
+// folded values of its subexpressions and the deduced types of exprTypes. The
+// boolean result reports whether the expression is an integer constant
+// expression. Operations of undefined behaviour are reported by an
+// *UndefinedError.
+//
+// Operands which are not evaluated, such as the second operand of 0 && x, need
+// not be integer constant expressions.
+//
+// "An integer constant expression shall have integer type and shall only have
+// operands that are integer constants, enumeration constants, character
+// constants, sizeof expressions whose results are integer constants, and
+// floating constants that are the immediate operands of casts." [C99 draft
+// 6.6.6]
+func Fold(n ast.Expr, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]Value) (Value, bool, error) {
+	typ := exprTypes[n]
+	if !types.IsInteger(typ) {
+		return 0, false, nil
+	}
+	switch n := n.(type) {
+	case *ast.BasicLit:
+		switch n.Kind {
+		case token.CharLit:
+			r, err := util.CharValue([]byte(n.Val))
+			if err != nil {
+				return 0, false, errors.Newf(n.ValPos, "invalid character literal %s; %v", n.Val, err)
+			}
+			// Character literals of type char are signed; e.g. '\xFF' has the
+			// value -1.
+			return Convert(int64(int8(r)), typ), true, nil
+		case token.IntLit:
+			x, err := util.UintValue([]byte(n.Val))
+			if err != nil {
+				return 0, false, errors.Newf(n.ValPos, "invalid integer constant %s; %v", n.Val, err)
+			}
+			return Convert(int64(x), typ), true, nil
+		}
+		return 0, false, nil
+	case *ast.Ident:
+		// "An identifier declared as an enumeration constant has type int."
+		// [C99 draft 6.4.4.3.2]
+		if c, ok := n.Decl.(*ast.EnumConst); ok {
+			return Convert(int64(c.Val), typ), true, nil
+		}
+		return 0, false, nil
+	case *ast.ParenExpr:
+		x, ok := values[n.X]
+		return x, ok, nil
+	case *ast.CastExpr:
+		x, ok := values[n.X]
+		if !ok {
+			return 0, false, nil
+		}
+		return Convert(int64(x), typ), true, nil
+	case *ast.SizeofExpr:
+		// "If the type of the operand is a variable length array type, the
+		// operand is evaluated; otherwise, the operand is not evaluated and the
+		// result is an integer constant." [C99 draft 6.5.3.4.2]
+		operandType := exprTypes[n.X]
+		if n.Type != nil {
+			operandType = ast.NewType(n.Type)
+		}
+		return Value(types.DefaultSizes.Sizeof(operandType)), true, nil
+	case *ast.UnaryExpr:
+		x, ok := values[n.X]
+		if !ok {
+			return 0, false, nil
+		}
+		switch n.Op {
+		case token.Sub:
+			return Convert(-int64(x), typ), true, nil
+		case token.Tilde:
+			return Convert(^int64(x), typ), true, nil
+		case token.Not:
+			return boolValue(x == 0), true, nil
+		}
+		return 0, false, nil
+	case *ast.BinaryExpr:
+		if n.Op.IsAssign() {
+			return 0, false, nil
+		}
+		x, ok := values[n.X]
+		if !ok {
+			return 0, false, nil
+		}
+		// "the second operand is not evaluated if the first operand compares
+		// equal to 0." [C99 draft 6.5.13.4]
+		//
+		// "the second operand is not evaluated if the first operand compares
+		// unequal to 0." [C99 draft 6.5.14.4]
+		if (n.Op == token.Land && x == 0) || (n.Op == token.Lor && x != 0) {
+			return boolValue(x != 0), true, nil
+		}
+		y, ok := values[n.Y]
+		if !ok {
+			return 0, false, nil
+		}
+		v, err := binaryOp(n, x, y, exprTypes[n.X], exprTypes[n.Y], typ)
+		if err != nil {
+			return 0, false, err
+		}
+		return v, true, nil
+	case *ast.CondExpr:
+		cond, ok := values[n.Cond]
+		if !ok {
+			return 0, false, nil
+		}
+		// "The first operand is evaluated; [...] the second operand is evaluated
+		// if the first compares unequal to 0; the third operand is evaluated
+		// only if the first compares equal to 0; the result is the value of the
+		// second or third operand (whichever is evaluated), converted to the
+		// type described below." [C99 draft 6.5.15.4]
+		operand := n.Y
+		if cond != 0 {
+			operand = n.X
+		}
+		v, ok := values[operand]
+		if !ok {
+			return 0, false, nil
+		}
+		return Convert(int64(v), typ), true, nil
+	}
+	return 0, false, nil
+}
+
+// binaryOp returns the value of the binary expression n, with operand values x
+// and y of type xType and yType respectively, and result type typ.
+func binaryOp(n *ast.BinaryExpr, x, y Value, xType, yType, typ types.Type) (Value, error) {
+	// The operands of arithmetic and bitwise operators are converted to the type
+	// of the result, and the operands of comparison operators to their common
+	// type. Only the left operand of shift operators is converted, and the
+	// operands of logical operators are compared against zero as is.
+	a, b := int64(x), int64(y)
+	opType := typ
+	switch n.Op {
+	case token.Land, token.Lor:
+	case token.Shl, token.Shr:
+		a = int64(Convert(a, opType))
+	case token.Eq, token.Ne, token.Lt, token.Le, token.Gt, token.Ge:
+		opType = types.ArithmeticConversion(xType, yType)
+		a, b = int64(Convert(a, opType)), int64(Convert(b, opType))
+	default:
+		a, b = int64(Convert(a, opType)), int64(Convert(b, opType))
+	}
+	unsigned := types.IsUnsigned(opType)
+	switch n.Op {
+	case token.Add:
+		return Convert(a+b, typ), nil
+	case token.Sub:
+		return Convert(a-b, typ), nil
+	case token.Mul:
+		return Convert(a*b, typ), nil
+	case token.Div, token.Rem:
+		// "if the value of the second operand is zero, the behavior is
+		// undefined." [C99 draft 6.5.5.5]
+		if b == 0 {
+			return 0, &UndefinedError{Expr: n, Desc: "division by zero"}
+		}
+		switch {
+		case unsigned && n.Op == token.Div:
+			return Convert(int64(uint64(a)/uint64(b)), typ), nil
+		case unsigned:
+			return Convert(int64(uint64(a)%uint64(b)), typ), nil
+		case n.Op == token.Div:
+			return Convert(a/b, typ), nil
+		default:
+			return Convert(a%b, typ), nil
+		}
+	case token.Shl, token.Shr:
+		// "If the value of the right operand is negative or is greater than or
+		// equal to the width of the promoted left operand, the behavior is
+		// undefined." [C99 draft 6.5.7.3]
+		if (!types.IsUnsigned(yType) && b < 0) || uint64(b) >= uint64(bitSize(typ)) {
+			return 0, &UndefinedError{Expr: n, Desc: fmt.Sprintf("shift count %d out of range", b)}
+		}
+		switch {
+		case n.Op == token.Shl:
+			return Convert(a<<uint(b), typ), nil
+		case unsigned:
+			return Convert(int64(uint64(a)>>uint(b)), typ), nil
+		default:
+			return Convert(a>>uint(b), typ), nil
+		}
+	case token.And:
+		return Convert(a&b, typ), nil
+	case token.Or:
+		return Convert(a|b, typ), nil
+	case token.Xor:
+		return Convert(a^b, typ), nil
+	case token.Eq:
+		return boolValue(a == b), nil
+	case token.Ne:
+		return boolValue(a != b), nil
+	case token.Lt, token.Le, token.Gt, token.Ge:
+		return boolValue(compare(n.Op, a, b, unsigned)), nil
+	case token.Land:
+		return boolValue(a != 0 && b != 0), nil
+	case token.Lor:
+		return boolValue(a != 0 || b != 0), nil
+	default:
+		panic(fmt.Sprintf("support for binary operator %v not yet implemented", n.Op))
+	}
+}
+
+// compare reports whether the relation a op b holds for the relational operator
+// op, comparing a and b as unsigned integers if unsigned is true.
+func compare(op token.Kind, a, b int64, unsigned bool) bool {
+	if unsigned {
+		x, y := uint64(a), uint64(b)
+		switch op {
+		case token.Lt:
+			return x < y
+		case token.Le:
+			return x <= y
+		case token.Gt:
+			return x > y
+		default:
+			return x >= y
+		}
+	}
+	switch op {
+	case token.Lt:
+		return a < b
+	case token.Le:
+		return a <= b
+	case token.Gt:
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+// Convert converts the given integer to a value of the specified integer type.
+// Values outside of the range of the type wrap around.
+//
+// "Otherwise, if the new type is unsigned, the value is converted by repeatedly
+// adding or subtracting one more than the maximum value that can be
+// represented in the new type until the value is in the range of the new
+// type." [C99 draft 6.3.1.3.2]
+//
+// "Otherwise, the new type is signed and the value cannot be represented in
+// it; either the result is implementation-defined or an implementation-defined
+// signal is raised." [C99 draft 6.3.1.3.3]
+//
+// In accordance with Clang and GCC, the value is truncated to the width of the
+// type, and sign extended if the type is signed. Values of unsigned long long
+// type are stored in two's complement form.
+func Convert(x int64, typ types.Type) Value {
+	shift := 64 - bitSize(typ)
+	if types.IsUnsigned(typ) {
+		return Value(int64(uint64(x) << shift >> shift))
+	}
+	return Value(x << shift >> shift)
+}
+
+// bitSize returns the size in bits of the given integer type.
+func bitSize(typ types.Type) uint {
+	if !types.IsInteger(typ) {
+		panic(fmt.Sprintf("invalid integer type %v", typ))
+	}
+	return uint(8 * types.DefaultSizes.Sizeof(typ))
+}
+
+// boolValue returns the integer value of the given boolean; 1 if true and 0
+// otherwise.
+//
+// "Each of the operators [...] shall yield 1 if the specified relation is true
+// and 0 if it is false. The result has type int." [C99 draft 6.5.8.6]
+func boolValue(x bool) Value {
+	if x {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,248 @@
+package constant_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/constant"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+func TestConvert(t *testing.T) {
+	golden := []struct {
+		x    int64
+		kind types.BasicKind
+		want constant.Value
+	}{
+		{x: 255, kind: types.Char, want: -1},
+		{x: 256, kind: types.Char, want: 0},
+		{x: -1, kind: types.UnsignedChar, want: 255},
+		{x: 65536 + 42, kind: types.Short, want: 42},
+		{x: 0x80000000, kind: types.Int, want: -2147483648},
+		{x: 1 << 32, kind: types.Int, want: 0},
+		{x: -1, kind: types.UnsignedInt, want: 4294967295},
+		{x: 1 << 32, kind: types.Long, want: 1 << 32},
+		{x: -1, kind: types.UnsignedLongLong, want: -1},
+	}
+	for _, g := range golden {
+		got := constant.Convert(g.x, &types.Basic{Kind: g.kind})
+		if got != g.want {
+			t.Errorf("%d converted to %v: value mismatch; expected %d, got %d", g.x, g.kind, g.want, got)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	golden := []struct {
+		// Expression to fold.
+		expr func(e *env) ast.Expr
+		// Folded value, and whether the expression is an integer constant
+		// expression.
+		want constant.Value
+		ok   bool
+		// Error message, if any.
+		err string
+	}{
+		// Arithmetic.
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Add, e.lit("2"), types.Int) },
+			want: 3, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("2147483647"), token.Add, e.lit("1"), types.Int) },
+			want: -2147483648, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.unary(token.Sub, e.lit("1"), types.UnsignedInt) },
+			want: 4294967295, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.unary(token.Tilde, e.lit("0"), types.Int) },
+			want: -1, ok: true,
+		},
+		// Division.
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("7"), token.Div, e.lit("2"), types.Int) },
+			want: 3, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.unary(token.Sub, e.lit("7"), types.Int), token.Rem, e.lit("3"), types.Int)
+			},
+			want: -1, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.unary(token.Sub, e.lit("2"), types.UnsignedInt), token.Div, e.lit("2"), types.UnsignedInt)
+			},
+			want: 2147483647, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Div, e.lit("0"), types.Int) },
+			err:  "division by zero in constant expression 1 / 0",
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Rem, e.lit("0"), types.Int) },
+			err:  "division by zero in constant expression 1 % 0",
+		},
+		// Shifts.
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Shl, e.lit("31"), types.Int) },
+			want: -2147483648, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.unary(token.Sub, e.lit("16"), types.Int), token.Shr, e.lit("2"), types.Int)
+			},
+			want: -4, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.unary(token.Sub, e.lit("16"), types.UnsignedInt), token.Shr, e.lit("2"), types.UnsignedInt)
+			},
+			want: 1073741820, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Shl, e.lit("32"), types.Int) },
+			err:  "shift count 32 out of range in constant expression 1 << 32",
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.lit("1"), token.Shl, e.unary(token.Sub, e.lit("1"), types.Int), types.Int)
+			},
+			err: "shift count -1 out of range in constant expression 1 << -1",
+		},
+		// Comparisons.
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.unary(token.Sub, e.lit("1"), types.Int), token.Lt, e.lit("0"), types.Int)
+			},
+			want: 1, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr {
+				// The signed operand is converted to unsigned int.
+				return e.binary(e.unary(token.Sub, e.lit("1"), types.Int), token.Lt, e.typed(e.lit("0"), types.UnsignedInt), types.Int)
+			},
+			want: 0, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("2"), token.Eq, e.lit("2"), types.Int) },
+			want: 1, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("2"), token.Ge, e.lit("3"), types.Int) },
+			want: 0, ok: true,
+		},
+		// Unevaluated operands.
+		{
+			expr: func(e *env) ast.Expr {
+				return e.binary(e.lit("0"), token.Land, e.binary(e.lit("1"), token.Div, e.lit("0"), types.Int), types.Int)
+			},
+			want: 0, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("2"), token.Lor, e.ident("x"), types.Int) },
+			want: 1, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.binary(e.lit("1"), token.Land, e.ident("x"), types.Int) },
+			ok:   false,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.cond(e.lit("1"), e.lit("2"), e.ident("x"), types.Int) },
+			want: 2, ok: true,
+		},
+		{
+			expr: func(e *env) ast.Expr { return e.cond(e.lit("0"), e.lit("2"), e.ident("x"), types.Int) },
+			ok:   false,
+		},
+	}
+	for _, g := range golden {
+		e := &env{exprTypes: make(map[ast.Expr]types.Type), values: make(map[ast.Expr]constant.Value), errs: make(map[ast.Expr]error)}
+		expr := g.expr(e)
+		if err := e.errs[expr]; err != nil {
+			if g.err == "" {
+				t.Errorf("%v: unexpected error; %v", expr, err)
+			} else if got := err.Error(); got != g.err {
+				t.Errorf("%v: error mismatch; expected %q, got %q", expr, g.err, got)
+			} else if _, ok := err.(*constant.UndefinedError); !ok {
+				t.Errorf("%v: error type mismatch; expected *constant.UndefinedError, got %T", expr, err)
+			}
+			continue
+		}
+		if g.err != "" {
+			t.Errorf("%v: expected error %q, got nil", expr, g.err)
+			continue
+		}
+		got, ok := e.values[expr]
+		if ok != g.ok {
+			t.Errorf("%v: integer constant expression mismatch; expected %v, got %v", expr, g.ok, ok)
+			continue
+		}
+		if got != g.want {
+			t.Errorf("%v: value mismatch; expected %d, got %d", expr, g.want, got)
+		}
+	}
+}
+
+// env records the types and folded values of the expressions of a test case.
+type env struct {
+	exprTypes map[ast.Expr]types.Type
+	values    map[ast.Expr]constant.Value
+	// Errors encountered while folding expressions.
+	errs map[ast.Expr]error
+}
+
+// fold records the given type of n and folds its value.
+func (e *env) fold(n ast.Expr, kind types.BasicKind) ast.Expr {
+	e.exprTypes[n] = &types.Basic{Kind: kind}
+	v, ok, err := constant.Fold(n, e.exprTypes, e.values)
+	if err != nil {
+		e.errs[n] = err
+		return n
+	}
+	if ok {
+		e.values[n] = v
+	}
+	return n
+}
+
+// lit returns a folded integer literal of type int.
+func (e *env) lit(val string) ast.Expr {
+	return e.fold(&ast.BasicLit{Kind: token.IntLit, Val: val}, types.Int)
+}
+
+// typed returns the given expression, converted to the given type through a
+// parenthesized expression.
+func (e *env) typed(x ast.Expr, kind types.BasicKind) ast.Expr {
+	n := &ast.ParenExpr{X: x}
+	v, ok := e.values[x]
+	e.exprTypes[n] = &types.Basic{Kind: kind}
+	if ok {
+		e.values[n] = constant.Convert(int64(v), e.exprTypes[n])
+	}
+	return n
+}
+
+// ident returns an identifier of type int, which is not an integer constant
+// expression.
+func (e *env) ident(name string) ast.Expr {
+	return e.fold(&ast.Ident{Name: name}, types.Int)
+}
+
+// unary returns a folded unary expression of the given type.
+func (e *env) unary(op token.Kind, x ast.Expr, kind types.BasicKind) ast.Expr {
+	return e.fold(&ast.UnaryExpr{Op: op, X: x}, kind)
+}
+
+// binary returns a folded binary expression of the given type.
+func (e *env) binary(x ast.Expr, op token.Kind, y ast.Expr, kind types.BasicKind) ast.Expr {
+	return e.fold(&ast.BinaryExpr{X: x, Op: op, Y: y}, kind)
+}
+
+// cond returns a folded conditional expression of the given type.
+func (e *env) cond(c, x, y ast.Expr, kind types.BasicKind) ast.Expr {
+	return e.fold(&ast.CondExpr{Cond: c, X: x, Y: y}, kind)
+}
@@ -0,0 +1,28 @@
+package errors
+
+import "bytes"
+
+// A List is a list of syntax errors, in the order encountered by the parser.
+type List []*Error
+
+// Err returns an error equivalent to the list of errors; or nil if the list is
+// empty.
+func (list List) Err() error {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+// Error returns the error strings of the errors in the list, separated by new
+// lines.
+func (list List) Error() string {
+	buf := new(bytes.Buffer)
+	for i, err := range list {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(err.Error())
+	}
+	return buf.String()
+}
@@ -13,8 +13,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 64
-	NumSymbols = 82
+	NumStates  = 186
+	NumSymbols = 239
 )
 
 type Lexer struct {
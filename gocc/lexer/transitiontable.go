@@ -28,70 +28,104 @@ var TransTab = TransitionTable{
 			return 1
 		case r == 33: // ['!','!']
 			return 2
-		case r == 35: // ['#','#']
+		case r == 34: // ['"','"']
 			return 3
-		case r == 38: // ['&','&']
+		case r == 35: // ['#','#']
 			return 4
-		case r == 39: // [''',''']
+		case r == 37: // ['%','%']
 			return 5
-		case r == 40: // ['(','(']
+		case r == 38: // ['&','&']
 			return 6
-		case r == 41: // [')',')']
+		case r == 39: // [''',''']
 			return 7
-		case r == 42: // ['*','*']
+		case r == 40: // ['(','(']
 			return 8
-		case r == 43: // ['+','+']
+		case r == 41: // [')',')']
 			return 9
-		case r == 44: // [',',',']
+		case r == 42: // ['*','*']
 			return 10
-		case r == 45: // ['-','-']
+		case r == 43: // ['+','+']
 			return 11
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 12
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 45: // ['-','-']
 			return 13
-		case r == 59: // [';',';']
+		case r == 46: // ['.','.']
 			return 14
-		case r == 60: // ['<','<']
+		case r == 47: // ['/','/']
 			return 15
-		case r == 61: // ['=','=']
+		case r == 48: // ['0','0']
 			return 16
-		case r == 62: // ['>','>']
+		case 49 <= r && r <= 57: // ['1','9']
 			return 17
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 58: // [':',':']
 			return 18
-		case r == 91: // ['[','[']
+		case r == 59: // [';',';']
 			return 19
-		case r == 93: // [']',']']
+		case r == 60: // ['<','<']
 			return 20
-		case r == 95: // ['_','_']
+		case r == 61: // ['=','=']
 			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
+		case r == 62: // ['>','>']
 			return 22
-		case 102 <= r && r <= 104: // ['f','h']
-			return 18
-		case r == 105: // ['i','i']
+		case r == 63: // ['?','?']
 			return 23
-		case 106 <= r && r <= 113: // ['j','q']
-			return 18
-		case r == 114: // ['r','r']
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 91: // ['[','[']
+			return 25
+		case r == 93: // [']',']']
+			return 26
+		case r == 94: // ['^','^']
+			return 27
+		case r == 95: // ['_','_']
+			return 28
+		case r == 97: // ['a','a']
+			return 24
+		case r == 98: // ['b','b']
+			return 29
+		case r == 99: // ['c','c']
+			return 30
+		case r == 100: // ['d','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 32
+		case r == 102: // ['f','f']
+			return 33
+		case r == 103: // ['g','g']
+			return 34
+		case r == 104: // ['h','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 35
+		case 106 <= r && r <= 107: // ['j','k']
 			return 24
+		case r == 108: // ['l','l']
+			return 36
+		case 109 <= r && r <= 113: // ['m','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 37
 		case r == 115: // ['s','s']
-			return 18
+			return 38
 		case r == 116: // ['t','t']
-			return 25
-		case 117 <= r && r <= 118: // ['u','v']
-			return 18
+			return 39
+		case r == 117: // ['u','u']
+			return 40
+		case r == 118: // ['v','v']
+			return 41
 		case r == 119: // ['w','w']
-			return 26
+			return 42
 		case 120 <= r && r <= 122: // ['x','z']
-			return 18
+			return 24
 		case r == 123: // ['{','{']
-			return 27
+			return 43
+		case r == 124: // ['|','|']
+			return 44
 		case r == 125: // ['}','}']
-			return 28
+			return 45
+		case r == 126: // ['~','~']
+			return 46
 
 		}
 		return NoState
@@ -109,7 +143,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 29
+			return 47
 
 		}
 		return NoState
@@ -118,44 +152,46 @@ var TransTab = TransitionTable{
 	// S3
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 30
-
-		default:
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
 			return 3
-		}
+		case 40 <= r && r <= 91: // ['(','[']
+			return 48
+		case r == 92: // ['\','\']
+			return 50
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 48
 
+		}
+		return NoState
 	},
 
 	// S4
 	func(r rune) int {
 		switch {
-		case r == 38: // ['&','&']
-			return 31
+		case r == 10: // ['\n','\n']
+			return 51
 
+		default:
+			return 4
 		}
-		return NoState
+
 	},
 
 	// S5
 	func(r rune) int {
 		switch {
-		case 1 <= r && r <= 9: // [\u0001,'\t']
-			return 32
-		case 11 <= r && r <= 12: // ['\v','\f']
-			return 32
-		case 14 <= r && r <= 33: // [\u000e,'!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case 35 <= r && r <= 38: // ['#','&']
-			return 32
-		case 40 <= r && r <= 91: // ['(','[']
-			return 32
-		case r == 92: // ['\','\']
-			return 34
-		case 93 <= r && r <= 127: // [']',\u007f]
-			return 32
+		case r == 61: // ['=','=']
+			return 52
 
 		}
 		return NoState
@@ -164,6 +200,10 @@ var TransTab = TransitionTable{
 	// S6
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 53
+		case r == 61: // ['=','=']
+			return 54
 
 		}
 		return NoState
@@ -172,6 +212,22 @@ var TransTab = TransitionTable{
 	// S7
 	func(r rune) int {
 		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 55
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 55
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 55
+		case r == 34: // ['"','"']
+			return 56
+		case 35 <= r && r <= 38: // ['#','&']
+			return 55
+		case 40 <= r && r <= 91: // ['(','[']
+			return 55
+		case r == 92: // ['\','\']
+			return 57
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 55
 
 		}
 		return NoState
@@ -196,6 +252,8 @@ var TransTab = TransitionTable{
 	// S10
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 58
 
 		}
 		return NoState
@@ -204,6 +262,10 @@ var TransTab = TransitionTable{
 	// S11
 	func(r rune) int {
 		switch {
+		case r == 43: // ['+','+']
+			return 59
+		case r == 61: // ['=','=']
+			return 60
 
 		}
 		return NoState
@@ -212,10 +274,6 @@ var TransTab = TransitionTable{
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 35
-		case r == 47: // ['/','/']
-			return 36
 
 		}
 		return NoState
@@ -224,8 +282,12 @@ var TransTab = TransitionTable{
 	// S13
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 13
+		case r == 45: // ['-','-']
+			return 61
+		case r == 61: // ['=','=']
+			return 62
+		case r == 62: // ['>','>']
+			return 63
 
 		}
 		return NoState
@@ -234,6 +296,8 @@ var TransTab = TransitionTable{
 	// S14
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 64
 
 		}
 		return NoState
@@ -242,8 +306,12 @@ var TransTab = TransitionTable{
 	// S15
 	func(r rune) int {
 		switch {
+		case r == 42: // ['*','*']
+			return 65
+		case r == 47: // ['/','/']
+			return 66
 		case r == 61: // ['=','=']
-			return 37
+			return 67
 
 		}
 		return NoState
@@ -252,8 +320,20 @@ var TransTab = TransitionTable{
 	// S16
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 38
+		case 48 <= r && r <= 57: // ['0','9']
+			return 68
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case r == 88: // ['X','X']
+			return 70
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
+		case r == 120: // ['x','x']
+			return 70
 
 		}
 		return NoState
@@ -262,8 +342,16 @@ var TransTab = TransitionTable{
 	// S17
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 39
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
 
 		}
 		return NoState
@@ -272,14 +360,6 @@ var TransTab = TransitionTable{
 	// S18
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
 
 		}
 		return NoState
@@ -296,6 +376,10 @@ var TransTab = TransitionTable{
 	// S20
 	func(r rune) int {
 		switch {
+		case r == 60: // ['<','<']
+			return 72
+		case r == 61: // ['=','=']
+			return 73
 
 		}
 		return NoState
@@ -304,14 +388,8 @@ var TransTab = TransitionTable{
 	// S21
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+		case r == 61: // ['=','=']
+			return 74
 
 		}
 		return NoState
@@ -320,18 +398,10 @@ var TransTab = TransitionTable{
 	// S22
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 107: // ['a','k']
-			return 18
-		case r == 108: // ['l','l']
-			return 41
-		case 109 <= r && r <= 122: // ['m','z']
-			return 18
+		case r == 61: // ['=','=']
+			return 75
+		case r == 62: // ['>','>']
+			return 76
 
 		}
 		return NoState
@@ -340,18 +410,6 @@ var TransTab = TransitionTable{
 	// S23
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 101: // ['a','e']
-			return 18
-		case r == 102: // ['f','f']
-			return 42
-		case 103 <= r && r <= 122: // ['g','z']
-			return 18
 
 		}
 		return NoState
@@ -361,17 +419,13 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 43
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
@@ -380,18 +434,6 @@ var TransTab = TransitionTable{
 	// S25
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 120: // ['a','x']
-			return 18
-		case r == 121: // ['y','y']
-			return 44
-		case r == 122: // ['z','z']
-			return 18
 
 		}
 		return NoState
@@ -400,18 +442,6 @@ var TransTab = TransitionTable{
 	// S26
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 103: // ['a','g']
-			return 18
-		case r == 104: // ['h','h']
-			return 45
-		case 105 <= r && r <= 122: // ['i','z']
-			return 18
 
 		}
 		return NoState
@@ -420,6 +450,8 @@ var TransTab = TransitionTable{
 	// S27
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 78
 
 		}
 		return NoState
@@ -428,6 +460,14 @@ var TransTab = TransitionTable{
 	// S28
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
@@ -436,6 +476,18 @@ var TransTab = TransitionTable{
 	// S29
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 79
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
 
 		}
 		return NoState
@@ -444,6 +496,24 @@ var TransTab = TransitionTable{
 	// S30
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case r == 97: // ['a','a']
+			return 80
+		case 98 <= r && r <= 103: // ['b','g']
+			return 24
+		case r == 104: // ['h','h']
+			return 81
+		case 105 <= r && r <= 110: // ['i','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 82
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
 		}
 		return NoState
@@ -452,6 +522,22 @@ var TransTab = TransitionTable{
 	// S31
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 83
+		case 102 <= r && r <= 110: // ['f','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 84
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
 		}
 		return NoState
@@ -460,8 +546,22 @@ var TransTab = TransitionTable{
 	// S32
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 46
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 107: // ['a','k']
+			return 24
+		case r == 108: // ['l','l']
+			return 85
+		case r == 109: // ['m','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 86
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
 
 		}
 		return NoState
@@ -470,8 +570,18 @@ var TransTab = TransitionTable{
 	// S33
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 46
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 87
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
 		}
 		return NoState
@@ -480,8 +590,18 @@ var TransTab = TransitionTable{
 	// S34
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 47
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 88
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
 		}
 		return NoState
@@ -490,30 +610,62 @@ var TransTab = TransitionTable{
 	// S35
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 48
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 101: // ['a','e']
+			return 24
+		case r == 102: // ['f','f']
+			return 89
+		case 103 <= r && r <= 109: // ['g','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 90
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
 
-		default:
-			return 35
 		}
-
+		return NoState
 	},
 
 	// S36
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 30
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 91
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
-		default:
-			return 36
 		}
-
+		return NoState
 	},
 
 	// S37
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 92
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
 
 		}
 		return NoState
@@ -522,6 +674,28 @@ var TransTab = TransitionTable{
 	// S38
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 103: // ['a','g']
+			return 24
+		case r == 104: // ['h','h']
+			return 93
+		case r == 105: // ['i','i']
+			return 94
+		case 106 <= r && r <= 115: // ['j','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 95
+		case 117 <= r && r <= 118: // ['u','v']
+			return 24
+		case r == 119: // ['w','w']
+			return 96
+		case 120 <= r && r <= 122: // ['x','z']
+			return 24
 
 		}
 		return NoState
@@ -530,6 +704,18 @@ var TransTab = TransitionTable{
 	// S39
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 120: // ['a','x']
+			return 24
+		case r == 121: // ['y','y']
+			return 97
+		case r == 122: // ['z','z']
+			return 24
 
 		}
 		return NoState
@@ -539,13 +725,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 98
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
 
 		}
 		return NoState
@@ -555,17 +745,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 114: // ['a','r']
-			return 18
-		case r == 115: // ['s','s']
-			return 49
-		case 116 <= r && r <= 122: // ['t','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 99
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
 
 		}
 		return NoState
@@ -575,13 +765,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 103: // ['a','g']
+			return 24
+		case r == 104: // ['h','h']
+			return 100
+		case 105 <= r && r <= 122: // ['i','z']
+			return 24
 
 		}
 		return NoState
@@ -590,18 +784,6 @@ var TransTab = TransitionTable{
 	// S43
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 115: // ['a','s']
-			return 18
-		case r == 116: // ['t','t']
-			return 50
-		case 117 <= r && r <= 122: // ['u','z']
-			return 18
 
 		}
 		return NoState
@@ -610,18 +792,10 @@ var TransTab = TransitionTable{
 	// S44
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 111: // ['a','o']
-			return 18
-		case r == 112: // ['p','p']
-			return 51
-		case 113 <= r && r <= 122: // ['q','z']
-			return 18
+		case r == 61: // ['=','=']
+			return 101
+		case r == 124: // ['|','|']
+			return 102
 
 		}
 		return NoState
@@ -630,18 +804,6 @@ var TransTab = TransitionTable{
 	// S45
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
-		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 104: // ['a','h']
-			return 18
-		case r == 105: // ['i','i']
-			return 52
-		case 106 <= r && r <= 122: // ['j','z']
-			return 18
 
 		}
 		return NoState
@@ -658,8 +820,6 @@ var TransTab = TransitionTable{
 	// S47
 	func(r rune) int {
 		switch {
-		case r == 39: // [''',''']
-			return 46
 
 		}
 		return NoState
@@ -668,284 +828,2340 @@ var TransTab = TransitionTable{
 	// S48
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 91: // ['(','[']
+			return 48
+		case r == 92: // ['\','\']
+			return 50
+		case 93 <= r && r <= 127: // [']',\u007f]
 			return 48
-		case r == 47: // ['/','/']
-			return 53
 
-		default:
-			return 35
 		}
-
+		return NoState
 	},
 
 	// S49
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 40
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+
+		}
+		return NoState
+	},
+
+	// S50
+	func(r rune) int {
+		switch {
+		case r == 34: // ['"','"']
+			return 103
+		case r == 39: // [''',''']
+			return 103
+		case 48 <= r && r <= 55: // ['0','7']
+			return 104
+		case r == 63: // ['?','?']
+			return 103
+		case r == 92: // ['\','\']
+			return 103
+		case r == 97: // ['a','a']
+			return 103
+		case r == 98: // ['b','b']
+			return 103
+		case r == 102: // ['f','f']
+			return 103
+		case r == 110: // ['n','n']
+			return 103
+		case r == 114: // ['r','r']
+			return 103
+		case r == 116: // ['t','t']
+			return 103
+		case r == 118: // ['v','v']
+			return 103
+		case r == 120: // ['x','x']
+			return 105
+
+		}
+		return NoState
+	},
+
+	// S51
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S52
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S53
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S54
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S55
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+
+		}
+		return NoState
+	},
+
+	// S56
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+
+		}
+		return NoState
+	},
+
+	// S57
+	func(r rune) int {
+		switch {
+		case r == 34: // ['"','"']
+			return 107
+		case r == 39: // [''',''']
+			return 107
+		case 48 <= r && r <= 55: // ['0','7']
+			return 108
+		case r == 63: // ['?','?']
+			return 107
+		case r == 92: // ['\','\']
+			return 107
+		case r == 97: // ['a','a']
+			return 107
+		case r == 98: // ['b','b']
+			return 107
+		case r == 102: // ['f','f']
+			return 107
+		case r == 110: // ['n','n']
+			return 107
+		case r == 114: // ['r','r']
+			return 107
+		case r == 116: // ['t','t']
+			return 107
+		case r == 118: // ['v','v']
+			return 107
+		case r == 120: // ['x','x']
+			return 109
+
+		}
+		return NoState
+	},
+
+	// S58
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S59
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S60
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S61
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S62
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S63
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S64
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 110
+
+		}
+		return NoState
+	},
+
+	// S65
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 111
+
+		default:
+			return 65
+		}
+
+	},
+
+	// S66
+	func(r rune) int {
+		switch {
+		case r == 10: // ['\n','\n']
+			return 51
+
+		default:
+			return 66
+		}
+
+	},
+
+	// S67
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S68
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 68
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
+
+		}
+		return NoState
+	},
+
+	// S69
+	func(r rune) int {
+		switch {
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
+
+		}
+		return NoState
+	},
+
+	// S70
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 112
+		case 65 <= r && r <= 70: // ['A','F']
+			return 112
+		case 97 <= r && r <= 102: // ['a','f']
+			return 112
+
+		}
+		return NoState
+	},
+
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
+
+		}
+		return NoState
+	},
+
+	// S72
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 113
+
+		}
+		return NoState
+	},
+
+	// S73
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S74
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S75
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S76
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 114
+
+		}
+		return NoState
+	},
+
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S78
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 115
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 114: // ['a','r']
+			return 24
+		case r == 115: // ['s','s']
+			return 116
+		case 116 <= r && r <= 122: // ['t','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case r == 97: // ['a','a']
+			return 117
+		case 98 <= r && r <= 122: // ['b','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 118
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 101: // ['a','e']
+			return 24
+		case r == 102: // ['f','f']
+			return 119
+		case 103 <= r && r <= 122: // ['g','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S85
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 114: // ['a','r']
+			return 24
+		case r == 115: // ['s','s']
+			return 120
+		case 116 <= r && r <= 122: // ['t','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 116: // ['a','t']
+			return 24
+		case r == 117: // ['u','u']
+			return 121
+		case 118 <= r && r <= 122: // ['v','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S87
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 122
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S88
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 123
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S89
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S90
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 124
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 125
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 126
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 127
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 121: // ['a','y']
+			return 24
+		case r == 122: // ['z','z']
+			return 128
+
+		}
+		return NoState
+	},
+
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 129
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 104: // ['a','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 130
+		case 106 <= r && r <= 122: // ['j','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 111: // ['a','o']
+			return 24
+		case r == 112: // ['p','p']
+			return 131
+		case 113 <= r && r <= 122: // ['q','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 114: // ['a','r']
+			return 24
+		case r == 115: // ['s','s']
+			return 132
+		case 116 <= r && r <= 122: // ['t','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 104: // ['a','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 133
+		case 106 <= r && r <= 122: // ['j','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 104: // ['a','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 134
+		case 106 <= r && r <= 122: // ['j','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S101
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S102
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S103
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 91: // ['(','[']
+			return 48
+		case r == 92: // ['\','\']
+			return 50
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S104
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 47: // ['(','/']
+			return 48
+		case 48 <= r && r <= 55: // ['0','7']
+			return 135
+		case 56 <= r && r <= 91: // ['8','[']
+			return 48
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 136
+		case 65 <= r && r <= 70: // ['A','F']
+			return 136
+		case 97 <= r && r <= 102: // ['a','f']
+			return 136
+
+		}
+		return NoState
+	},
+
+	// S106
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S107
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+
+		}
+		return NoState
+	},
+
+	// S108
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+		case 48 <= r && r <= 55: // ['0','7']
+			return 137
+
+		}
+		return NoState
+	},
+
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 138
+		case 65 <= r && r <= 70: // ['A','F']
+			return 138
+		case 97 <= r && r <= 102: // ['a','f']
+			return 138
+
+		}
+		return NoState
+	},
+
+	// S110
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S111
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 111
+		case r == 47: // ['/','/']
+			return 139
+
+		default:
+			return 65
+		}
+
+	},
+
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 112
+		case 65 <= r && r <= 70: // ['A','F']
+			return 112
+		case r == 76: // ['L','L']
+			return 69
+		case r == 85: // ['U','U']
+			return 69
+		case 97 <= r && r <= 102: // ['a','f']
+			return 112
+		case r == 108: // ['l','l']
+			return 69
+		case r == 117: // ['u','u']
+			return 69
+
+		}
+		return NoState
+	},
+
+	// S113
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S114
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case r == 97: // ['a','a']
+			return 140
+		case 98 <= r && r <= 122: // ['b','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 141
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 142
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 143
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case r == 97: // ['a','a']
+			return 144
+		case 98 <= r && r <= 122: // ['b','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 145
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 108: // ['a','l']
+			return 24
+		case r == 109: // ['m','m']
+			return 146
+		case 110 <= r && r <= 122: // ['n','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S122
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S123
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 147
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S124
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 102: // ['a','f']
+			return 24
+		case r == 103: // ['g','g']
+			return 148
+		case 104 <= r && r <= 122: // ['h','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S126
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 116: // ['a','t']
+			return 24
+		case r == 117: // ['u','u']
+			return 149
+		case 118 <= r && r <= 122: // ['v','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S127
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 150
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S128
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 151
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S129
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 116: // ['a','t']
+			return 24
+		case r == 117: // ['u','u']
+			return 152
+		case 118 <= r && r <= 122: // ['v','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S130
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 153
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S131
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 154
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S132
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 104: // ['a','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 155
+		case 106 <= r && r <= 122: // ['j','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S133
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 99: // ['a','c']
+			return 24
+		case r == 100: // ['d','d']
+			return 156
+		case 101 <= r && r <= 122: // ['e','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S134
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 107: // ['a','k']
+			return 24
+		case r == 108: // ['l','l']
+			return 157
+		case 109 <= r && r <= 122: // ['m','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S135
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 47: // ['(','/']
+			return 48
+		case 48 <= r && r <= 55: // ['0','7']
+			return 158
+		case 56 <= r && r <= 91: // ['8','[']
+			return 48
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S136
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 47: // ['(','/']
+			return 48
+		case 48 <= r && r <= 57: // ['0','9']
+			return 159
+		case 58 <= r && r <= 64: // [':','@']
+			return 48
+		case 65 <= r && r <= 70: // ['A','F']
+			return 159
+		case 71 <= r && r <= 91: // ['G','[']
+			return 48
+		case 93 <= r && r <= 96: // [']','`']
+			return 48
+		case 97 <= r && r <= 102: // ['a','f']
+			return 159
+		case 103 <= r && r <= 127: // ['g',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S137
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+		case 48 <= r && r <= 55: // ['0','7']
+			return 160
+
+		}
+		return NoState
+	},
+
+	// S138
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+		case 48 <= r && r <= 57: // ['0','9']
+			return 138
+		case 65 <= r && r <= 70: // ['A','F']
+			return 138
+		case 97 <= r && r <= 102: // ['a','f']
+			return 138
+
+		}
+		return NoState
+	},
+
+	// S139
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S140
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 106: // ['a','j']
+			return 24
+		case r == 107: // ['k','k']
+			return 161
+		case 108 <= r && r <= 122: // ['l','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S141
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S142
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S143
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 104: // ['a','h']
+			return 24
+		case r == 105: // ['i','i']
+			return 162
+		case 106 <= r && r <= 122: // ['j','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S144
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 116: // ['a','t']
+			return 24
+		case r == 117: // ['u','u']
+			return 163
+		case 118 <= r && r <= 122: // ['v','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S145
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S146
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S147
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S148
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S149
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 113: // ['a','q']
+			return 24
+		case r == 114: // ['r','r']
+			return 164
+		case 115 <= r && r <= 122: // ['s','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S150
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 165
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S151
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 110: // ['a','n']
+			return 24
+		case r == 111: // ['o','o']
+			return 166
+		case 112 <= r && r <= 122: // ['p','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S152
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 98: // ['a','b']
+			return 24
+		case r == 99: // ['c','c']
+			return 167
+		case 100 <= r && r <= 122: // ['d','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S153
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 98: // ['a','b']
+			return 24
+		case r == 99: // ['c','c']
+			return 168
+		case 100 <= r && r <= 122: // ['d','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S154
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 99: // ['a','c']
+			return 24
+		case r == 100: // ['d','d']
+			return 169
+		case 101 <= r && r <= 122: // ['e','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S155
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 102: // ['a','f']
+			return 24
+		case r == 103: // ['g','g']
+			return 170
+		case 104 <= r && r <= 122: // ['h','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S156
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S157
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 171
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S158
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 91: // ['(','[']
+			return 48
+		case r == 92: // ['\','\']
+			return 50
+		case 93 <= r && r <= 127: // [']',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S159
+	func(r rune) int {
+		switch {
+		case 1 <= r && r <= 9: // [\u0001,'\t']
+			return 48
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 48
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 48
+		case r == 34: // ['"','"']
+			return 49
+		case 35 <= r && r <= 38: // ['#','&']
+			return 48
+		case r == 39: // [''',''']
+			return 3
+		case 40 <= r && r <= 47: // ['(','/']
+			return 48
+		case 48 <= r && r <= 57: // ['0','9']
+			return 159
+		case 58 <= r && r <= 64: // [':','@']
+			return 48
+		case 65 <= r && r <= 70: // ['A','F']
+			return 159
+		case 71 <= r && r <= 91: // ['G','[']
+			return 48
+		case 93 <= r && r <= 96: // [']','`']
+			return 48
+		case 97 <= r && r <= 102: // ['a','f']
+			return 159
+		case 103 <= r && r <= 127: // ['g',\u007f]
+			return 48
+
+		}
+		return NoState
+	},
+
+	// S160
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 106
+
+		}
+		return NoState
+	},
+
+	// S161
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S162
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 172
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S163
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 107: // ['a','k']
+			return 24
+		case r == 108: // ['l','l']
+			return 173
+		case 109 <= r && r <= 122: // ['m','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S164
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 174
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S165
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S166
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 101: // ['a','e']
+			return 24
+		case r == 102: // ['f','f']
+			return 175
+		case 103 <= r && r <= 122: // ['g','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S167
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 176
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S168
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 103: // ['a','g']
+			return 24
+		case r == 104: // ['h','h']
+			return 177
+		case 105 <= r && r <= 122: // ['i','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S169
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 24
 		case r == 101: // ['e','e']
-			return 54
+			return 178
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S50
+	// S170
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
+		case 97 <= r && r <= 109: // ['a','m']
+			return 24
+		case r == 110: // ['n','n']
+			return 179
+		case 111 <= r && r <= 122: // ['o','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S171
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
+
+		}
+		return NoState
+	},
+
+	// S172
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
 		case 97 <= r && r <= 116: // ['a','t']
-			return 18
+			return 24
 		case r == 117: // ['u','u']
-			return 55
+			return 180
 		case 118 <= r && r <= 122: // ['v','z']
-			return 18
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S51
+	// S173
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 56
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 115: // ['a','s']
+			return 24
+		case r == 116: // ['t','t']
+			return 181
+		case 117 <= r && r <= 122: // ['u','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S52
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 107: // ['a','k']
-			return 18
-		case r == 108: // ['l','l']
-			return 57
-		case 109 <= r && r <= 122: // ['m','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S53
+	// S175
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 77
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 24
+		case r == 95: // ['_','_']
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S54
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S55
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 113: // ['a','q']
-			return 18
-		case r == 114: // ['r','r']
-			return 58
-		case 115 <= r && r <= 122: // ['s','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S56
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 99: // ['a','c']
-			return 18
-		case r == 100: // ['d','d']
-			return 59
-		case 101 <= r && r <= 122: // ['e','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 101: // ['a','e']
+			return 24
+		case r == 102: // ['f','f']
+			return 182
+		case 103 <= r && r <= 122: // ['g','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S57
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 24
 		case r == 101: // ['e','e']
-			return 60
+			return 183
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S58
+	// S180
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 109: // ['a','m']
-			return 18
-		case r == 110: // ['n','n']
-			return 61
-		case 111 <= r && r <= 122: // ['o','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 100: // ['a','d']
+			return 24
+		case r == 101: // ['e','e']
+			return 184
+		case 102 <= r && r <= 122: // ['f','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S59
+	// S181
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 100: // ['a','d']
-			return 18
-		case r == 101: // ['e','e']
-			return 62
-		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S60
+	// S182
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S61
+	// S183
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 99: // ['a','c']
+			return 24
+		case r == 100: // ['d','d']
+			return 185
+		case 101 <= r && r <= 122: // ['e','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S62
+	// S184
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
-		case 97 <= r && r <= 101: // ['a','e']
-			return 18
-		case r == 102: // ['f','f']
-			return 63
-		case 103 <= r && r <= 122: // ['g','z']
-			return 18
+			return 28
+		case 97 <= r && r <= 122: // ['a','z']
+			return 24
 
 		}
 		return NoState
 	},
 
-	// S63
+	// S185
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 77
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 24
 		case r == 95: // ['_','_']
-			return 21
+			return 28
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 24
 
 		}
 		return NoState
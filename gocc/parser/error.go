@@ -3,24 +3,103 @@ package parser
 import (
 	"fmt"
 	"sort"
+	"strings"
 
+	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/token"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	uctoken "github.com/mewmew/uc/token"
 )
 
-// NewError returns a user-friendly parse error.
-func NewError(err *errors.Error) error {
-	if err.Err != nil {
-		return err.Err
-	}
-	// TODO: Add line:col positional tracking.
-	var expected []string
-	for _, tok := range err.ExpectedTokens {
-		if tok == "error" {
-			// Remove "error" production rule from the set of expected tokens.
-			continue
+// NewError returns a user-friendly parse error, which is rendered like semantic
+// analysis errors; i.e. with file:line:column position information, followed
+// by the offending source line and a caret pointing at the unexpected token.
+func NewError(src *semerrors.Source, err *errors.Error) *semerrors.Error {
+	pos := uctoken.Pos(err.ErrorToken.Pos.Offset)
+	var e *semerrors.Error
+	switch {
+	case err.Err != nil:
+		if e, ok := err.Err.(*semerrors.Error); ok {
+			// Positioned error of production rule action.
+			e.Src = src
+			return e
+		}
+		if e, ok := err.Err.(*errutil.ErrInfo); ok {
+			// Unwrap errutil error.
+			err.Err = e.Err
+		}
+		e = semerrors.New(pos, err.Err.Error())
+	case err.ErrorToken.Type == token.INVALID:
+		// Lexical errors are reported by the lexer, either as the erroneous
+		// input (Gocc lexer) or as an error message (hand-written lexer).
+		e = semerrors.Newf(pos, "invalid token: %s", err.ErrorToken.Lit)
+	default:
+		var expected []string
+		for _, id := range err.ExpectedTokens {
+			if id == "error" {
+				// Remove "error" production rule from the set of expected tokens.
+				continue
+			}
+			expected = append(expected, tokenName(id))
 		}
-		expected = append(expected, tok)
+		sort.Strings(expected)
+		e = semerrors.Newf(pos, "unexpected %s, expected %s", tokenString(err.ErrorToken), orList(expected))
+	}
+	e.Src = src
+	return e
+}
+
+// NewErrorList returns a list of user-friendly parse errors, based on the given
+// list of Gocc parse errors.
+func NewErrorList(src *semerrors.Source, errs errors.List) semerrors.List {
+	var list semerrors.List
+	for _, err := range errs {
+		list = append(list, NewError(src, err))
+	}
+	return list
+}
+
+// tokenNames maps from Gocc token identifiers to human-readable token names,
+// for tokens without a fixed spelling.
+var tokenNames = map[string]string{
+	"$":          "end of file",
+	"ident":      "identifier",
+	"int_lit":    "integer literal",
+	"char_lit":   "character literal",
+	"string_lit": "string literal",
+	"type_name":  "type name",
+}
+
+// tokenName returns a human-readable name of the given Gocc token identifier.
+func tokenName(id string) string {
+	if name, ok := tokenNames[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("%q", id)
+}
+
+// tokenString returns a human-readable description of the given token.
+func tokenString(tok *token.Token) string {
+	id := token.TokMap.Id(tok.Type)
+	name, ok := tokenNames[id]
+	if !ok {
+		return fmt.Sprintf("%q", id)
+	}
+	if tok.Type == token.EOF {
+		return name
+	}
+	return fmt.Sprintf("%s %q", name, tok.Lit)
+}
+
+// orList returns a human-readable disjunction of the given items; e.g. `a, b or
+// c`.
+func orList(items []string) string {
+	switch len(items) {
+	case 0:
+		return "nothing"
+	case 1:
+		return items[0]
 	}
-	sort.Strings(expected)
-	return fmt.Errorf("%d: unexpected %q, expected %q", err.ErrorToken.Pos.Offset, string(err.ErrorToken.Lit), expected)
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
 }
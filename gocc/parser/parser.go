@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 84
-	numStates      = 318
-	numSymbols     = 72
+	numProductions = 199
+	numStates      = 1830
+	numSymbols     = 139
 )
 
 // Stack
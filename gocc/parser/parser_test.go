@@ -2,6 +2,7 @@ package parser_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"reflect"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/mewmew/uc/gocc/errors"
 	"github.com/mewmew/uc/gocc/parser"
 	"github.com/mewmew/uc/gocc/scanner"
+	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
 )
 
@@ -38,7 +40,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 13,
+							Ellipsis: token.NoPos,
+							Rparen:   13,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 4,
@@ -269,7 +272,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 13,
+							Ellipsis: token.NoPos,
+							Rparen:   13,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 4,
@@ -363,7 +367,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 14,
+							Ellipsis: token.NoPos,
+							Rparen:   14,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 5,
@@ -482,7 +487,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 14,
+							Ellipsis: token.NoPos,
+							Rparen:   14,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 5,
@@ -575,7 +581,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 13,
+							Ellipsis: token.NoPos,
+							Rparen:   13,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 4,
@@ -735,6 +742,11 @@ func TestParser(t *testing.T) {
 								Name:    "int",
 							},
 							Lbracket: 5,
+							LenExpr: &ast.BasicLit{
+								ValPos: 6,
+								Kind:   token.IntLit,
+								Val:    "10",
+							},
 							Len:      10,
 							Rbracket: 8,
 						},
@@ -750,6 +762,11 @@ func TestParser(t *testing.T) {
 								Name:    "char",
 							},
 							Lbracket: 17,
+							LenExpr: &ast.BasicLit{
+								ValPos: 18,
+								Kind:   token.IntLit,
+								Val:    "10",
+							},
 							Len:      10,
 							Rbracket: 20,
 						},
@@ -795,7 +812,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 48,
+							Ellipsis: token.NoPos,
+							Rparen:   48,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 29,
@@ -821,7 +839,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 69,
+							Ellipsis: token.NoPos,
+							Rparen:   69,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 60,
@@ -860,7 +879,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 11,
+							Ellipsis: token.NoPos,
+							Rparen:   11,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 5,
@@ -891,7 +911,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 38,
+							Ellipsis: token.NoPos,
+							Rparen:   38,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 32,
@@ -927,7 +948,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 71,
+							Ellipsis: token.NoPos,
+							Rparen:   71,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 62,
@@ -983,7 +1005,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 13,
+							Ellipsis: token.NoPos,
+							Rparen:   13,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 4,
@@ -1097,7 +1120,8 @@ func TestParser(t *testing.T) {
 									},
 								},
 							},
-							Rparen: 83,
+							Ellipsis: token.NoPos,
+							Rparen:   83,
 						},
 						FuncName: &ast.Ident{
 							NamePos: 74,
@@ -1177,6 +1201,46 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			path: "../../testdata/extra/parser/enum.c",
+			want: func() *ast.File {
+				// The names of enumeration constants refer to their own
+				// declarations.
+				a := &ast.EnumConst{
+					ConstName: &ast.Ident{
+						NamePos: 7,
+						Name:    "A",
+					},
+					Assign: token.NoPos,
+				}
+				a.ConstName.Decl = a
+				b := &ast.EnumConst{
+					ConstName: &ast.Ident{
+						NamePos: 10,
+						Name:    "B",
+					},
+					Assign: 12,
+					ValExpr: &ast.BasicLit{
+						ValPos: 14,
+						Kind:   token.IntLit,
+						Val:    "2",
+					},
+				}
+				b.ConstName.Decl = b
+				return &ast.File{
+					Decls: []ast.Decl{
+						&ast.VarDecl{
+							VarType: &ast.EnumType{
+								Enum:   0,
+								Lbrace: 5,
+								Consts: []*ast.EnumConst{a, b},
+								Rbrace: 16,
+							},
+						},
+					},
+				}
+			}(),
+		},
 	}
 
 	for _, g := range golden {
@@ -1187,7 +1251,7 @@ func TestParser(t *testing.T) {
 			continue
 		}
 		p := parser.NewParser()
-		file, err := p.Parse(s)
+		file, err := p.ParseAll(s)
 		if err != nil {
 			t.Error(err)
 			continue
@@ -1207,35 +1271,51 @@ func TestParserError(t *testing.T) {
 	}{
 		{
 			path: "../../testdata/incorrect/parser/pe01.c",
-			want: `102: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: "(../../testdata/incorrect/parser/pe01.c:5:12) error: unexpected \")\", expected \"!\", \"&\", \"(\", \"*\", \"++\", \"-\", \"--\", \"sizeof\", \"~\", character literal, identifier, integer literal or string literal\n" +
+				"  a = (a + ) * a;   //  Unexpected token ')'\n" +
+				"           ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe02.c",
-			want: `112: unexpected "}", expected ["!=" "&&" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">="]`,
+			want: "(../../testdata/incorrect/parser/pe02.c:4:1) error: unexpected \"}\", expected \"!=\", \"%\", \"%=\", \"&\", \"&&\", \"&=\", \"*\", \"*=\", \"+\", \"++\", \"+=\", \"-\", \"--\", \"-=\", \"->\", \".\", \"/\", \"/=\", \";\", \"<\", \"<<\", \"<<=\", \"<=\", \"=\", \"==\", \">\", \">=\", \">>\", \">>=\", \"?\", \"[\", \"^\", \"^=\", \"|\", \"|=\" or \"||\"\n" +
+				"}\n" +
+				"^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe03.c",
-			want: `129: unexpected "}", expected ["!" "(" "-" ";" "char_lit" "ident" "if" "int_lit" "return" "while" "{"]`,
+			want: "(../../testdata/incorrect/parser/pe03.c:6:1) error: unexpected \"}\", expected \"!\", \"&\", \"(\", \"*\", \"++\", \"-\", \"--\", \";\", \"break\", \"case\", \"continue\", \"default\", \"do\", \"for\", \"goto\", \"if\", \"return\", \"sizeof\", \"switch\", \"while\", \"{\", \"~\", character literal, identifier, integer literal or string literal\n" +
+				"}\n" +
+				"^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe04.c",
-			want: `111: unexpected "a", expected ["!=" "&&" "(" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "["]`,
+			want: "(../../testdata/incorrect/parser/pe04.c:5:20) error: unexpected identifier \"a\", expected \"!=\", \"%\", \"%=\", \"&\", \"&&\", \"&=\", \"(\", \"*\", \"*=\", \"+\", \"++\", \"+=\", \"-\", \"--\", \"-=\", \"->\", \".\", \"/\", \"/=\", \":\", \";\", \"<\", \"<<\", \"<<=\", \"<=\", \"=\", \"==\", \">\", \">=\", \">>\", \">>=\", \"?\", \"[\", \"^\", \"^=\", \"|\", \"|=\" or \"||\"\n" +
+				"  if (a != 0) then a=1; // Shouldn't be a 'then' here\n" +
+				"                   ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe05.c",
-			want: `71: unexpected "else", expected ["ident"]`,
+			want: "(../../testdata/incorrect/parser/pe05.c:3:5) error: unexpected \"else\", expected \"*\", identifier or type name\n" +
+				"int else;  // Bad identifier\n" +
+				"    ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe06.c",
-			want: `73: unexpected "b", expected ["(" ";" "["]`,
+			want: "(../../testdata/incorrect/parser/pe06.c:3:7) error: unexpected identifier \"b\", expected \"(\", \";\", \"=\" or \"[\"\n" +
+				"int a b; // Unexpected identifier\n" +
+				"      ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe07.c",
-			want: `72: unexpected ",", expected ["(" ";" "["]`,
+			want: "(../../testdata/incorrect/parser/pe07.c:3:6) error: unexpected \",\", expected \"(\", \";\", \"=\" or \"[\"\n" +
+				"int a, b; // unexpected comma (and b)\n" +
+				"     ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe08.c",
-			want: `86: unexpected "42", expected [";" "{"]`,
+			want: "(../../testdata/incorrect/parser/pe08.c:3:6) error: unexpected integer literal \"42\", expected \";\" or \"{\"\n" +
+				"     42; // Procedure definition must have {}\n" +
+				"     ^",
 		},
 		{
 			// TODO: The ';' at offset 80 in pe09.c shuold probably be a '{', as
@@ -1243,19 +1323,27 @@ func TestParserError(t *testing.T) {
 			//
 			// Update this test case if the test file is fixed.
 			path: "../../testdata/incorrect/parser/pe09.c",
-			want: `87: unexpected ";", expected ["$" "ident" "typedef"]`,
+			want: "(../../testdata/incorrect/parser/pe09.c:3:6) error: unexpected \";\", expected \"char\", \"enum\", \"int\", \"long\", \"short\", \"struct\", \"typedef\", \"unsigned\", \"void\", end of file, identifier or type name\n" +
+				"     ; // '}' missing \n" +
+				"     ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe10.c",
-			want: `135: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: "(../../testdata/incorrect/parser/pe10.c:8:13) error: unexpected \")\", expected \"!\", \"&\", \"(\", \"*\", \"++\", \"-\", \"--\", \"sizeof\", \"~\", character literal, identifier, integer literal or string literal\n" +
+				"  foo(1, 2, ); // Unexpected token ')'\n" +
+				"            ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe11.c",
-			want: `70: unexpected "(", expected ["ident"]`,
+			want: "(../../testdata/incorrect/parser/pe11.c:3:4) error: unexpected \"(\", expected identifier or type name\n" +
+				"foo(0);\n" +
+				"   ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe12.c",
-			want: `77: unexpected "{", expected ["(" ";" "["]`,
+			want: "(../../testdata/incorrect/parser/pe12.c:3:11) error: unexpected \"{\", expected \"(\", \";\", \"=\" or \"[\"\n" +
+				"void fred { // Missing parameter list\n" +
+				"          ^",
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe13.c",
@@ -1278,22 +1366,40 @@ func TestParserError(t *testing.T) {
 			//    https://github.com/mewmew/uc/issues/43
 			want: "",
 		},
+		{
+			path: "../../testdata/extra/parser/multiple-errors.c",
+			want: "(../../testdata/extra/parser/multiple-errors.c:1:7) error: unexpected identifier \"b\", expected \"(\", \";\", \"=\" or \"[\"\n" +
+				"int a b;\n" +
+				"      ^\n" +
+				"(../../testdata/extra/parser/multiple-errors.c:5:10) error: unexpected \";\", expected \"!\", \"&\", \"(\", \"*\", \"++\", \"-\", \"--\", \"sizeof\", \"~\", character literal, identifier, integer literal or string literal\n" +
+				" y = x + ;\n" +
+				"         ^\n" +
+				"(../../testdata/extra/parser/multiple-errors.c:10:11) error: unexpected integer literal \"2\", expected \"!=\", \"%\", \"%=\", \"&\", \"&&\", \"&=\", \"*\", \"*=\", \"+\", \"++\", \"+=\", \"-\", \"--\", \"-=\", \"->\", \".\", \"/\", \"/=\", \";\", \"<\", \"<<\", \"<<=\", \"<=\", \"=\", \"==\", \">\", \">=\", \">>\", \">>=\", \"?\", \"[\", \"^\", \"^=\", \"|\", \"|=\" or \"||\"\n" +
+				" return 1 2;\n" +
+				"          ^",
+		},
 	}
 
+	semerrors.UseColor = false
+
 	for _, g := range golden {
 		log.Println("path:", g.path)
-		s, err := scanner.Open(g.path)
+		buf, err := ioutil.ReadFile(g.path)
 		if err != nil {
 			t.Error(err)
 			continue
 		}
+		fset := token.NewFileSet()
+		file := fset.AddFile(g.path, len(buf))
+		s := scanner.NewFromFile(file, buf)
+		src := semerrors.NewFileSource(file, string(buf))
 		p := parser.NewParser()
-		_, err = p.Parse(s)
+		_, err = p.ParseAll(s)
 		got := ""
 		if err != nil {
-			if e, ok := err.(*errors.Error); ok {
-				// Unwrap Gocc error.
-				err = parser.NewError(e)
+			if errs, ok := err.(errors.List); ok {
+				// Unwrap Gocc errors.
+				err = parser.NewErrorList(src, errs)
 			}
 			got = err.Error()
 		}
@@ -1303,4 +1409,49 @@ func TestParserError(t *testing.T) {
 	}
 }
 
+func TestParserRecovery(t *testing.T) {
+	const path = "../../testdata/extra/parser/multiple-errors.c"
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	s := scanner.NewFromFile(fset.AddFile(path, len(buf)), buf)
+	p := parser.NewParser()
+	res, err := p.ParseAll(s)
+	errs, ok := err.(errors.List)
+	if !ok {
+		t.Fatalf("%q: error type mismatch; expected errors.List, got %T", path, err)
+	}
+	if want, got := 3, len(errs); got != want {
+		t.Errorf("%q: number of syntax errors mismatch; expected %d, got %d", path, want, got)
+	}
+	file, ok := res.(*ast.File)
+	if !ok {
+		t.Fatalf("%q: partial AST type mismatch; expected *ast.File, got %T", path, res)
+	}
+	var got []string
+	for _, decl := range file.Decls {
+		got = append(got, fmt.Sprintf("%T", decl))
+	}
+	want := []string{"*ast.BadDecl", "*ast.FuncDecl", "*ast.FuncDecl", "*ast.VarDecl"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%q: declarations mismatch; expected %v, got %v", path, want, got)
+	}
+	// Verify that the erroneous statements of function bodies have been
+	// replaced with bad statements.
+	for _, decl := range file.Decls[1:3] {
+		body := decl.(*ast.FuncDecl).Body
+		var bad int
+		for _, item := range body.Items {
+			if _, ok := item.(*ast.BadStmt); ok {
+				bad++
+			}
+		}
+		if bad != 1 {
+			t.Errorf("%q: number of bad statements in %v mismatch; expected 1, got %d", path, decl.Name(), bad)
+		}
+	}
+}
+
 // TODO: add benchmark
@@ -64,7 +64,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `DeclList : Decl	<< astx.NewDeclList(X[0]) >>`,
+		String: `DeclList : ExternalDecl	<< astx.NewDeclList(X[0]) >>`,
 		Id:         "DeclList",
 		NTType:     3,
 		Index:      4,
@@ -74,7 +74,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `DeclList : DeclList Decl	<< astx.AppendDecl(X[0], X[1]) >>`,
+		String: `DeclList : DeclList ExternalDecl	<< astx.AppendDecl(X[0], X[1]) >>`,
 		Id:         "DeclList",
 		NTType:     3,
 		Index:      5,
@@ -84,39 +84,39 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : VarDecl ";"	<< X[0], nil >>`,
-		Id:         "Decl",
+		String: `ExternalDecl : Decl	<<  >>`,
+		Id:         "ExternalDecl",
 		NTType:     4,
 		Index:      6,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : FuncDecl ";"	<< X[0], nil >>`,
-		Id:         "Decl",
+		String: `ExternalDecl : error ";"	<< astx.NewBadDecl(X[0], X[1]) >>`,
+		Id:         "ExternalDecl",
 		NTType:     4,
 		Index:      7,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewBadDecl(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : FuncDef	<<  >>`,
-		Id:         "Decl",
+		String: `ExternalDecl : error "}"	<< astx.NewBadDecl(X[0], X[1]) >>`,
+		Id:         "ExternalDecl",
 		NTType:     4,
 		Index:      8,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewBadDecl(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : TypeDef ";"	<< X[0], nil >>`,
+		String: `Decl : VarDecl ";"	<< X[0], nil >>`,
 		Id:         "Decl",
-		NTType:     4,
+		NTType:     5,
 		Index:      9,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
@@ -124,199 +124,199 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `FuncDecl : FuncHeader	<<  >>`,
-		Id:         "FuncDecl",
+		String: `Decl : VarDecl "=" Initializer ";"	<< astx.NewVarDef(X[0], X[2]) >>`,
+		Id:         "Decl",
 		NTType:     5,
 		Index:      10,
-		NumSymbols: 1,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewVarDef(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `FuncHeader : BasicType ident "(" Params ")"	<< astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
-		Id:         "FuncHeader",
-		NTType:     6,
+		String: `Decl : FuncDecl ";"	<< X[0], nil >>`,
+		Id:         "Decl",
+		NTType:     5,
 		Index:      11,
-		NumSymbols: 5,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[4])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FuncDef : FuncHeader BlockStmt	<< astx.SetFuncBody(X[0], X[1]) >>`,
-		Id:         "FuncDef",
-		NTType:     7,
+		String: `Decl : FuncDef	<<  >>`,
+		Id:         "Decl",
+		NTType:     5,
 		Index:      12,
-		NumSymbols: 2,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.SetFuncBody(X[0], X[1])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `VarDecl : ScalarDecl	<<  >>`,
-		Id:         "VarDecl",
-		NTType:     8,
+		String: `Decl : TypeDef ";"	<< X[0], nil >>`,
+		Id:         "Decl",
+		NTType:     5,
 		Index:      13,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `VarDecl : ArrayDecl	<<  >>`,
-		Id:         "VarDecl",
-		NTType:     8,
+		String: `Decl : StructType ";"	<< astx.NewStructDecl(X[0]) >>`,
+		Id:         "Decl",
+		NTType:     5,
 		Index:      14,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewStructDecl(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `ScalarDecl : BasicType ident	<< astx.NewScalarDecl(X[0], X[1]) >>`,
-		Id:         "ScalarDecl",
-		NTType:     9,
+		String: `Decl : EnumType ";"	<< astx.NewEnumDecl(X[0]) >>`,
+		Id:         "Decl",
+		NTType:     5,
 		Index:      15,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewScalarDecl(X[0], X[1])
+			return astx.NewEnumDecl(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `ArrayDecl : BasicType ident "[" IntLit "]"	<< astx.NewArrayDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
-		Id:         "ArrayDecl",
-		NTType:     10,
+		String: `FuncDecl : FuncHeader	<<  >>`,
+		Id:         "FuncDecl",
+		NTType:     6,
 		Index:      16,
-		NumSymbols: 5,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewArrayDecl(X[0], X[1], X[2], X[3], X[4])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ArrayDecl : BasicType ident "[" "]"	<< astx.NewArrayDecl(X[0], X[1], X[2], 0, X[3]) >>`,
-		Id:         "ArrayDecl",
-		NTType:     10,
+		String: `FuncHeader : Type ident "(" Params ")"	<< astx.NewFuncDecl(X[0], X[1], X[2], X[3], nil, X[4]) >>`,
+		Id:         "FuncHeader",
+		NTType:     7,
 		Index:      17,
-		NumSymbols: 4,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewArrayDecl(X[0], X[1], X[2], 0, X[3])
+			return astx.NewFuncDecl(X[0], X[1], X[2], X[3], nil, X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `IntLit : int_lit	<< astx.NewIntLit(X[0], token.IntLit) >>`,
-		Id:         "IntLit",
-		NTType:     11,
+		String: `FuncHeader : Type ident "(" ParamList "," "..." ")"	<< astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[5], X[6]) >>`,
+		Id:         "FuncHeader",
+		NTType:     7,
 		Index:      18,
-		NumSymbols: 1,
+		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIntLit(X[0], token.IntLit)
+			return astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[5], X[6])
 		},
 	},
 	ProdTabEntry{
-		String: `IntLit : char_lit	<< astx.NewIntLit(X[0], token.CharLit) >>`,
-		Id:         "IntLit",
-		NTType:     11,
+		String: `FuncDef : FuncHeader BlockStmt	<< astx.SetFuncBody(X[0], X[1]) >>`,
+		Id:         "FuncDef",
+		NTType:     8,
 		Index:      19,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIntLit(X[0], token.CharLit)
+			return astx.SetFuncBody(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `TypeDef : "typedef" Type ident	<< astx.NewTypeDef(X[0], X[1], X[2]) >>`,
-		Id:         "TypeDef",
-		NTType:     12,
+		String: `VarDecl : ScalarDecl	<<  >>`,
+		Id:         "VarDecl",
+		NTType:     9,
 		Index:      20,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewTypeDef(X[0], X[1], X[2])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `BasicType : ident	<< astx.NewIdent(X[0]) >>`,
-		Id:         "BasicType",
-		NTType:     13,
+		String: `VarDecl : ArrayDecl	<<  >>`,
+		Id:         "VarDecl",
+		NTType:     9,
 		Index:      21,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIdent(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Params : empty	<<  >>`,
-		Id:         "Params",
-		NTType:     14,
+		String: `ScalarDecl : Type ident	<< astx.NewScalarDecl(X[0], X[1]) >>`,
+		Id:         "ScalarDecl",
+		NTType:     10,
 		Index:      22,
-		NumSymbols: 0,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return nil, nil
+			return astx.NewScalarDecl(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Params : ParamList	<<  >>`,
-		Id:         "Params",
-		NTType:     14,
+		String: `ScalarDecl : Type type_name	<< astx.NewScalarDecl(X[0], X[1]) >>`,
+		Id:         "ScalarDecl",
+		NTType:     10,
 		Index:      23,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewScalarDecl(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `ParamList : Param	<< astx.NewParamList(X[0]) >>`,
-		Id:         "ParamList",
-		NTType:     15,
+		String: `ArrayDecl : Type ident ArrayDims	<< astx.NewArrayDecl(X[0], X[1], X[2]) >>`,
+		Id:         "ArrayDecl",
+		NTType:     11,
 		Index:      24,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewParamList(X[0])
+			return astx.NewArrayDecl(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `ParamList : ParamList "," Param	<< astx.AppendParam(X[0], X[2]) >>`,
-		Id:         "ParamList",
-		NTType:     15,
+		String: `ArrayDecl : Type type_name ArrayDims	<< astx.NewArrayDecl(X[0], X[1], X[2]) >>`,
+		Id:         "ArrayDecl",
+		NTType:     11,
 		Index:      25,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.AppendParam(X[0], X[2])
+			return astx.NewArrayDecl(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Param : Type	<< astx.NewAnonParam(X[0]) >>`,
-		Id:         "Param",
-		NTType:     16,
+		String: `ArrayDims : "[" Expr "]"	<< astx.NewArrayDims(X[0], X[1], X[2]) >>`,
+		Id:         "ArrayDims",
+		NTType:     12,
 		Index:      26,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewAnonParam(X[0])
+			return astx.NewArrayDims(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Param : VarDecl	<<  >>`,
-		Id:         "Param",
-		NTType:     16,
+		String: `ArrayDims : "[" "]"	<< astx.NewArrayDims(X[0], 0, X[1]) >>`,
+		Id:         "ArrayDims",
+		NTType:     12,
 		Index:      27,
-		NumSymbols: 1,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewArrayDims(X[0], 0, X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Type : BasicType	<<  >>`,
-		Id:         "Type",
-		NTType:     17,
+		String: `ArrayDims : ArrayDims "[" Expr "]"	<< astx.AppendArrayDim(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "ArrayDims",
+		NTType:     12,
 		Index:      28,
-		NumSymbols: 1,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.AppendArrayDim(X[0], X[1], X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `Stmt : MatchedStmt	<<  >>`,
-		Id:         "Stmt",
-		NTType:     18,
+		String: `Initializer : Expr	<<  >>`,
+		Id:         "Initializer",
+		NTType:     13,
 		Index:      29,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
@@ -324,120 +324,750 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Stmt : OpenStmt	<<  >>`,
-		Id:         "Stmt",
-		NTType:     18,
+		String: `Initializer : "{" InitializerList "}"	<< astx.NewInitList(X[0], X[1], X[2]) >>`,
+		Id:         "Initializer",
+		NTType:     13,
 		Index:      30,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewInitList(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `OtherStmt : Expr ";"	<< astx.NewExprStmt(X[0]) >>`,
-		Id:         "OtherStmt",
-		NTType:     19,
+		String: `Initializer : "{" InitializerList "," "}"	<< astx.NewInitList(X[0], X[1], X[3]) >>`,
+		Id:         "Initializer",
+		NTType:     13,
 		Index:      31,
-		NumSymbols: 2,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewExprStmt(X[0])
+			return astx.NewInitList(X[0], X[1], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `OtherStmt : "return" Expr ";"	<< astx.NewReturnStmt(X[0], X[1]) >>`,
-		Id:         "OtherStmt",
-		NTType:     19,
+		String: `InitializerList : Initializer	<< astx.NewExprList(X[0]) >>`,
+		Id:         "InitializerList",
+		NTType:     14,
 		Index:      32,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewReturnStmt(X[0], X[1])
+			return astx.NewExprList(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `OtherStmt : "return" ";"	<< astx.NewReturnStmt(X[0], nil) >>`,
-		Id:         "OtherStmt",
-		NTType:     19,
+		String: `InitializerList : InitializerList "," Initializer	<< astx.AppendExpr(X[0], X[2]) >>`,
+		Id:         "InitializerList",
+		NTType:     14,
 		Index:      33,
-		NumSymbols: 2,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewReturnStmt(X[0], nil)
+			return astx.AppendExpr(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `OtherStmt : BlockStmt	<<  >>`,
-		Id:         "OtherStmt",
-		NTType:     19,
+		String: `TypeDef : "typedef" Type ident	<< astx.NewTypeDef(X[0], X[1], X[2]) >>`,
+		Id:         "TypeDef",
+		NTType:     15,
 		Index:      34,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewTypeDef(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `OtherStmt : ";"	<< astx.NewEmptyStmt(X[0]) >>`,
-		Id:         "OtherStmt",
-		NTType:     19,
+		String: `TypeDef : "typedef" Type type_name	<< astx.NewTypeDef(X[0], X[1], X[2]) >>`,
+		Id:         "TypeDef",
+		NTType:     15,
 		Index:      35,
-		NumSymbols: 1,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewEmptyStmt(X[0])
+			return astx.NewTypeDef(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `BlockStmt : "{" BlockItems "}"	<< astx.NewBlockStmt(X[0], X[1], X[2]) >>`,
-		Id:         "BlockStmt",
-		NTType:     20,
+		String: `BasicType : TypeKeyword	<<  >>`,
+		Id:         "BasicType",
+		NTType:     16,
 		Index:      36,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewBlockStmt(X[0], X[1], X[2])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `MatchedStmt : "if" Condition MatchedStmt "else" MatchedStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
-		Id:         "MatchedStmt",
-		NTType:     21,
+		String: `BasicType : TypeName	<<  >>`,
+		Id:         "BasicType",
+		NTType:     16,
 		Index:      37,
-		NumSymbols: 5,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIfStmt(X[0], X[1], X[2], X[4])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `MatchedStmt : "while" Condition MatchedStmt	<< astx.NewWhileStmt(X[0], X[1], X[2]) >>`,
-		Id:         "MatchedStmt",
-		NTType:     21,
+		String: `BasicType : ident	<< astx.NewIdent(X[0]) >>`,
+		Id:         "BasicType",
+		NTType:     16,
 		Index:      38,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewWhileStmt(X[0], X[1], X[2])
+			return astx.NewIdent(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `MatchedStmt : OtherStmt	<<  >>`,
-		Id:         "MatchedStmt",
-		NTType:     21,
+		String: `TypeName : type_name	<< astx.NewIdent(X[0]) >>`,
+		Id:         "TypeName",
+		NTType:     17,
 		Index:      39,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewIdent(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `OpenStmt : "if" Condition Stmt	<< astx.NewIfStmt(X[0], X[1], X[2], nil) >>`,
-		Id:         "OpenStmt",
-		NTType:     22,
+		String: `TypeKeyword : IntTypeKeyword	<<  >>`,
+		Id:         "TypeKeyword",
+		NTType:     18,
 		Index:      40,
-		NumSymbols: 3,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIfStmt(X[0], X[1], X[2], nil)
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `OpenStmt : "if" Condition MatchedStmt "else" OpenStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
-		Id:         "OpenStmt",
-		NTType:     22,
+		String: `TypeKeyword : "unsigned"	<< astx.NewTypeKeyword(X[0]) >>`,
+		Id:         "TypeKeyword",
+		NTType:     18,
 		Index:      41,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `TypeKeyword : "unsigned" IntTypeKeyword	<< astx.NewTypeKeyword(X[0], X[1]) >>`,
+		Id:         "TypeKeyword",
+		NTType:     18,
+		Index:      42,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TypeKeyword : "void"	<< astx.NewIdent(X[0]) >>`,
+		Id:         "TypeKeyword",
+		NTType:     18,
+		Index:      43,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIdent(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "char"	<< astx.NewIdent(X[0]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIdent(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "short"	<< astx.NewIdent(X[0]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      45,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIdent(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "short" "int"	<< astx.NewTypeKeyword(X[0], X[1]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      46,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "int"	<< astx.NewIdent(X[0]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      47,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIdent(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "long"	<< astx.NewIdent(X[0]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      48,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIdent(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "long" "int"	<< astx.NewTypeKeyword(X[0], X[1]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      49,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "long" "long"	<< astx.NewTypeKeyword(X[0], X[1]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      50,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `IntTypeKeyword : "long" "long" "int"	<< astx.NewTypeKeyword(X[0], X[1], X[2]) >>`,
+		Id:         "IntTypeKeyword",
+		NTType:     19,
+		Index:      51,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewTypeKeyword(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `PointerType : TypeKeyword "*"	<< astx.NewPointerType(X[0], X[1]) >>`,
+		Id:         "PointerType",
+		NTType:     20,
+		Index:      52,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPointerType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PointerType : TypeName "*"	<< astx.NewPointerType(X[0], X[1]) >>`,
+		Id:         "PointerType",
+		NTType:     20,
+		Index:      53,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPointerType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PointerType : StructType "*"	<< astx.NewPointerType(X[0], X[1]) >>`,
+		Id:         "PointerType",
+		NTType:     20,
+		Index:      54,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPointerType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PointerType : EnumType "*"	<< astx.NewPointerType(X[0], X[1]) >>`,
+		Id:         "PointerType",
+		NTType:     20,
+		Index:      55,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPointerType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `PointerType : PointerType "*"	<< astx.NewPointerType(X[0], X[1]) >>`,
+		Id:         "PointerType",
+		NTType:     20,
+		Index:      56,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPointerType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Params : empty	<<  >>`,
+		Id:         "Params",
+		NTType:     21,
+		Index:      57,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Params : ParamList	<<  >>`,
+		Id:         "Params",
+		NTType:     21,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ParamList : Param	<< astx.NewParamList(X[0]) >>`,
+		Id:         "ParamList",
+		NTType:     22,
+		Index:      59,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewParamList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `ParamList : ParamList "," Param	<< astx.AppendParam(X[0], X[2]) >>`,
+		Id:         "ParamList",
+		NTType:     22,
+		Index:      60,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.AppendParam(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Param : Type	<< astx.NewAnonParam(X[0]) >>`,
+		Id:         "Param",
+		NTType:     23,
+		Index:      61,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewAnonParam(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Param : VarDecl	<<  >>`,
+		Id:         "Param",
+		NTType:     23,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : BasicType	<<  >>`,
+		Id:         "Type",
+		NTType:     24,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : PointerType	<<  >>`,
+		Id:         "Type",
+		NTType:     24,
+		Index:      64,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : StructType	<<  >>`,
+		Id:         "Type",
+		NTType:     24,
+		Index:      65,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : EnumType	<<  >>`,
+		Id:         "Type",
+		NTType:     24,
+		Index:      66,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `StructType : "struct" ident "{" FieldList "}"	<< astx.NewStructType(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "StructType",
+		NTType:     25,
+		Index:      67,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewStructType(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `StructType : "struct" "{" FieldList "}"	<< astx.NewStructType(X[0], nil, X[1], X[2], X[3]) >>`,
+		Id:         "StructType",
+		NTType:     25,
+		Index:      68,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewStructType(X[0], nil, X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `StructType : "struct" ident	<< astx.NewStructType(X[0], X[1], nil, nil, nil) >>`,
+		Id:         "StructType",
+		NTType:     25,
+		Index:      69,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewStructType(X[0], X[1], nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `FieldList : VarDecl ";"	<< astx.NewFieldList(X[0]) >>`,
+		Id:         "FieldList",
+		NTType:     26,
+		Index:      70,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewFieldList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldList : FieldList VarDecl ";"	<< astx.AppendField(X[0], X[1]) >>`,
+		Id:         "FieldList",
+		NTType:     26,
+		Index:      71,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.AppendField(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumType : "enum" ident "{" EnumConstList "}"	<< astx.NewEnumType(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "EnumType",
+		NTType:     27,
+		Index:      72,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumType : "enum" ident "{" EnumConstList "," "}"	<< astx.NewEnumType(X[0], X[1], X[2], X[3], X[5]) >>`,
+		Id:         "EnumType",
+		NTType:     27,
+		Index:      73,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], X[1], X[2], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumType : "enum" "{" EnumConstList "}"	<< astx.NewEnumType(X[0], nil, X[1], X[2], X[3]) >>`,
+		Id:         "EnumType",
+		NTType:     27,
+		Index:      74,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], nil, X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumType : "enum" "{" EnumConstList "," "}"	<< astx.NewEnumType(X[0], nil, X[1], X[2], X[4]) >>`,
+		Id:         "EnumType",
+		NTType:     27,
+		Index:      75,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], nil, X[1], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumType : "enum" ident	<< astx.NewEnumType(X[0], X[1], nil, nil, nil) >>`,
+		Id:         "EnumType",
+		NTType:     27,
+		Index:      76,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], X[1], nil, nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `EnumConstList : EnumConst	<< astx.NewEnumConstList(X[0]) >>`,
+		Id:         "EnumConstList",
+		NTType:     28,
+		Index:      77,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumConstList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumConstList : EnumConstList "," EnumConst	<< astx.AppendEnumConst(X[0], X[2]) >>`,
+		Id:         "EnumConstList",
+		NTType:     28,
+		Index:      78,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.AppendEnumConst(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumConst : ident	<< astx.NewEnumConst(X[0], nil, nil) >>`,
+		Id:         "EnumConst",
+		NTType:     29,
+		Index:      79,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumConst(X[0], nil, nil)
+		},
+	},
+	ProdTabEntry{
+		String: `EnumConst : ident "=" ConstExpr	<< astx.NewEnumConst(X[0], X[1], X[2]) >>`,
+		Id:         "EnumConst",
+		NTType:     29,
+		Index:      80,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumConst(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Stmt : MatchedStmt	<<  >>`,
+		Id:         "Stmt",
+		NTType:     30,
+		Index:      81,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Stmt : OpenStmt	<<  >>`,
+		Id:         "Stmt",
+		NTType:     30,
+		Index:      82,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : Expr ";"	<< astx.NewExprStmt(X[0]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      83,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewExprStmt(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "return" Expr ";"	<< astx.NewReturnStmt(X[0], X[1]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      84,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewReturnStmt(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "return" ";"	<< astx.NewReturnStmt(X[0], nil) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      85,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewReturnStmt(X[0], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "do" Stmt "while" Condition ";"	<< astx.NewDoWhileStmt(X[0], X[1], X[3]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      86,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewDoWhileStmt(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "break" ";"	<< astx.NewBreakStmt(X[0]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      87,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBreakStmt(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "continue" ";"	<< astx.NewContinueStmt(X[0]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      88,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewContinueStmt(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : "goto" ident ";"	<< astx.NewGotoStmt(X[0], X[1]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      89,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewGotoStmt(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : BlockStmt	<<  >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      90,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OtherStmt : ";"	<< astx.NewEmptyStmt(X[0]) >>`,
+		Id:         "OtherStmt",
+		NTType:     31,
+		Index:      91,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEmptyStmt(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `BlockStmt : "{" BlockItems "}"	<< astx.NewBlockStmt(X[0], X[1], X[2]) >>`,
+		Id:         "BlockStmt",
+		NTType:     32,
+		Index:      92,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBlockStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `BlockStmt : "{" error "}"	<< astx.NewBadBlockStmt(X[0], nil, X[1], X[2]) >>`,
+		Id:         "BlockStmt",
+		NTType:     32,
+		Index:      93,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBadBlockStmt(X[0], nil, X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `BlockStmt : "{" BlockItemList error "}"	<< astx.NewBadBlockStmt(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "BlockStmt",
+		NTType:     32,
+		Index:      94,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBadBlockStmt(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "if" Condition MatchedStmt "else" MatchedStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      95,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIfStmt(X[0], X[1], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "while" Condition MatchedStmt	<< astx.NewWhileStmt(X[0], X[1], X[2]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      96,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewWhileStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "for" "(" ExprOpt ";" ExprOpt ";" ExprOpt ")" MatchedStmt	<< astx.NewForStmt(X[0], X[2], X[4], X[6], X[8]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      97,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewForStmt(X[0], X[2], X[4], X[6], X[8])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "switch" Condition MatchedStmt	<< astx.NewSwitchStmt(X[0], X[1], X[2]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      98,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSwitchStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "case" ConstExpr ":" MatchedStmt	<< astx.NewCaseStmt(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      99,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCaseStmt(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : "default" ":" MatchedStmt	<< astx.NewCaseStmt(X[0], nil, X[1], X[2]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      100,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCaseStmt(X[0], nil, X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : ident ":" MatchedStmt	<< astx.NewLabeledStmt(X[0], X[1], X[2]) >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      101,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewLabeledStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchedStmt : OtherStmt	<<  >>`,
+		Id:         "MatchedStmt",
+		NTType:     33,
+		Index:      102,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : "if" Condition Stmt	<< astx.NewIfStmt(X[0], X[1], X[2], nil) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      103,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewIfStmt(X[0], X[1], X[2], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : "if" Condition MatchedStmt "else" OpenStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      104,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIfStmt(X[0], X[1], X[2], X[4])
@@ -446,18 +1076,78 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OpenStmt : "while" Condition OpenStmt	<< astx.NewWhileStmt(X[0], X[1], X[2]) >>`,
 		Id:         "OpenStmt",
-		NTType:     22,
-		Index:      42,
+		NTType:     34,
+		Index:      105,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewWhileStmt(X[0], X[1], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `OpenStmt : "for" "(" ExprOpt ";" ExprOpt ";" ExprOpt ")" OpenStmt	<< astx.NewForStmt(X[0], X[2], X[4], X[6], X[8]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      106,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewForStmt(X[0], X[2], X[4], X[6], X[8])
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : "switch" Condition OpenStmt	<< astx.NewSwitchStmt(X[0], X[1], X[2]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      107,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSwitchStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : "case" ConstExpr ":" OpenStmt	<< astx.NewCaseStmt(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      108,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCaseStmt(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : "default" ":" OpenStmt	<< astx.NewCaseStmt(X[0], nil, X[1], X[2]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      109,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCaseStmt(X[0], nil, X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `OpenStmt : ident ":" OpenStmt	<< astx.NewLabeledStmt(X[0], X[1], X[2]) >>`,
+		Id:         "OpenStmt",
+		NTType:     34,
+		Index:      110,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewLabeledStmt(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ConstExpr : Expr3R	<<  >>`,
+		Id:         "ConstExpr",
+		NTType:     35,
+		Index:      111,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `Condition : "(" Expr ")"	<< X[1], nil >>`,
 		Id:         "Condition",
-		NTType:     23,
-		Index:      43,
+		NTType:     36,
+		Index:      112,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[1], nil
@@ -466,108 +1156,338 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItems : empty	<<  >>`,
 		Id:         "BlockItems",
-		NTType:     24,
-		Index:      44,
+		NTType:     37,
+		Index:      113,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItems : BlockItemList	<<  >>`,
+		Id:         "BlockItems",
+		NTType:     37,
+		Index:      114,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItemList : BlockItem	<< astx.NewBlockItemList(X[0]) >>`,
+		Id:         "BlockItemList",
+		NTType:     38,
+		Index:      115,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBlockItemList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItemList : BlockItemList BlockItem	<< astx.AppendBlockItem(X[0], X[1]) >>`,
+		Id:         "BlockItemList",
+		NTType:     38,
+		Index:      116,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.AppendBlockItem(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItem : Decl	<<  >>`,
+		Id:         "BlockItem",
+		NTType:     39,
+		Index:      117,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItem : Stmt	<<  >>`,
+		Id:         "BlockItem",
+		NTType:     39,
+		Index:      118,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BlockItem : error ";"	<< astx.NewBadStmt(X[0], X[1]) >>`,
+		Id:         "BlockItem",
+		NTType:     39,
+		Index:      119,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBadStmt(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr : Expr2R	<<  >>`,
+		Id:         "Expr",
+		NTType:     40,
+		Index:      120,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ExprOpt : empty	<<  >>`,
+		Id:         "ExprOpt",
+		NTType:     41,
+		Index:      121,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return nil, nil
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ExprOpt : Expr	<<  >>`,
+		Id:         "ExprOpt",
+		NTType:     41,
+		Index:      122,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr3R	<<  >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      123,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      124,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "+=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      125,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "-=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      126,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "*=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      127,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "/=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      128,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "%=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      129,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "<<=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      130,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L ">>=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      131,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "&=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      132,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "^=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      133,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr4L "|=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr2R",
+		NTType:     42,
+		Index:      134,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `BlockItems : BlockItemList	<<  >>`,
-		Id:         "BlockItems",
-		NTType:     24,
-		Index:      45,
+		String: `Expr3R : Expr4L	<<  >>`,
+		Id:         "Expr3R",
+		NTType:     43,
+		Index:      135,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `BlockItemList : BlockItem	<< astx.NewBlockItemList(X[0]) >>`,
-		Id:         "BlockItemList",
-		NTType:     25,
-		Index:      46,
+		String: `Expr3R : Expr4L "?" Expr ":" Expr3R	<< astx.NewCondExpr(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "Expr3R",
+		NTType:     43,
+		Index:      136,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCondExpr(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr4L : Expr5L	<<  >>`,
+		Id:         "Expr4L",
+		NTType:     44,
+		Index:      137,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewBlockItemList(X[0])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `BlockItemList : BlockItemList BlockItem	<< astx.AppendBlockItem(X[0], X[1]) >>`,
-		Id:         "BlockItemList",
-		NTType:     25,
-		Index:      47,
-		NumSymbols: 2,
+		String: `Expr4L : Expr4L "||" Expr5L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr4L",
+		NTType:     44,
+		Index:      138,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.AppendBlockItem(X[0], X[1])
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `BlockItem : Decl	<<  >>`,
-		Id:         "BlockItem",
-		NTType:     26,
-		Index:      48,
+		String: `Expr5L : Expr6L	<<  >>`,
+		Id:         "Expr5L",
+		NTType:     45,
+		Index:      139,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `BlockItem : Stmt	<<  >>`,
-		Id:         "BlockItem",
-		NTType:     26,
-		Index:      49,
-		NumSymbols: 1,
+		String: `Expr5L : Expr5L "&&" Expr6L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr5L",
+		NTType:     45,
+		Index:      140,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr : Expr2R	<<  >>`,
-		Id:         "Expr",
-		NTType:     27,
-		Index:      50,
+		String: `Expr6L : Expr7L	<<  >>`,
+		Id:         "Expr6L",
+		NTType:     46,
+		Index:      141,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr2R : Expr5L	<<  >>`,
-		Id:         "Expr2R",
-		NTType:     28,
-		Index:      51,
+		String: `Expr6L : Expr6L "|" Expr7L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr6L",
+		NTType:     46,
+		Index:      142,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr7L : Expr8L	<<  >>`,
+		Id:         "Expr7L",
+		NTType:     47,
+		Index:      143,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr2R : Expr5L "=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
-		Id:         "Expr2R",
-		NTType:     28,
-		Index:      52,
+		String: `Expr7L : Expr7L "^" Expr8L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr7L",
+		NTType:     47,
+		Index:      144,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr5L : Expr9L	<<  >>`,
-		Id:         "Expr5L",
-		NTType:     29,
-		Index:      53,
+		String: `Expr8L : Expr9L	<<  >>`,
+		Id:         "Expr8L",
+		NTType:     48,
+		Index:      145,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr5L : Expr5L "&&" Expr9L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
-		Id:         "Expr5L",
-		NTType:     29,
-		Index:      54,
+		String: `Expr8L : Expr8L "&" Expr9L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr8L",
+		NTType:     48,
+		Index:      146,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -576,8 +1496,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr10L	<<  >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      55,
+		NTType:     49,
+		Index:      147,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -586,8 +1506,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr9L "==" Expr10L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      56,
+		NTType:     49,
+		Index:      148,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -596,58 +1516,88 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr9L "!=" Expr10L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      57,
+		NTType:     49,
+		Index:      149,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr10L : Expr12L	<<  >>`,
+		String: `Expr10L : Expr11L	<<  >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      58,
+		NTType:     50,
+		Index:      150,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr10L : Expr10L "<" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		String: `Expr10L : Expr10L "<" Expr11L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      59,
+		NTType:     50,
+		Index:      151,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr10L : Expr10L ">" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		String: `Expr10L : Expr10L ">" Expr11L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      60,
+		NTType:     50,
+		Index:      152,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr10L : Expr10L "<=" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		String: `Expr10L : Expr10L "<=" Expr11L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      61,
+		NTType:     50,
+		Index:      153,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr10L : Expr10L ">=" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		String: `Expr10L : Expr10L ">=" Expr11L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      62,
+		NTType:     50,
+		Index:      154,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr11L : Expr12L	<<  >>`,
+		Id:         "Expr11L",
+		NTType:     51,
+		Index:      155,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr11L : Expr11L "<<" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr11L",
+		NTType:     51,
+		Index:      156,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr11L : Expr11L ">>" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr11L",
+		NTType:     51,
+		Index:      157,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -656,8 +1606,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr13L	<<  >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      63,
+		NTType:     52,
+		Index:      158,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -666,8 +1616,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr12L "+" Expr13L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      64,
+		NTType:     52,
+		Index:      159,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -676,8 +1626,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr12L "-" Expr13L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      65,
+		NTType:     52,
+		Index:      160,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -686,8 +1636,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr14	<<  >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      66,
+		NTType:     53,
+		Index:      161,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -696,8 +1646,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr13L "*" Expr14	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      67,
+		NTType:     53,
+		Index:      162,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -706,58 +1656,208 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr13L "/" Expr14	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      68,
+		NTType:     53,
+		Index:      163,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr13L : Expr13L "%" Expr14	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr13L",
+		NTType:     53,
+		Index:      164,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr14 : Expr15	<<  >>`,
+		String: `Expr14 : UnaryExpr	<<  >>`,
 		Id:         "Expr14",
-		NTType:     34,
-		Index:      69,
+		NTType:     54,
+		Index:      165,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr14 : "-" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		String: `Expr14 : "(" CastType ")" Expr14	<< astx.NewCastExpr(X[0], X[1], X[2], X[3]) >>`,
 		Id:         "Expr14",
-		NTType:     34,
-		Index:      70,
+		NTType:     54,
+		Index:      166,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCastExpr(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : Expr15	<<  >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      167,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "-" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      168,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewUnaryExpr(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Expr14 : "!" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
-		Id:         "Expr14",
-		NTType:     34,
-		Index:      71,
+		String: `UnaryExpr : "!" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      169,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "~" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      170,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "++" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      171,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "--" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      172,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "*" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      173,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "&" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      174,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewUnaryExpr(X[0], X[1])
 		},
 	},
+	ProdTabEntry{
+		String: `UnaryExpr : "sizeof" UnaryExpr	<< astx.NewSizeofExpr(X[0], X[1]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      175,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSizeofExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `UnaryExpr : "sizeof" "(" CastType ")"	<< astx.NewSizeofType(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "UnaryExpr",
+		NTType:     55,
+		Index:      176,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSizeofType(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `CastType : TypeKeyword	<<  >>`,
+		Id:         "CastType",
+		NTType:     56,
+		Index:      177,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastType : TypeName	<<  >>`,
+		Id:         "CastType",
+		NTType:     56,
+		Index:      178,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastType : PointerType	<<  >>`,
+		Id:         "CastType",
+		NTType:     56,
+		Index:      179,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastType : StructType	<<  >>`,
+		Id:         "CastType",
+		NTType:     56,
+		Index:      180,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastType : EnumType	<<  >>`,
+		Id:         "CastType",
+		NTType:     56,
+		Index:      181,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `Expr15 : PrimaryExpr	<<  >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      72,
+		NTType:     57,
+		Index:      182,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr15 : ident "[" Expr "]"	<< astx.NewIndexExpr(X[0], X[1], X[2], X[3]) >>`,
+		String: `Expr15 : Expr15 "[" Expr "]"	<< astx.NewIndexExpr(X[0], X[1], X[2], X[3]) >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      73,
+		NTType:     57,
+		Index:      183,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIndexExpr(X[0], X[1], X[2], X[3])
@@ -766,18 +1866,58 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr15 : ident "(" Args ")"	<< astx.NewCallExpr(X[0], X[1], X[2], X[3]) >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      74,
+		NTType:     57,
+		Index:      184,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewCallExpr(X[0], X[1], X[2], X[3])
 		},
 	},
+	ProdTabEntry{
+		String: `Expr15 : Expr15 "++"	<< astx.NewPostfixExpr(X[0], X[1]) >>`,
+		Id:         "Expr15",
+		NTType:     57,
+		Index:      185,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPostfixExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr15 : Expr15 "--"	<< astx.NewPostfixExpr(X[0], X[1]) >>`,
+		Id:         "Expr15",
+		NTType:     57,
+		Index:      186,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewPostfixExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr15 : Expr15 "." ident	<< astx.NewSelectorExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr15",
+		NTType:     57,
+		Index:      187,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSelectorExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr15 : Expr15 "->" ident	<< astx.NewSelectorExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr15",
+		NTType:     57,
+		Index:      188,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSelectorExpr(X[0], X[1], X[2])
+		},
+	},
 	ProdTabEntry{
 		String: `PrimaryExpr : int_lit	<< astx.NewBasicLit(X[0], token.IntLit) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      75,
+		NTType:     58,
+		Index:      189,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBasicLit(X[0], token.IntLit)
@@ -786,18 +1926,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : char_lit	<< astx.NewBasicLit(X[0], token.CharLit) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      76,
+		NTType:     58,
+		Index:      190,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBasicLit(X[0], token.CharLit)
 		},
 	},
+	ProdTabEntry{
+		String: `PrimaryExpr : string_lit	<< astx.NewBasicLit(X[0], token.StringLit) >>`,
+		Id:         "PrimaryExpr",
+		NTType:     58,
+		Index:      191,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBasicLit(X[0], token.StringLit)
+		},
+	},
 	ProdTabEntry{
 		String: `PrimaryExpr : ident	<< astx.NewIdent(X[0]) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      77,
+		NTType:     58,
+		Index:      192,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIdent(X[0])
@@ -806,8 +1956,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : ParenExpr	<<  >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      78,
+		NTType:     58,
+		Index:      193,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -816,8 +1966,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParenExpr : "(" Expr ")"	<< astx.NewParenExpr(X[0], X[1], X[2]) >>`,
 		Id:         "ParenExpr",
-		NTType:     37,
-		Index:      79,
+		NTType:     59,
+		Index:      194,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewParenExpr(X[0], X[1], X[2])
@@ -826,8 +1976,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Args : empty	<<  >>`,
 		Id:         "Args",
-		NTType:     38,
-		Index:      80,
+		NTType:     60,
+		Index:      195,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return nil, nil
@@ -836,8 +1986,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Args : ExprList	<<  >>`,
 		Id:         "Args",
-		NTType:     38,
-		Index:      81,
+		NTType:     60,
+		Index:      196,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -846,8 +1996,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ExprList : Expr	<< astx.NewExprList(X[0]) >>`,
 		Id:         "ExprList",
-		NTType:     39,
-		Index:      82,
+		NTType:     61,
+		Index:      197,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewExprList(X[0])
@@ -856,8 +2006,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ExprList : ExprList "," Expr	<< astx.AppendExpr(X[0], X[2]) >>`,
 		Id:         "ExprList",
-		NTType:     39,
-		Index:      83,
+		NTType:     61,
+		Index:      198,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.AppendExpr(X[0], X[2])
@@ -0,0 +1,142 @@
+package parser
+
+import (
+	parseError "github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/token"
+)
+
+// The error recovery of the parser, and the tracking of type definition names,
+// is kept separate from the Gocc generated parser.go, so that it survives the
+// regeneration of the parser.
+
+// numRecoveryShifts specifies the number of tokens to shift after error
+// recovery before reporting new syntax errors.
+const numRecoveryShifts = 3
+
+// ParseAll parses the tokens of the given scanner. Syntax errors are recovered
+// from using the error productions of the grammar, and parsing continues to
+// locate further syntax errors. If any syntax error was encountered, the
+// returned error is a parseError.List of every syntax error, and the result
+// holds the partial parse tree of the input; or nil if the parser failed to
+// recover.
+//
+// Identifiers denoting type definitions in scope are scanned as type_name
+// tokens.
+func (P *Parser) ParseAll(scanner Scanner) (res interface{}, err error) {
+	names := newTypeNames()
+	scanner = &typeNameScanner{scanner: scanner, names: names}
+
+	// errs records the syntax errors encountered while parsing.
+	var errs parseError.List
+	// errStatus specifies the number of tokens to shift after error recovery
+	// before reporting new syntax errors; used to prevent cascading error
+	// reports.
+	errStatus := 0
+	// addError records the given syntax error, unless too few tokens have been
+	// shifted since the last error recovery.
+	addError := func(err *parseError.Error) {
+		if errStatus == 0 {
+			errs = append(errs, err)
+		}
+		errStatus = numRecoveryShifts
+	}
+
+	P.Reset()
+	P.nextToken = scanner.Scan()
+	for acc := false; !acc; {
+		action := actionTab[P.stack.top()].actions[P.nextToken.Type]
+		if action == nil {
+			recovered, errAttrib := P.recoverError(scanner)
+			addError(errAttrib)
+			if !recovered {
+				return nil, errs
+			}
+			if action = actionTab[P.stack.top()].actions[P.nextToken.Type]; action == nil {
+				panic("Error recovery led to invalid action")
+			}
+		}
+		switch act := action.(type) {
+		case accept:
+			res = P.stack.popN(1)[0]
+			acc = true
+		case shift:
+			P.stack.push(int(act), P.nextToken)
+			names.shift(P.nextToken)
+			P.nextToken = scanner.Scan()
+			if errStatus > 0 {
+				errStatus--
+			}
+		case reduce:
+			prod := productionsTable[int(act)]
+			attrib, err := prod.ReduceFunc(P.stack.popN(prod.NumSymbols))
+			if err != nil {
+				errStatus = 0
+				addError(P.newError(err).(*parseError.Error))
+				return nil, errs
+			}
+			names.reduce(attrib)
+			P.stack.push(gotoTab[P.stack.top()][prod.NTType], attrib)
+		default:
+			panic("unknown action: " + action.String())
+		}
+	}
+	return res, errs.Err()
+}
+
+// recoverError recovers from the syntax error at the next token, by popping
+// states until a state which may shift the error token is located, and
+// skipping input tokens until the next token is valid in the recovered state.
+//
+// In contrast to the Error method of the generated parser, the recovery state
+// is reduced on the error lookahead until the error token may be shifted, and
+// the expected tokens are those of the erroneous state rather than those of the
+// recovery state.
+func (P *Parser) recoverError(scanner Scanner) (recovered bool, errorAttrib *parseError.Error) {
+	errorAttrib = &parseError.Error{
+		ErrorToken:     P.nextToken,
+		ExpectedTokens: make([]string, 0, 8),
+		StackTop:       P.stack.top(),
+	}
+	// Record the expected tokens of the erroneous state, before popping states
+	// to recover.
+	for t, action := range actionTab[P.stack.top()].actions {
+		if action != nil {
+			errorAttrib.ExpectedTokens = append(errorAttrib.ExpectedTokens, token.TokMap.Id(token.Type(t)))
+		}
+	}
+	errorAttrib.ErrorSymbols = P.popNonRecoveryStates()
+
+	// Reduce the recovery state on the error lookahead, until the error token
+	// may be shifted.
+	errType := token.TokMap.Type("error")
+	for {
+		action := actionTab[P.stack.top()].actions[errType]
+		if action == nil {
+			return false, errorAttrib
+		}
+		if act, ok := action.(shift); ok {
+			P.stack.push(int(act), errorAttrib)
+			break
+		}
+		act, ok := action.(reduce)
+		if !ok {
+			return false, errorAttrib
+		}
+		prod := productionsTable[int(act)]
+		attrib, err := prod.ReduceFunc(P.stack.popN(prod.NumSymbols))
+		if err != nil {
+			return false, errorAttrib
+		}
+		P.stack.push(gotoTab[P.stack.top()][prod.NTType], attrib)
+	}
+
+	// Skip input tokens until the next token is valid in the recovered state.
+	for P.nextToken.Type != token.EOF {
+		if action := actionTab[P.stack.top()].actions[P.nextToken.Type]; action != nil {
+			return true, errorAttrib
+		}
+		P.nextToken = scanner.Scan()
+	}
+	action := actionTab[P.stack.top()].actions[P.nextToken.Type]
+	return action != nil, errorAttrib
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/token"
+)
+
+// Token types used to track the type definition names in scope.
+var (
+	identType    = token.TokMap.Type("ident")
+	typeNameType = token.TokMap.Type("type_name")
+	lbraceType   = token.TokMap.Type("{")
+	rbraceType   = token.TokMap.Type("}")
+	lparenType   = token.TokMap.Type("(")
+	rparenType   = token.TokMap.Type(")")
+	structType   = token.TokMap.Type("struct")
+	enumType     = token.TokMap.Type("enum")
+	periodType   = token.TokMap.Type(".")
+	arrowType    = token.TokMap.Type("->")
+)
+
+// typeNames tracks the type definition names in scope while parsing, so that
+// identifiers denoting type definitions are scanned as type_name tokens rather
+// than ident tokens; e.g. to distinguish the declaration `T *x;` from the
+// multiplication expression `x * y;`, and the cast expression `(T)x` from the
+// parenthesized expression `(x)`.
+//
+// The scopes are tracked by the braces of shifted tokens, and declarations are
+// recorded as they are reduced. As the parser uses a single token of
+// lookahead, the token following a declaration is scanned after the
+// declaration has been reduced.
+type typeNames struct {
+	// Scopes of declared identifiers, from outermost to innermost. Maps from
+	// identifier names to whether they denote type definitions (true) or
+	// ordinary identifiers shadowing the type definitions of outer scopes
+	// (false).
+	scopes []map[string]bool
+	// Depth of nested parentheses; used to locate parameter declarations.
+	parens int
+	// Parameter names of the function declaration being parsed.
+	params []string
+	// Parameter names of the preceding function declaration, which are declared
+	// in the scope of the function body if followed by one.
+	bodyParams []string
+	// Specifies whether the next token is in a separate name space from
+	// ordinary identifiers; i.e. a structure or enumeration tag, or a structure
+	// field.
+	otherSpace bool
+}
+
+// newTypeNames returns a new tracker of type definition names, with an empty
+// file scope.
+func newTypeNames() *typeNames {
+	return &typeNames{scopes: []map[string]bool{make(map[string]bool)}}
+}
+
+// A typeNameScanner scans identifiers denoting type definitions in scope as
+// type_name tokens.
+type typeNameScanner struct {
+	// Underlying scanner.
+	scanner Scanner
+	// Type definition names in scope.
+	names *typeNames
+}
+
+// Scan lexes and returns the next token of the source input.
+func (s *typeNameScanner) Scan() *token.Token {
+	return s.names.classify(s.scanner.Scan())
+}
+
+// isTypeName reports whether the given identifier denotes a type definition in
+// the innermost scope declaring the identifier.
+func (t *typeNames) isTypeName(name string) bool {
+	for i := len(t.scopes) - 1; i >= 0; i-- {
+		if isType, ok := t.scopes[i][name]; ok {
+			return isType
+		}
+	}
+	return false
+}
+
+// classify changes the type of the given identifier token to type_name, if the
+// identifier denotes a type definition.
+func (t *typeNames) classify(tok *token.Token) *token.Token {
+	if tok.Type == identType && !t.otherSpace && t.isTypeName(string(tok.Lit)) {
+		tok.Type = typeNameType
+	}
+	return tok
+}
+
+// shift updates the scopes based on the given shifted token.
+func (t *typeNames) shift(tok *token.Token) {
+	switch tok.Type {
+	case lbraceType:
+		scope := make(map[string]bool)
+		for _, name := range t.bodyParams {
+			scope[name] = false
+		}
+		t.scopes = append(t.scopes, scope)
+	case rbraceType:
+		// Unbalanced braces may be encountered after error recovery.
+		if len(t.scopes) > 1 {
+			t.scopes = t.scopes[:len(t.scopes)-1]
+		}
+	case lparenType:
+		t.parens++
+	case rparenType:
+		if t.parens > 0 {
+			t.parens--
+		}
+	}
+	t.bodyParams = nil
+	switch tok.Type {
+	case structType, enumType, periodType, arrowType:
+		t.otherSpace = true
+	default:
+		t.otherSpace = false
+	}
+}
+
+// reduce records the declaration of the given reduced attribute, if any.
+func (t *typeNames) reduce(attrib Attrib) {
+	switch n := attrib.(type) {
+	case *ast.TypeDef:
+		t.declare(n.TypeName.Name, true)
+	case *ast.VarDecl:
+		if n.VarName == nil {
+			// Anonymous parameter, or structure or enumeration declaration.
+			return
+		}
+		if t.parens > 0 {
+			t.params = append(t.params, n.VarName.Name)
+			return
+		}
+		t.declare(n.VarName.Name, false)
+	case *ast.FuncDecl:
+		t.declare(n.Name().Name, false)
+		t.bodyParams = t.params
+		t.params = nil
+	}
+}
+
+// declare declares the given identifier in the innermost scope.
+func (t *typeNames) declare(name string, isType bool) {
+	scope := t.scopes[len(t.scopes)-1]
+	if isType || t.isTypeName(name) {
+		scope[name] = isType
+	}
+}
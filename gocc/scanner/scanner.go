@@ -10,6 +10,7 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/gocc/lexer"
 	"github.com/mewmew/uc/gocc/token"
+	uctoken "github.com/mewmew/uc/token"
 )
 
 // Scanner represents the lexer interface used by the Gocc parser.
@@ -54,6 +55,43 @@ func NewFromString(input string) Scanner {
 
 // NewFromBytes returns a new scanner lexing from input.
 func NewFromBytes(input []byte) Scanner {
+	return lexer.NewLexer(appendNewLine(input))
+}
+
+// NewFromFile returns a new scanner lexing from input, which holds the contents
+// of the given source file. Token positions are relative to the file set of the
+// source file.
+func NewFromFile(file *uctoken.File, input []byte) Scanner {
+	file.SetLinesForContent(input)
+	l := lexer.NewLexer(appendNewLine(input))
+	return &fileScanner{l: l, file: file}
+}
+
+// A fileScanner translates the token offsets of the Gocc lexer into positions
+// within the file set of a source file.
+type fileScanner struct {
+	// Underlying Gocc lexer.
+	l *lexer.Lexer
+	// Source file of the lexed tokens.
+	file *uctoken.File
+}
+
+// Scan lexes and returns the next token of the source input.
+func (s *fileScanner) Scan() *token.Token {
+	tok := s.l.Scan()
+	// Clamp the offset to the file size, as a new line may have been appended
+	// to the input.
+	offset := tok.Offset
+	if offset > s.file.Size() {
+		offset = s.file.Size()
+	}
+	tok.Offset = int(s.file.Pos(offset))
+	return tok
+}
+
+// appendNewLine appends a new line to input if the input does not end with a
+// new line.
+func appendNewLine(input []byte) []byte {
 	// Append new line to files not ending with new line.
 	appendNewLine := false
 	lastNewLine := bytes.LastIndexByte(input, '\n')
@@ -67,6 +105,5 @@ func NewFromBytes(input []byte) Scanner {
 	if appendNewLine {
 		input = append(input, '\n')
 	}
-
-	return lexer.NewLexer(input)
+	return input
 }
@@ -19,7 +19,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/incorrect/lexer/bad.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 114},
 				},
@@ -34,7 +34,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 122},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 123},
 				},
@@ -135,17 +135,17 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 351},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("char"),
 					Lit:  []byte("char"),
 					Pos:  token.Pos{Offset: 354},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 359},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 363},
 				},
@@ -479,255 +479,1500 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/incorrect/lexer/long-char.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
-					Lit:  []byte("main"),
-					Pos:  token.Pos{Offset: 4},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("main"),
+					Pos:  token.Pos{Offset: 4},
+				},
+				{
+					Type: token.TokMap.Type("("),
+					Lit:  []byte("("),
+					Pos:  token.Pos{Offset: 8},
+				},
+				{
+					Type: token.TokMap.Type("void"),
+					Lit:  []byte("void"),
+					Pos:  token.Pos{Offset: 9},
+				},
+				{
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 13},
+				},
+				{
+					Type: token.TokMap.Type("{"),
+					Lit:  []byte("{"),
+					Pos:  token.Pos{Offset: 15},
+				},
+				{
+					Type: token.TokMap.Type("char"),
+					Lit:  []byte("char"),
+					Pos:  token.Pos{Offset: 19},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 24},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 25},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 29},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 31},
+				},
+				{
+					Type: token.TokMap.Type("char_lit"),
+					Lit:  []byte("'c'"),
+					Pos:  token.Pos{Offset: 33},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 36},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 46},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 48},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("'cc"),
+					Pos:  token.Pos{Offset: 50},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("'; "),
+					Pos:  token.Pos{Offset: 53},
+				},
+				{
+					Type: token.TokMap.Type("}"),
+					Lit:  []byte("}"),
+					Pos:  token.Pos{Offset: 66},
+				},
+				{
+					Type: token.TokMap.Type("$"),
+					Lit:  []byte(""),
+					Pos:  token.Pos{Offset: 68},
+				},
+			},
+		},
+
+		{
+			path: "../../testdata/incorrect/lexer/ugly.c",
+			toks: []*token.Token{
+				{
+					Type: token.TokMap.Type("|"),
+					Lit:  []byte("|"),
+					Pos:  token.Pos{Offset: 0},
+				},
+				{
+					Type: token.TokMap.Type("+"),
+					Lit:  []byte("+"),
+					Pos:  token.Pos{Offset: 1},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x01"),
+					Pos:  token.Pos{Offset: 2},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x02"),
+					Pos:  token.Pos{Offset: 3},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x03"),
+					Pos:  token.Pos{Offset: 4},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x04"),
+					Pos:  token.Pos{Offset: 5},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x05"),
+					Pos:  token.Pos{Offset: 6},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x06"),
+					Pos:  token.Pos{Offset: 7},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x07"),
+					Pos:  token.Pos{Offset: 8},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x08"),
+					Pos:  token.Pos{Offset: 9},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x0E"),
+					Pos:  token.Pos{Offset: 15},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x0F"),
+					Pos:  token.Pos{Offset: 16},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x10"),
+					Pos:  token.Pos{Offset: 17},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x11"),
+					Pos:  token.Pos{Offset: 18},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x12"),
+					Pos:  token.Pos{Offset: 19},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x13"),
+					Pos:  token.Pos{Offset: 20},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x14"),
+					Pos:  token.Pos{Offset: 21},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x15"),
+					Pos:  token.Pos{Offset: 22},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x16"),
+					Pos:  token.Pos{Offset: 23},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x17"),
+					Pos:  token.Pos{Offset: 24},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x18"),
+					Pos:  token.Pos{Offset: 25},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x19"),
+					Pos:  token.Pos{Offset: 26},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1A"),
+					Pos:  token.Pos{Offset: 27},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1B"),
+					Pos:  token.Pos{Offset: 28},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1C"),
+					Pos:  token.Pos{Offset: 29},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1D"),
+					Pos:  token.Pos{Offset: 30},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1E"),
+					Pos:  token.Pos{Offset: 31},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x1F"),
+					Pos:  token.Pos{Offset: 32},
+				},
+				{
+					Type: token.TokMap.Type("!"),
+					Lit:  []byte("!"),
+					Pos:  token.Pos{Offset: 34},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte(`"#$%&'()*+ ,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\]`),
+					Pos:  token.Pos{Offset: 35},
+				},
+				{
+					Type: token.TokMap.Type("^"),
+					Lit:  []byte("^"),
+					Pos:  token.Pos{Offset: 96},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("_"),
+					Pos:  token.Pos{Offset: 97},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("`"),
+					Pos:  token.Pos{Offset: 98},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("abcdefghijklmnopqrstuvwxyz"),
+					Pos:  token.Pos{Offset: 99},
+				},
+				{
+					Type: token.TokMap.Type("{"),
+					Lit:  []byte("{"),
+					Pos:  token.Pos{Offset: 125},
+				},
+				{
+					Type: token.TokMap.Type("|"),
+					Lit:  []byte("|"),
+					Pos:  token.Pos{Offset: 126},
+				},
+				{
+					Type: token.TokMap.Type("}"),
+					Lit:  []byte("}"),
+					Pos:  token.Pos{Offset: 127},
+				},
+				{
+					Type: token.TokMap.Type("~"),
+					Lit:  []byte("~"),
+					Pos:  token.Pos{Offset: 128},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x7F"),
+					Pos:  token.Pos{Offset: 129},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x80"),
+					Pos:  token.Pos{Offset: 130},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x81"),
+					Pos:  token.Pos{Offset: 131},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x82"),
+					Pos:  token.Pos{Offset: 132},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x83"),
+					Pos:  token.Pos{Offset: 133},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x84"),
+					Pos:  token.Pos{Offset: 134},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x85"),
+					Pos:  token.Pos{Offset: 135},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x86"),
+					Pos:  token.Pos{Offset: 136},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x87"),
+					Pos:  token.Pos{Offset: 137},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x88"),
+					Pos:  token.Pos{Offset: 138},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x89"),
+					Pos:  token.Pos{Offset: 139},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8A"),
+					Pos:  token.Pos{Offset: 140},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8B"),
+					Pos:  token.Pos{Offset: 141},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8C"),
+					Pos:  token.Pos{Offset: 142},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8D"),
+					Pos:  token.Pos{Offset: 143},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8E"),
+					Pos:  token.Pos{Offset: 144},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x8F"),
+					Pos:  token.Pos{Offset: 145},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x90"),
+					Pos:  token.Pos{Offset: 146},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x91"),
+					Pos:  token.Pos{Offset: 147},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x92"),
+					Pos:  token.Pos{Offset: 148},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x93"),
+					Pos:  token.Pos{Offset: 149},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x94"),
+					Pos:  token.Pos{Offset: 150},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x95"),
+					Pos:  token.Pos{Offset: 151},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x96"),
+					Pos:  token.Pos{Offset: 152},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x97"),
+					Pos:  token.Pos{Offset: 153},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x98"),
+					Pos:  token.Pos{Offset: 154},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x99"),
+					Pos:  token.Pos{Offset: 155},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9A"),
+					Pos:  token.Pos{Offset: 156},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9B"),
+					Pos:  token.Pos{Offset: 157},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9C"),
+					Pos:  token.Pos{Offset: 158},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9D"),
+					Pos:  token.Pos{Offset: 159},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9E"),
+					Pos:  token.Pos{Offset: 160},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\x9F"),
+					Pos:  token.Pos{Offset: 161},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA0"),
+					Pos:  token.Pos{Offset: 162},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA1"),
+					Pos:  token.Pos{Offset: 163},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA2"),
+					Pos:  token.Pos{Offset: 164},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA3"),
+					Pos:  token.Pos{Offset: 165},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA4"),
+					Pos:  token.Pos{Offset: 166},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA5"),
+					Pos:  token.Pos{Offset: 167},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA6"),
+					Pos:  token.Pos{Offset: 168},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA7"),
+					Pos:  token.Pos{Offset: 169},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA8"),
+					Pos:  token.Pos{Offset: 170},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xA9"),
+					Pos:  token.Pos{Offset: 171},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAA"),
+					Pos:  token.Pos{Offset: 172},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAB"),
+					Pos:  token.Pos{Offset: 173},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAC"),
+					Pos:  token.Pos{Offset: 174},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAD"),
+					Pos:  token.Pos{Offset: 175},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAE"),
+					Pos:  token.Pos{Offset: 176},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xAF"),
+					Pos:  token.Pos{Offset: 177},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB0"),
+					Pos:  token.Pos{Offset: 178},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB1"),
+					Pos:  token.Pos{Offset: 179},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB2"),
+					Pos:  token.Pos{Offset: 180},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB3"),
+					Pos:  token.Pos{Offset: 181},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB4"),
+					Pos:  token.Pos{Offset: 182},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB5"),
+					Pos:  token.Pos{Offset: 183},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB6"),
+					Pos:  token.Pos{Offset: 184},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB7"),
+					Pos:  token.Pos{Offset: 185},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB8"),
+					Pos:  token.Pos{Offset: 186},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xB9"),
+					Pos:  token.Pos{Offset: 187},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBA"),
+					Pos:  token.Pos{Offset: 188},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBB"),
+					Pos:  token.Pos{Offset: 189},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBC"),
+					Pos:  token.Pos{Offset: 190},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBD"),
+					Pos:  token.Pos{Offset: 191},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBE"),
+					Pos:  token.Pos{Offset: 192},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xBF"),
+					Pos:  token.Pos{Offset: 193},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC0"),
+					Pos:  token.Pos{Offset: 194},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC1"),
+					Pos:  token.Pos{Offset: 195},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC2"),
+					Pos:  token.Pos{Offset: 196},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC3"),
+					Pos:  token.Pos{Offset: 197},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC4"),
+					Pos:  token.Pos{Offset: 198},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC5"),
+					Pos:  token.Pos{Offset: 199},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC6"),
+					Pos:  token.Pos{Offset: 200},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC7"),
+					Pos:  token.Pos{Offset: 201},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC8"),
+					Pos:  token.Pos{Offset: 202},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xC9"),
+					Pos:  token.Pos{Offset: 203},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCA"),
+					Pos:  token.Pos{Offset: 204},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCB"),
+					Pos:  token.Pos{Offset: 205},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCC"),
+					Pos:  token.Pos{Offset: 206},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCD"),
+					Pos:  token.Pos{Offset: 207},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCE"),
+					Pos:  token.Pos{Offset: 208},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xCF"),
+					Pos:  token.Pos{Offset: 209},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD0"),
+					Pos:  token.Pos{Offset: 210},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD1"),
+					Pos:  token.Pos{Offset: 211},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD2"),
+					Pos:  token.Pos{Offset: 212},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD3"),
+					Pos:  token.Pos{Offset: 213},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD4"),
+					Pos:  token.Pos{Offset: 214},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD5"),
+					Pos:  token.Pos{Offset: 215},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD6"),
+					Pos:  token.Pos{Offset: 216},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD7"),
+					Pos:  token.Pos{Offset: 217},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD8"),
+					Pos:  token.Pos{Offset: 218},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xD9"),
+					Pos:  token.Pos{Offset: 219},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDA"),
+					Pos:  token.Pos{Offset: 220},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDB"),
+					Pos:  token.Pos{Offset: 221},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDC"),
+					Pos:  token.Pos{Offset: 222},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDD"),
+					Pos:  token.Pos{Offset: 223},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDE"),
+					Pos:  token.Pos{Offset: 224},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xDF"),
+					Pos:  token.Pos{Offset: 225},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE0"),
+					Pos:  token.Pos{Offset: 226},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE1"),
+					Pos:  token.Pos{Offset: 227},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE2"),
+					Pos:  token.Pos{Offset: 228},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE3"),
+					Pos:  token.Pos{Offset: 229},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE4"),
+					Pos:  token.Pos{Offset: 230},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE5"),
+					Pos:  token.Pos{Offset: 231},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE6"),
+					Pos:  token.Pos{Offset: 232},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE7"),
+					Pos:  token.Pos{Offset: 233},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE8"),
+					Pos:  token.Pos{Offset: 234},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xE9"),
+					Pos:  token.Pos{Offset: 235},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xEA"),
+					Pos:  token.Pos{Offset: 236},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xEB"),
+					Pos:  token.Pos{Offset: 237},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xEC"),
+					Pos:  token.Pos{Offset: 238},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xED"),
+					Pos:  token.Pos{Offset: 239},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xEE"),
+					Pos:  token.Pos{Offset: 240},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xEF"),
+					Pos:  token.Pos{Offset: 241},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF0"),
+					Pos:  token.Pos{Offset: 242},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF1"),
+					Pos:  token.Pos{Offset: 243},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF2"),
+					Pos:  token.Pos{Offset: 244},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF3"),
+					Pos:  token.Pos{Offset: 245},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF4"),
+					Pos:  token.Pos{Offset: 246},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF5"),
+					Pos:  token.Pos{Offset: 247},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF6"),
+					Pos:  token.Pos{Offset: 248},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF7"),
+					Pos:  token.Pos{Offset: 249},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF8"),
+					Pos:  token.Pos{Offset: 250},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xF9"),
+					Pos:  token.Pos{Offset: 251},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFA"),
+					Pos:  token.Pos{Offset: 252},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFB"),
+					Pos:  token.Pos{Offset: 253},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFC"),
+					Pos:  token.Pos{Offset: 254},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFD"),
+					Pos:  token.Pos{Offset: 255},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFE"),
+					Pos:  token.Pos{Offset: 256},
+				},
+				{
+					Type: token.TokMap.Type("INVALID"),
+					Lit:  []byte("\xFF"),
+					Pos:  token.Pos{Offset: 257},
+				},
+				{
+					Type: token.TokMap.Type("+"),
+					Lit:  []byte("+"),
+					Pos:  token.Pos{Offset: 258},
+				},
+				{
+					Type: token.TokMap.Type("|"),
+					Lit:  []byte("|"),
+					Pos:  token.Pos{Offset: 259},
+				},
+				{
+					Type: token.TokMap.Type("int"),
+					Lit:  []byte("int"),
+					Pos:  token.Pos{Offset: 261},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 265},
+				},
+				{
+					Type: token.TokMap.Type(","),
+					Lit:  []byte(","),
+					Pos:  token.Pos{Offset: 267},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 269},
+				},
+				{
+					Type: token.TokMap.Type(","),
+					Lit:  []byte(","),
+					Pos:  token.Pos{Offset: 271},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 273},
+				},
+				{
+					Type: token.TokMap.Type(","),
+					Lit:  []byte(","),
+					Pos:  token.Pos{Offset: 275},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("d"),
+					Pos:  token.Pos{Offset: 277},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 279},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("BEGIN"),
+					Pos:  token.Pos{Offset: 281},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 287},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 289},
+				},
+				{
+					Type: token.TokMap.Type("("),
+					Lit:  []byte("("),
+					Pos:  token.Pos{Offset: 291},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("4711"),
+					Pos:  token.Pos{Offset: 293},
+				},
+				{
+					Type: token.TokMap.Type("+"),
+					Lit:  []byte("+"),
+					Pos:  token.Pos{Offset: 298},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("17"),
+					Pos:  token.Pos{Offset: 300},
+				},
+				{
+					Type: token.TokMap.Type("-"),
+					Lit:  []byte("-"),
+					Pos:  token.Pos{Offset: 303},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("2001"),
+					Pos:  token.Pos{Offset: 305},
+				},
+				{
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 310},
+				},
+				{
+					Type: token.TokMap.Type("/"),
+					Lit:  []byte("/"),
+					Pos:  token.Pos{Offset: 312},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("100"),
+					Pos:  token.Pos{Offset: 314},
+				},
+				{
+					Type: token.TokMap.Type("-"),
+					Lit:  []byte("-"),
+					Pos:  token.Pos{Offset: 318},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("17"),
+					Pos:  token.Pos{Offset: 320},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 323},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 325},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 327},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 329},
+				},
+				{
+					Type: token.TokMap.Type("/"),
+					Lit:  []byte("/"),
+					Pos:  token.Pos{Offset: 331},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("5"),
+					Pos:  token.Pos{Offset: 333},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 335},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 337},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 339},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 341},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 343},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 345},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 347},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 349},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 351},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 353},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 355},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 357},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 359},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 361},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 363},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("d"),
+					Pos:  token.Pos{Offset: 365},
+				},
+				{
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 367},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 369},
+				},
+				{
+					Type: token.TokMap.Type("+"),
+					Lit:  []byte("+"),
+					Pos:  token.Pos{Offset: 371},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 373},
+				},
+				{
+					Type: token.TokMap.Type("/"),
+					Lit:  []byte("/"),
+					Pos:  token.Pos{Offset: 375},
+				},
+				{
+					Type: token.TokMap.Type("("),
+					Lit:  []byte("("),
+					Pos:  token.Pos{Offset: 377},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("100"),
+					Pos:  token.Pos{Offset: 379},
+				},
+				{
+					Type: token.TokMap.Type("/"),
+					Lit:  []byte("/"),
+					Pos:  token.Pos{Offset: 383},
+				},
+				{
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("2"),
+					Pos:  token.Pos{Offset: 385},
+				},
+				{
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 387},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 389},
+				},
+				{
+					Type: token.TokMap.Type("if"),
+					Lit:  []byte("if"),
+					Pos:  token.Pos{Offset: 391},
 				},
 				{
 					Type: token.TokMap.Type("("),
 					Lit:  []byte("("),
-					Pos:  token.Pos{Offset: 8},
+					Pos:  token.Pos{Offset: 394},
 				},
 				{
 					Type: token.TokMap.Type("ident"),
-					Lit:  []byte("void"),
-					Pos:  token.Pos{Offset: 9},
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 396},
 				},
 				{
-					Type: token.TokMap.Type(")"),
-					Lit:  []byte(")"),
-					Pos:  token.Pos{Offset: 13},
+					Type: token.TokMap.Type(">"),
+					Lit:  []byte(">"),
+					Pos:  token.Pos{Offset: 398},
 				},
 				{
-					Type: token.TokMap.Type("{"),
-					Lit:  []byte("{"),
-					Pos:  token.Pos{Offset: 15},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("3"),
+					Pos:  token.Pos{Offset: 400},
+				},
+				{
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 402},
 				},
 				{
 					Type: token.TokMap.Type("ident"),
-					Lit:  []byte("char"),
-					Pos:  token.Pos{Offset: 19},
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 404},
 				},
 				{
 					Type: token.TokMap.Type("ident"),
-					Lit:  []byte("c"),
-					Pos:  token.Pos{Offset: 24},
+					Lit:  []byte("or"),
+					Pos:  token.Pos{Offset: 406},
 				},
 				{
-					Type: token.TokMap.Type(";"),
-					Lit:  []byte(";"),
-					Pos:  token.Pos{Offset: 25},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("not"),
+					Pos:  token.Pos{Offset: 409},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 413},
+				},
+				{
+					Type: token.TokMap.Type("=="),
+					Lit:  []byte("=="),
+					Pos:  token.Pos{Offset: 415},
 				},
 				{
 					Type: token.TokMap.Type("ident"),
 					Lit:  []byte("c"),
-					Pos:  token.Pos{Offset: 29},
+					Pos:  token.Pos{Offset: 418},
 				},
 				{
-					Type: token.TokMap.Type("="),
-					Lit:  []byte("="),
-					Pos:  token.Pos{Offset: 31},
+					Type: token.TokMap.Type("/"),
+					Lit:  []byte("/"),
+					Pos:  token.Pos{Offset: 420},
 				},
 				{
-					Type: token.TokMap.Type("char_lit"),
-					Lit:  []byte("'c'"),
-					Pos:  token.Pos{Offset: 33},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("2"),
+					Pos:  token.Pos{Offset: 422},
 				},
 				{
-					Type: token.TokMap.Type(";"),
-					Lit:  []byte(";"),
-					Pos:  token.Pos{Offset: 36},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("and"),
+					Pos:  token.Pos{Offset: 424},
 				},
 				{
 					Type: token.TokMap.Type("ident"),
 					Lit:  []byte("c"),
-					Pos:  token.Pos{Offset: 46},
+					Pos:  token.Pos{Offset: 428},
 				},
 				{
-					Type: token.TokMap.Type("="),
-					Lit:  []byte("="),
-					Pos:  token.Pos{Offset: 48},
+					Type: token.TokMap.Type(">"),
+					Lit:  []byte(">"),
+					Pos:  token.Pos{Offset: 430},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("'cc"),
-					Pos:  token.Pos{Offset: 50},
+					Type: token.TokMap.Type("("),
+					Lit:  []byte("("),
+					Pos:  token.Pos{Offset: 432},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("'; "),
-					Pos:  token.Pos{Offset: 53},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("d"),
+					Pos:  token.Pos{Offset: 434},
 				},
 				{
-					Type: token.TokMap.Type("}"),
-					Lit:  []byte("}"),
-					Pos:  token.Pos{Offset: 66},
+					Type: token.TokMap.Type("-"),
+					Lit:  []byte("-"),
+					Pos:  token.Pos{Offset: 436},
 				},
 				{
-					Type: token.TokMap.Type("$"),
-					Lit:  []byte(""),
-					Pos:  token.Pos{Offset: 68},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("3"),
+					Pos:  token.Pos{Offset: 438},
 				},
-			},
-		},
-
-		{
-			path: "../../testdata/incorrect/lexer/ugly.c",
-			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("|"),
-					Pos:  token.Pos{Offset: 0},
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 440},
 				},
 				{
-					Type: token.TokMap.Type("+"),
-					Lit:  []byte("+"),
-					Pos:  token.Pos{Offset: 1},
+					Type: token.TokMap.Type("*"),
+					Lit:  []byte("*"),
+					Pos:  token.Pos{Offset: 442},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x01"),
-					Pos:  token.Pos{Offset: 2},
+					Type: token.TokMap.Type("("),
+					Lit:  []byte("("),
+					Pos:  token.Pos{Offset: 444},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x02"),
-					Pos:  token.Pos{Offset: 3},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 446},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x03"),
-					Pos:  token.Pos{Offset: 4},
+					Type: token.TokMap.Type("-"),
+					Lit:  []byte("-"),
+					Pos:  token.Pos{Offset: 448},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x04"),
-					Pos:  token.Pos{Offset: 5},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 450},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x05"),
-					Pos:  token.Pos{Offset: 6},
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 452},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x06"),
-					Pos:  token.Pos{Offset: 7},
+					Type: token.TokMap.Type(")"),
+					Lit:  []byte(")"),
+					Pos:  token.Pos{Offset: 454},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x07"),
-					Pos:  token.Pos{Offset: 8},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("and"),
+					Pos:  token.Pos{Offset: 456},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x08"),
-					Pos:  token.Pos{Offset: 9},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 460},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x0E"),
-					Pos:  token.Pos{Offset: 15},
+					Type: token.TokMap.Type("<"),
+					Lit:  []byte("<"),
+					Pos:  token.Pos{Offset: 462},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x0F"),
-					Pos:  token.Pos{Offset: 16},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("0"),
+					Pos:  token.Pos{Offset: 464},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x10"),
-					Pos:  token.Pos{Offset: 17},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("then"),
+					Pos:  token.Pos{Offset: 466},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x11"),
-					Pos:  token.Pos{Offset: 18},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("begin"),
+					Pos:  token.Pos{Offset: 471},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x12"),
-					Pos:  token.Pos{Offset: 19},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 477},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x13"),
-					Pos:  token.Pos{Offset: 20},
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 479},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x14"),
-					Pos:  token.Pos{Offset: 21},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("1"),
+					Pos:  token.Pos{Offset: 481},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x15"),
-					Pos:  token.Pos{Offset: 22},
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 483},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x16"),
-					Pos:  token.Pos{Offset: 23},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("read"),
+					Pos:  token.Pos{Offset: 485},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x17"),
-					Pos:  token.Pos{Offset: 24},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("b"),
+					Pos:  token.Pos{Offset: 490},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x18"),
-					Pos:  token.Pos{Offset: 25},
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 492},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x19"),
-					Pos:  token.Pos{Offset: 26},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("end"),
+					Pos:  token.Pos{Offset: 494},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1A"),
-					Pos:  token.Pos{Offset: 27},
+					Type: token.TokMap.Type("else"),
+					Lit:  []byte("else"),
+					Pos:  token.Pos{Offset: 498},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1B"),
-					Pos:  token.Pos{Offset: 28},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("begin"),
+					Pos:  token.Pos{Offset: 503},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1C"),
-					Pos:  token.Pos{Offset: 29},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("a"),
+					Pos:  token.Pos{Offset: 509},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1D"),
-					Pos:  token.Pos{Offset: 30},
+					Type: token.TokMap.Type("="),
+					Lit:  []byte("="),
+					Pos:  token.Pos{Offset: 511},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1E"),
-					Pos:  token.Pos{Offset: 31},
+					Type: token.TokMap.Type("int_lit"),
+					Lit:  []byte("0"),
+					Pos:  token.Pos{Offset: 513},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte("\x1F"),
-					Pos:  token.Pos{Offset: 32},
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 515},
 				},
 				{
-					Type: token.TokMap.Type("!"),
-					Lit:  []byte("!"),
-					Pos:  token.Pos{Offset: 34},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("read"),
+					Pos:  token.Pos{Offset: 517},
 				},
 				{
-					Type: token.TokMap.Type("INVALID"),
-					Lit:  []byte(`"`),
-					Pos:  token.Pos{Offset: 35},
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("c"),
+					Pos:  token.Pos{Offset: 522},
+				},
+				{
+					Type: token.TokMap.Type(";"),
+					Lit:  []byte(";"),
+					Pos:  token.Pos{Offset: 524},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("end"),
+					Pos:  token.Pos{Offset: 526},
+				},
+				{
+					Type: token.TokMap.Type("ident"),
+					Lit:  []byte("END"),
+					Pos:  token.Pos{Offset: 530},
 				},
 				{
 					Type: token.TokMap.Type("$"),
@@ -744,7 +1989,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l01.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
@@ -759,7 +2004,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 9},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 10},
 				},
@@ -795,7 +2040,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l02.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
@@ -810,7 +2055,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 7},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 10},
 				},
@@ -825,7 +2070,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 21},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 24},
 				},
@@ -840,7 +2085,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 36},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 39},
 				},
@@ -855,7 +2100,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 50},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 53},
 				},
@@ -870,7 +2115,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 64},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 67},
 				},
@@ -885,7 +2130,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 135},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 138},
 				},
@@ -900,7 +2145,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 146},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 147},
 				},
@@ -936,7 +2181,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l03.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
@@ -951,7 +2196,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 8},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 9},
 				},
@@ -966,7 +2211,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 15},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 19},
 				},
@@ -1097,7 +2342,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l04.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
@@ -1112,7 +2357,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 8},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 9},
 				},
@@ -1127,7 +2372,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 15},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 19},
 				},
@@ -1142,7 +2387,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 24},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("char"),
 					Lit:  []byte("char"),
 					Pos:  token.Pos{Offset: 28},
 				},
@@ -1313,7 +2558,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l05.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 0},
 				},
@@ -1328,7 +2573,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 8},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 9},
 				},
@@ -1343,7 +2588,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 15},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 19},
 				},
@@ -1614,7 +2859,7 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/quiet/lexer/l06.c",
 			toks: []*token.Token{
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("int"),
 					Lit:  []byte("int"),
 					Pos:  token.Pos{Offset: 259},
 				},
@@ -1629,7 +2874,7 @@ func TestLexer(t *testing.T) {
 					Pos:  token.Pos{Offset: 267},
 				},
 				{
-					Type: token.TokMap.Type("ident"),
+					Type: token.TokMap.Type("void"),
 					Lit:  []byte("void"),
 					Pos:  token.Pos{Offset: 297},
 				},
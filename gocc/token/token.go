@@ -62,69 +62,159 @@ var TokMap = TokenMap{
 		"INVALID",
 		"$",
 		"empty",
+		"error",
 		";",
+		"}",
+		"=",
 		"ident",
 		"(",
 		")",
+		",",
+		"...",
+		"type_name",
 		"[",
 		"]",
-		"int_lit",
-		"char_lit",
+		"{",
 		"typedef",
-		",",
+		"unsigned",
+		"void",
+		"char",
+		"short",
+		"int",
+		"long",
+		"*",
+		"struct",
+		"enum",
 		"return",
-		"{",
-		"}",
+		"do",
+		"while",
+		"break",
+		"continue",
+		"goto",
 		"if",
 		"else",
-		"while",
-		"=",
+		"for",
+		"switch",
+		"case",
+		":",
+		"default",
+		"+=",
+		"-=",
+		"*=",
+		"/=",
+		"%=",
+		"<<=",
+		">>=",
+		"&=",
+		"^=",
+		"|=",
+		"?",
+		"||",
 		"&&",
+		"|",
+		"^",
+		"&",
 		"==",
 		"!=",
 		"<",
 		">",
 		"<=",
 		">=",
+		"<<",
+		">>",
 		"+",
 		"-",
-		"*",
 		"/",
+		"%",
 		"!",
+		"~",
+		"++",
+		"--",
+		"sizeof",
+		".",
+		"->",
+		"int_lit",
+		"char_lit",
+		"string_lit",
 	},
 
 	idMap: map[string]Type{
-		"INVALID":  0,
-		"$":        1,
-		"empty":    2,
-		";":        3,
-		"ident":    4,
-		"(":        5,
-		")":        6,
-		"[":        7,
-		"]":        8,
-		"int_lit":  9,
-		"char_lit": 10,
-		"typedef":  11,
-		",":        12,
-		"return":   13,
-		"{":        14,
-		"}":        15,
-		"if":       16,
-		"else":     17,
-		"while":    18,
-		"=":        19,
-		"&&":       20,
-		"==":       21,
-		"!=":       22,
-		"<":        23,
-		">":        24,
-		"<=":       25,
-		">=":       26,
-		"+":        27,
-		"-":        28,
-		"*":        29,
-		"/":        30,
-		"!":        31,
+		"INVALID":    0,
+		"$":          1,
+		"empty":      2,
+		"error":      3,
+		";":          4,
+		"}":          5,
+		"=":          6,
+		"ident":      7,
+		"(":          8,
+		")":          9,
+		",":          10,
+		"...":        11,
+		"type_name":  12,
+		"[":          13,
+		"]":          14,
+		"{":          15,
+		"typedef":    16,
+		"unsigned":   17,
+		"void":       18,
+		"char":       19,
+		"short":      20,
+		"int":        21,
+		"long":       22,
+		"*":          23,
+		"struct":     24,
+		"enum":       25,
+		"return":     26,
+		"do":         27,
+		"while":      28,
+		"break":      29,
+		"continue":   30,
+		"goto":       31,
+		"if":         32,
+		"else":       33,
+		"for":        34,
+		"switch":     35,
+		"case":       36,
+		":":          37,
+		"default":    38,
+		"+=":         39,
+		"-=":         40,
+		"*=":         41,
+		"/=":         42,
+		"%=":         43,
+		"<<=":        44,
+		">>=":        45,
+		"&=":         46,
+		"^=":         47,
+		"|=":         48,
+		"?":          49,
+		"||":         50,
+		"&&":         51,
+		"|":          52,
+		"^":          53,
+		"&":          54,
+		"==":         55,
+		"!=":         56,
+		"<":          57,
+		">":          58,
+		"<=":         59,
+		">=":         60,
+		"<<":         61,
+		">>":         62,
+		"+":          63,
+		"-":          64,
+		"/":          65,
+		"%":          66,
+		"!":          67,
+		"~":          68,
+		"++":         69,
+		"--":         70,
+		"sizeof":     71,
+		".":          72,
+		"->":         73,
+		"int_lit":    74,
+		"char_lit":   75,
+		"string_lit": 76,
 	},
 }
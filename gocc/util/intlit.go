@@ -0,0 +1,10 @@
+package util
+
+import "strings"
+
+// SplitIntSuffix splits the given integer literal into its digits and its
+// integer suffix (e.g. "u", "L" or "ull"). The suffix is not validated.
+func SplitIntSuffix(lit string) (digits, suffix string) {
+	digits = strings.TrimRight(lit, "uUlL")
+	return digits, lit[len(digits):]
+}
@@ -18,8 +18,8 @@ package util
 
 import (
 	"fmt"
+	"math"
 	"strconv"
-	"unicode"
 	"unicode/utf8"
 )
 
@@ -29,84 +29,160 @@ import (
 Convert the literal value of a scanned token to rune
 */
 func RuneValue(lit []byte) rune {
-	if lit[1] == '\\' {
-		return escapeCharVal(lit)
-	}
-	r, size := utf8.DecodeRune(lit[1:])
-	if size != len(lit)-2 {
-		panic(fmt.Sprintf("Error decoding rune. Lit: %s, rune: %d, size%d\n", lit, r, size))
+	r, err := CharValue(lit)
+	if err != nil {
+		panic(err.Error())
 	}
 	return r
 }
 
+/*
+Convert the literal value of a scanned character literal to rune, supporting
+the escape sequences of C (§6.4.4.4); i.e. simple escape sequences (e.g. '\n'),
+octal escape sequences of one to three digits (e.g. '\0', '\101') and
+hexadecimal escape sequences of any length (e.g. '\x41').
+*/
+func CharValue(lit []byte) (rune, error) {
+	if len(lit) < 3 || lit[0] != '\'' || lit[len(lit)-1] != '\'' {
+		return 0, fmt.Errorf("invalid character literal %s", lit)
+	}
+	body := lit[1 : len(lit)-1]
+	var r rune
+	var size int
+	if body[0] == '\\' {
+		var err error
+		if r, size, err = escapeCharVal(body); err != nil {
+			return 0, err
+		}
+	} else {
+		r, size = utf8.DecodeRune(body)
+	}
+	if size != len(body) {
+		return 0, fmt.Errorf("Error decoding rune. Lit: %s, rune: %d, size%d", lit, r, size)
+	}
+	return r, nil
+}
+
+/*
+Convert the literal value of a scanned string literal to the bytes of the
+string, supporting the escape sequences of C (§6.4.4.4). The terminating null
+character is not included.
+*/
+func StringValue(lit []byte) ([]byte, error) {
+	if len(lit) < 2 || lit[0] != '"' || lit[len(lit)-1] != '"' {
+		return nil, fmt.Errorf("invalid string literal %s", lit)
+	}
+	body := lit[1 : len(lit)-1]
+	var buf []byte
+	for len(body) > 0 {
+		if body[0] != '\\' {
+			buf = append(buf, body[0])
+			body = body[1:]
+			continue
+		}
+		r, size, err := escapeCharVal(body)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, byte(r))
+		body = body[size:]
+	}
+	return buf, nil
+}
+
 /*
 Convert the literal value of a scanned token to int64
 */
 func IntValue(lit []byte) (int64, error) {
-	return strconv.ParseInt(string(lit), 10, 64)
+	x, err := UintValue(lit)
+	if err != nil {
+		return 0, err
+	}
+	if x > math.MaxInt64 {
+		return 0, &strconv.NumError{Func: "IntValue", Num: string(lit), Err: strconv.ErrRange}
+	}
+	return int64(x), nil
 }
 
 /*
-Convert the literal value of a scanned token to uint64
+Convert the literal value of a scanned token to uint64, supporting the decimal,
+octal (e.g. 0173) and hexadecimal (e.g. 0x7B) integer constants of C
+(§6.4.4.1). The integer suffix (e.g. 10u) is ignored.
 */
 func UintValue(lit []byte) (uint64, error) {
-	return strconv.ParseUint(string(lit), 10, 64)
+	s, _ := SplitIntSuffix(string(lit))
+	switch {
+	case len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X'):
+		return strconv.ParseUint(s[2:], 16, 64)
+	case len(s) > 1 && s[0] == '0':
+		return strconv.ParseUint(s[1:], 8, 64)
+	}
+	return strconv.ParseUint(s, 10, 64)
 }
 
 /* Util */
 
-func escapeCharVal(lit []byte) rune {
+// escapeCharVal decodes the escape sequence at the start of s, and returns its
+// value and length in bytes.
+func escapeCharVal(s []byte) (r rune, size int, err error) {
+	if len(s) < 2 {
+		return 0, 0, fmt.Errorf("incomplete escape sequence")
+	}
 	var i, base, max uint32
-	offset := 2
-	switch lit[offset] {
+	var kind string
+	offset := 1
+	switch s[offset] {
 	case 'a':
-		return '\a'
+		return '\a', 2, nil
 	case 'b':
-		return '\b'
+		return '\b', 2, nil
 	case 'f':
-		return '\f'
+		return '\f', 2, nil
 	case 'n':
-		return '\n'
+		return '\n', 2, nil
 	case 'r':
-		return '\r'
+		return '\r', 2, nil
 	case 't':
-		return '\t'
+		return '\t', 2, nil
 	case 'v':
-		return '\v'
+		return '\v', 2, nil
 	case '\\':
-		return '\\'
+		return '\\', 2, nil
 	case '\'':
-		return '\''
+		return '\'', 2, nil
+	case '"':
+		return '"', 2, nil
+	case '?':
+		return '?', 2, nil
 	case '0', '1', '2', '3', '4', '5', '6', '7':
 		i, base, max = 3, 8, 255
+		kind = "octal"
 	case 'x':
-		i, base, max = 2, 16, 255
-		offset++
-	case 'u':
-		i, base, max = 4, 16, unicode.MaxRune
-		offset++
-	case 'U':
-		i, base, max = 8, 16, unicode.MaxRune
+		// Hexadecimal escape sequences are of arbitrary length in C.
+		i, base, max = uint32(len(s)), 16, 255
+		kind = "hex"
 		offset++
+		if offset >= len(s) || digitVal(rune(s[offset])) >= 16 {
+			return 0, 0, fmt.Errorf(`\x used with no following hex digits`)
+		}
 	default:
-		panic(fmt.Sprintf("Error decoding character literal: %s\n", lit))
+		return 0, 0, fmt.Errorf(`unknown escape sequence '\%c'`, s[offset])
 	}
 
 	var x uint32
-	for ; i > 0 && offset < len(lit)-1; i-- {
-		ch, size := utf8.DecodeRune(lit[offset:])
-		offset += size
-		d := uint32(digitVal(ch))
+	for ; i > 0 && offset < len(s); i-- {
+		d := uint32(digitVal(rune(s[offset])))
 		if d >= base {
-			panic(fmt.Sprintf("charVal(%s): illegal character (%c) in escape sequence. size=%d, offset=%d", lit, ch, size, offset))
+			break
 		}
+		offset++
 		x = x*base + d
-	}
-	if x > max || 0xD800 <= x && x < 0xE000 {
-		panic(fmt.Sprintf("Error decoding escape char value. Lit:%s, offset:%d, escape sequence is invalid Unicode code point\n", lit, offset))
+		if x > max {
+			return 0, 0, fmt.Errorf("%s escape sequence out of range", kind)
+		}
 	}
 
-	return rune(x)
+	return rune(x), offset, nil
 }
 
 func digitVal(ch rune) int {
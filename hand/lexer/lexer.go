@@ -99,7 +99,7 @@ func (l *lexer) errorf(format string, args ...interface{}) {
 	tok := token.Token{
 		Kind: token.Error,
 		Val:  err,
-		Pos:  l.start,
+		Pos:  token.Pos(l.start),
 	}
 	l.tokens = append(l.tokens, tok)
 }
@@ -111,7 +111,7 @@ func (l *lexer) errorfCur(format string, args ...interface{}) {
 	tok := token.Token{
 		Kind: token.Error,
 		Val:  err,
-		Pos:  l.cur - width,
+		Pos:  token.Pos(l.cur - width),
 	}
 	l.tokens = append(l.tokens, tok)
 }
@@ -144,7 +144,7 @@ func (l *lexer) emitCustom(kind token.Kind, val string) {
 	tok := token.Token{
 		Kind: kind,
 		Val:  val,
-		Pos:  l.start,
+		Pos:  token.Pos(l.start),
 	}
 	l.tokens = append(l.tokens, tok)
 	// Advance the token start position.
@@ -708,8 +708,8 @@ func TestLexer(t *testing.T) {
 			path: "../../testdata/incorrect/lexer/ugly.c",
 			toks: []token.Token{
 				{
-					Kind: token.Error,
-					Val:  "unexpected U+007C '|'",
+					Kind: token.Or,
+					Val:  "|",
 					Pos:  0,
 				},
 				{
@@ -854,174 +854,9 @@ func TestLexer(t *testing.T) {
 				},
 				{
 					Kind: token.Error,
-					Val:  `unexpected U+0022 '"'`,
-					Pos:  35,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+0023 '#'",
-					Pos:  36,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+0024 '$'",
-					Pos:  37,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+0025 '%'",
-					Pos:  38,
-				},
-				{
-					Kind: token.Error,
-					Val:  "expected '&' after '&', got U+0027 '''",
-					Pos:  39,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unterminated character literal",
-					Pos:  40,
-				},
-				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  41,
-				},
-				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  42,
-				},
-				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  43,
-				},
-				{
-					Kind: token.Add,
-					Val:  "+",
-					Pos:  44,
-				},
-				{
-					Kind: token.Comma,
-					Val:  ",",
-					Pos:  46,
-				},
-				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  47,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+002E '.'",
-					Pos:  48,
-				},
-				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  49,
-				},
-				{
-					Kind: token.IntLit,
-					Val:  "0123456789", // TODO: When octal integer literals have been implemented, fail accordingly.
-					Pos:  50,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+003A ':'",
-					Pos:  60,
-				},
-				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  61,
-				},
-				{
-					Kind: token.Le,
-					Val:  "<=",
-					Pos:  62,
-				},
-				{
-					Kind: token.Gt,
-					Val:  ">",
-					Pos:  64,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+003F '?'",
-					Pos:  65,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+0040 '@'",
-					Pos:  66,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
-					Pos:  67,
-				},
-				{
-					Kind: token.Lbracket,
-					Val:  "[",
-					Pos:  93,
-				},
-				{
-					Kind: token.Error,
-					Val:  `unexpected U+005C '\'`,
+					Val:  `unknown escape sequence '\]'`,
 					Pos:  94,
 				},
-				{
-					Kind: token.Rbracket,
-					Val:  "]",
-					Pos:  95,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+005E '^'",
-					Pos:  96,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "_",
-					Pos:  97,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+0060 '`'",
-					Pos:  98,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "abcdefghijklmnopqrstuvwxyz",
-					Pos:  99,
-				},
-				{
-					Kind: token.Lbrace,
-					Val:  "{",
-					Pos:  125,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+007C '|'",
-					Pos:  126,
-				},
-				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  127,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+007E '~'",
-					Pos:  128,
-				},
-				{
-					Kind: token.Error,
-					Val:  "unexpected U+007F",
-					Pos:  129,
-				},
 				{
 					Kind: token.Error,
 					Val:  "illegal UTF-8 encoding",
@@ -1663,575 +1498,594 @@ func TestLexer(t *testing.T) {
 					Pos:  257,
 				},
 				{
-					Kind: token.Add,
-					Val:  "+",
-					Pos:  258,
+					Kind: token.Error,
+					Val:  "unterminated string literal",
+					Pos:  35,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unexpected U+007C '|'",
-					Pos:  259,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  533,
 				},
+			},
+		},
+
+		{
+			path: "../../testdata/quiet/lexer/l01.c",
+			toks: []token.Token{
 				{
 					Kind: token.Ident,
 					Val:  "int",
-					Pos:  261,
+					Pos:  0,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  265,
+					Val:  "main",
+					Pos:  4,
 				},
 				{
-					Kind: token.Comma,
-					Val:  ",",
-					Pos:  267,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  9,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "b",
-					Pos:  269,
+					Val:  "void",
+					Pos:  10,
 				},
 				{
-					Kind: token.Comma,
-					Val:  ",",
-					Pos:  271,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  14,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "c",
-					Pos:  273,
+					Kind: token.Lbrace,
+					Val:  "{",
+					Pos:  16,
 				},
 				{
-					Kind: token.Comma,
-					Val:  ",",
-					Pos:  275,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  18,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "d",
-					Pos:  277,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  20,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  279,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  23,
 				},
+			},
+		},
+
+		{
+			path: "../../testdata/quiet/lexer/l02.c",
+			toks: []token.Token{
 				{
 					Kind: token.Ident,
-					Val:  "BEGIN",
-					Pos:  281,
+					Val:  "int",
+					Pos:  0,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  287,
+					Val:  "foo",
+					Pos:  4,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  289,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  7,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  291,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  10,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "4711",
-					Pos:  293,
+					Kind: token.Ident,
+					Val:  "BarBara",
+					Pos:  14,
 				},
 				{
-					Kind: token.Add,
-					Val:  "+",
-					Pos:  298,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  21,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "17",
-					Pos:  300,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  24,
 				},
 				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  303,
+					Kind: token.Ident,
+					Val:  "bar_bara",
+					Pos:  28,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "2001",
-					Pos:  305,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  36,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  310,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  39,
 				},
 				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  312,
+					Kind: token.Ident,
+					Val:  "bar4711",
+					Pos:  43,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "100",
-					Pos:  314,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  50,
 				},
 				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  318,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  53,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "17",
-					Pos:  320,
+					Kind: token.Ident,
+					Val:  "b4rb4r4",
+					Pos:  57,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  323,
+					Pos:  64,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "b",
-					Pos:  325,
+					Val:  "int",
+					Pos:  67,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  327,
+					Kind: token.Ident,
+					Val:  "abcdefghijklmnopqrstuvwxyz_ABCDEFGHIJKLMNOPQRSTUVWXYZ_0123456789",
+					Pos:  71,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "a",
-					Pos:  329,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  135,
 				},
 				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  331,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  138,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "5",
-					Pos:  333,
+					Kind: token.Ident,
+					Val:  "main",
+					Pos:  142,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  335,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  146,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  337,
+					Val:  "void",
+					Pos:  147,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  339,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  151,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "a",
-					Pos:  341,
+					Kind: token.Lbrace,
+					Val:  "{",
+					Pos:  153,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  343,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  155,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b",
-					Pos:  345,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  157,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  347,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  159,
 				},
+			},
+		},
+
+		{
+			path: "../../testdata/quiet/lexer/l03.c",
+			toks: []token.Token{
 				{
 					Kind: token.Ident,
-					Val:  "b",
-					Pos:  349,
+					Val:  "int",
+					Pos:  0,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  351,
+					Kind: token.Ident,
+					Val:  "main",
+					Pos:  4,
+				},
+				{
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  8,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  353,
+					Val:  "void",
+					Pos:  9,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  355,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  13,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b",
-					Pos:  357,
+					Kind: token.Lbrace,
+					Val:  "{",
+					Pos:  15,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  359,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  19,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "b",
-					Pos:  361,
+					Val:  "i",
+					Pos:  23,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  363,
+					Pos:  24,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "d",
-					Pos:  365,
+					Val:  "i",
+					Pos:  28,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  367,
+					Pos:  30,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "c",
-					Pos:  369,
+					Kind: token.IntLit,
+					Val:  "123456789",
+					Pos:  32,
 				},
 				{
-					Kind: token.Add,
-					Val:  "+",
-					Pos:  371,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  41,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// Was:   i = 1234567890;",
+					Pos:  43,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  373,
+					Val:  "i",
+					Pos:  71,
 				},
 				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  375,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  73,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  377,
+					Kind: token.CharLit,
+					Val:  "'0'",
+					Pos:  75,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "100",
-					Pos:  379,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  78,
 				},
 				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  383,
+					Kind: token.Ident,
+					Val:  "i",
+					Pos:  82,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "2",
-					Pos:  385,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  84,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  387,
+					Kind: token.CharLit,
+					Val:  "'a'",
+					Pos:  86,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  389,
+					Pos:  89,
 				},
 				{
-					Kind: token.KwIf,
-					Val:  "if",
-					Pos:  391,
+					Kind: token.Ident,
+					Val:  "i",
+					Pos:  93,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  394,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  95,
+				},
+				{
+					Kind: token.CharLit,
+					Val:  "' '",
+					Pos:  97,
+				},
+				{
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  100,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  396,
+					Val:  "i",
+					Pos:  104,
 				},
 				{
-					Kind: token.Gt,
-					Val:  ">",
-					Pos:  398,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  106,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "3",
-					Pos:  400,
+					Kind: token.CharLit,
+					Val:  `'\n'`,
+					Pos:  108,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  402,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  112,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b",
-					Pos:  404,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  114,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "or",
-					Pos:  406,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  117,
 				},
+			},
+		},
+
+		{
+			path: "../../testdata/quiet/lexer/l04.c",
+			toks: []token.Token{
 				{
 					Kind: token.Ident,
-					Val:  "not",
-					Pos:  409,
+					Val:  "int",
+					Pos:  0,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  413,
+					Val:  "main",
+					Pos:  4,
 				},
 				{
-					Kind: token.Eq,
-					Val:  "==",
-					Pos:  415,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  8,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  418,
+					Val:  "void",
+					Pos:  9,
 				},
 				{
-					Kind: token.Div,
-					Val:  "/",
-					Pos:  420,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  13,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "2",
-					Pos:  422,
+					Kind: token.Lbrace,
+					Val:  "{",
+					Pos:  15,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "and",
-					Pos:  424,
+					Val:  "int",
+					Pos:  19,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  428,
-				},
-				{
-					Kind: token.Gt,
-					Val:  ">",
-					Pos:  430,
+					Val:  "i",
+					Pos:  23,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  432,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  24,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "d",
-					Pos:  434,
-				},
-				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  436,
+					Val:  "char",
+					Pos:  28,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "3",
-					Pos:  438,
+					Kind: token.Ident,
+					Val:  "j",
+					Pos:  33,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  440,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  34,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  442,
+					Kind: token.KwIf,
+					Val:  "if",
+					Pos:  38,
 				},
 				{
 					Kind: token.Lparen,
 					Val:  "(",
-					Pos:  444,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "a",
-					Pos:  446,
+					Pos:  41,
 				},
 				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  448,
+					Kind: token.IntLit,
+					Val:  "1",
+					Pos:  42,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b",
-					Pos:  450,
+					Kind: token.Eq,
+					Val:  "==",
+					Pos:  43,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  452,
+					Kind: token.IntLit,
+					Val:  "0",
+					Pos:  45,
 				},
 				{
 					Kind: token.Rparen,
 					Val:  ")",
-					Pos:  454,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "and",
-					Pos:  456,
+					Pos:  46,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  460,
+					Val:  "i",
+					Pos:  48,
 				},
 				{
-					Kind: token.Lt,
-					Val:  "<",
-					Pos:  462,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  50,
 				},
 				{
 					Kind: token.IntLit,
 					Val:  "0",
-					Pos:  464,
+					Pos:  52,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "then",
-					Pos:  466,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  53,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "begin",
-					Pos:  471,
+					Kind: token.KwElse,
+					Val:  "else",
+					Pos:  58,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  477,
+					Val:  "i",
+					Pos:  63,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  479,
+					Pos:  65,
 				},
 				{
 					Kind: token.IntLit,
 					Val:  "1",
-					Pos:  481,
+					Pos:  67,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  483,
+					Pos:  68,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "read",
-					Pos:  485,
+					Kind: token.KwWhile,
+					Val:  "while",
+					Pos:  72,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b",
-					Pos:  490,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  78,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  492,
+					Kind: token.IntLit,
+					Val:  "1",
+					Pos:  79,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "end",
-					Pos:  494,
+					Kind: token.Eq,
+					Val:  "==",
+					Pos:  80,
 				},
 				{
-					Kind: token.KwElse,
-					Val:  "else",
-					Pos:  498,
+					Kind: token.IntLit,
+					Val:  "0",
+					Pos:  82,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "begin",
-					Pos:  503,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  83,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "a",
-					Pos:  509,
+					Val:  "i",
+					Pos:  85,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  511,
+					Pos:  87,
 				},
 				{
 					Kind: token.IntLit,
 					Val:  "0",
-					Pos:  513,
+					Pos:  89,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  515,
+					Pos:  90,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "read",
-					Pos:  517,
+					Kind: token.KwReturn,
+					Val:  "return",
+					Pos:  94,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "c",
-					Pos:  522,
+					Kind: token.IntLit,
+					Val:  "42",
+					Pos:  101,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  524,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "end",
-					Pos:  526,
+					Pos:  103,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "END",
-					Pos:  530,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  105,
 				},
 				{
 					Kind: token.EOF,
 					Val:  "",
-					Pos:  533,
+					Pos:  107,
 				},
 			},
 		},
 
 		{
-			path: "../../testdata/quiet/lexer/l01.c",
+			path: "../../testdata/quiet/lexer/l05.c",
 			toks: []token.Token{
 				{
 					Kind: token.Ident,
@@ -2246,1192 +2100,1323 @@ func TestLexer(t *testing.T) {
 				{
 					Kind: token.Lparen,
 					Val:  "(",
-					Pos:  9,
+					Pos:  8,
 				},
 				{
 					Kind: token.Ident,
 					Val:  "void",
-					Pos:  10,
+					Pos:  9,
 				},
 				{
 					Kind: token.Rparen,
 					Val:  ")",
-					Pos:  14,
+					Pos:  13,
 				},
 				{
 					Kind: token.Lbrace,
 					Val:  "{",
-					Pos:  16,
+					Pos:  15,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  19,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "i",
+					Pos:  23,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  18,
+					Pos:  24,
 				},
 				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  20,
+					Kind: token.IntLit,
+					Val:  "1",
+					Pos:  28,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  23,
+					Kind: token.Ne,
+					Val:  "!=",
+					Pos:  29,
 				},
-			},
-		},
-
-		{
-			path: "../../testdata/quiet/lexer/l02.c",
-			toks: []token.Token{
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  0,
+					Kind: token.Not,
+					Val:  "!",
+					Pos:  31,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "foo",
-					Pos:  4,
+					Kind: token.IntLit,
+					Val:  "3",
+					Pos:  32,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  7,
+					Pos:  33,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  10,
+					Kind: token.IntLit,
+					Val:  "4",
+					Pos:  37,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "BarBara",
-					Pos:  14,
+					Kind: token.Land,
+					Val:  "&&",
+					Pos:  38,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  21,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  40,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  24,
+					Kind: token.IntLit,
+					Val:  "6",
+					Pos:  41,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "bar_bara",
-					Pos:  28,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  42,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  36,
+					Pos:  43,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  39,
+					Kind: token.IntLit,
+					Val:  "7",
+					Pos:  47,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "bar4711",
-					Pos:  43,
+					Kind: token.Mul,
+					Val:  "*",
+					Pos:  48,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
+					Kind: token.IntLit,
+					Val:  "8",
 					Pos:  50,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  53,
+					Kind: token.Add,
+					Val:  "+",
+					Pos:  51,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "b4rb4r4",
-					Pos:  57,
+					Kind: token.IntLit,
+					Val:  "10",
+					Pos:  52,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  64,
-				},
-				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  67,
+					Pos:  54,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "abcdefghijklmnopqrstuvwxyz_ABCDEFGHIJKLMNOPQRSTUVWXYZ_0123456789",
-					Pos:  71,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  58,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  135,
+					Kind: token.IntLit,
+					Val:  "11",
+					Pos:  59,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  138,
+					Kind: token.Sub,
+					Val:  "-",
+					Pos:  61,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "main",
-					Pos:  142,
+					Kind: token.IntLit,
+					Val:  "12",
+					Pos:  62,
+				},
+				{
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  64,
+				},
+				{
+					Kind: token.Add,
+					Val:  "+",
+					Pos:  65,
 				},
 				{
 					Kind: token.Lparen,
 					Val:  "(",
-					Pos:  146,
+					Pos:  66,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "void",
-					Pos:  147,
+					Kind: token.IntLit,
+					Val:  "12",
+					Pos:  67,
+				},
+				{
+					Kind: token.Div,
+					Val:  "/",
+					Pos:  69,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "16",
+					Pos:  70,
 				},
 				{
 					Kind: token.Rparen,
 					Val:  ")",
-					Pos:  151,
+					Pos:  72,
 				},
 				{
-					Kind: token.Lbrace,
-					Val:  "{",
-					Pos:  153,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  73,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "17",
+					Pos:  77,
+				},
+				{
+					Kind: token.Le,
+					Val:  "<=",
+					Pos:  79,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "18",
+					Pos:  81,
+				},
+				{
+					Kind: token.Lt,
+					Val:  "<",
+					Pos:  84,
+				},
+				{
+					Kind: token.Sub,
+					Val:  "-",
+					Pos:  85,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "20",
+					Pos:  86,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  155,
+					Pos:  88,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "i",
+					Pos:  92,
+				},
+				{
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  93,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "21",
+					Pos:  94,
+				},
+				{
+					Kind: token.Eq,
+					Val:  "==",
+					Pos:  96,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "22",
+					Pos:  98,
+				},
+				{
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  100,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "25",
+					Pos:  104,
+				},
+				{
+					Kind: token.Ge,
+					Val:  ">=",
+					Pos:  107,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "27",
+					Pos:  109,
+				},
+				{
+					Kind: token.Gt,
+					Val:  ">",
+					Pos:  111,
+				},
+				{
+					Kind: token.IntLit,
+					Val:  "28",
+					Pos:  112,
+				},
+				{
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  114,
 				},
 				{
 					Kind: token.Rbrace,
 					Val:  "}",
-					Pos:  157,
+					Pos:  116,
 				},
 				{
 					Kind: token.EOF,
 					Val:  "",
-					Pos:  159,
+					Pos:  118,
 				},
 			},
 		},
 
 		{
-			path: "../../testdata/quiet/lexer/l03.c",
+			path: "../../testdata/quiet/lexer/l06.c",
 			toks: []token.Token{
+				{
+					Kind: token.Comment,
+					Val:  "/* This file contains examples of various types of white space and comments. */",
+					Pos:  0,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// A blank (32)",
+					Pos:  80,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// A new-line (10)",
+					Pos:  98,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// a carriage-return (13)",
+					Pos:  118,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// form-feed (12)",
+					Pos:  146,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "// tab(9)",
+					Pos:  166,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "/* In uC, each file must contain at least one declaration */",
+					Pos:  178,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "/* a comment... */",
+					Pos:  240,
+				},
 				{
 					Kind: token.Ident,
 					Val:  "int",
-					Pos:  0,
+					Pos:  259,
 				},
 				{
 					Kind: token.Ident,
 					Val:  "main",
-					Pos:  4,
+					Pos:  263,
 				},
 				{
 					Kind: token.Lparen,
 					Val:  "(",
-					Pos:  8,
+					Pos:  267,
+				},
+				{
+					Kind: token.Comment,
+					Val:  "/* ...and another */",
+					Pos:  269,
 				},
 				{
 					Kind: token.Ident,
 					Val:  "void",
-					Pos:  9,
+					Pos:  297,
 				},
 				{
 					Kind: token.Rparen,
 					Val:  ")",
-					Pos:  13,
+					Pos:  302,
 				},
 				{
 					Kind: token.Lbrace,
 					Val:  "{",
-					Pos:  15,
+					Pos:  304,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  19,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  306,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  23,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  308,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  24,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  313,
 				},
+			},
+		},
+
+		// Test encodings.
+		{
+			path: "../../testdata/encoding/lexer/big5.c",
+			toks: []token.Token{
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  28,
+					Kind: token.Comment,
+					Val:  "/* \xA5\x40\xAC\xC9\xB1\x7A\xA6\x6E */",
+					Pos:  0,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  30,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  15,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "123456789",
-					Pos:  32,
+					Kind: token.Ident,
+					Val:  "a",
+					Pos:  19,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  41,
+					Pos:  20,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// Was:   i = 1234567890;",
-					Pos:  43,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  22,
 				},
+			},
+		},
+		{
+			path: "../../testdata/encoding/lexer/iso-8859-1.c",
+			toks: []token.Token{
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  71,
+					Kind: token.Comment,
+					Val:  "// Hej v\xE4rlden!",
+					Pos:  0,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  73,
+					Kind: token.Ident,
+					Val:  "int",
+					Pos:  16,
 				},
 				{
-					Kind: token.CharLit,
-					Val:  "'0'",
-					Pos:  75,
+					Kind: token.Ident,
+					Val:  "b",
+					Pos:  20,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  78,
+					Pos:  21,
+				},
+				{
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  23,
+				},
+			},
+		},
+		{
+			path: "../../testdata/encoding/lexer/utf-8.c",
+			toks: encTokens,
+		},
+		{
+			path: "../../testdata/encoding/lexer/utf-8_bom.c",
+			toks: encTokens,
+		},
+		{
+			path: "../../testdata/encoding/lexer/utf-16be_bom.c",
+			toks: encTokens,
+		},
+		{
+			path: "../../testdata/encoding/lexer/utf-16le_bom.c",
+			toks: encTokens,
+		},
+
+		// Extra tests.
+		{
+			path: "../../testdata/extra/lexer/invalid-esc.c",
+			toks: []token.Token{
+				{
+					Kind: token.Comment,
+					Val:  "// Test invalid escape sequence.",
+					Pos:  0,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "i",
-					Pos:  82,
+					Val:  "char",
+					Pos:  33,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "c",
+					Pos:  38,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  84,
+					Pos:  40,
 				},
 				{
-					Kind: token.CharLit,
-					Val:  "'a'",
-					Pos:  86,
+					Kind: token.Error,
+					Val:  `unknown escape sequence '\q'`,
+					Pos:  43,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  89,
+					Kind: token.Error,
+					Val:  `unexpected U+005C '\'`,
+					Pos:  43,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "i",
-					Pos:  93,
-				},
-				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  95,
+					Val:  "q",
+					Pos:  44,
 				},
 				{
-					Kind: token.CharLit,
-					Val:  "' '",
-					Pos:  97,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  45,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  100,
+					Pos:  46,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "i",
-					Pos:  104,
+					Val:  "char",
+					Pos:  48,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "d",
+					Pos:  53,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  106,
+					Pos:  55,
 				},
 				{
-					Kind: token.CharLit,
-					Val:  `'\n'`,
-					Pos:  108,
+					Kind: token.Error,
+					Val:  `\x used with no following hex digits`,
+					Pos:  58,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  112,
+					Kind: token.Error,
+					Val:  `unexpected U+005C '\'`,
+					Pos:  58,
 				},
 				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  114,
+					Kind: token.Ident,
+					Val:  "x",
+					Pos:  59,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  117,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  60,
 				},
-			},
-		},
-
-		{
-			path: "../../testdata/quiet/lexer/l04.c",
-			toks: []token.Token{
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  0,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  61,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "main",
-					Pos:  4,
+					Val:  "char",
+					Pos:  63,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  8,
+					Kind: token.Ident,
+					Val:  "e",
+					Pos:  68,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "void",
-					Pos:  9,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  70,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  13,
+					Kind: token.Error,
+					Val:  "hex escape sequence out of range",
+					Pos:  73,
 				},
 				{
-					Kind: token.Lbrace,
-					Val:  "{",
-					Pos:  15,
+					Kind: token.Error,
+					Val:  `unexpected U+005C '\'`,
+					Pos:  73,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "int",
-					Pos:  19,
+					Val:  "x100",
+					Pos:  74,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  23,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  78,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  24,
+					Pos:  79,
 				},
 				{
 					Kind: token.Ident,
 					Val:  "char",
-					Pos:  28,
+					Pos:  81,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "j",
-					Pos:  33,
-				},
-				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  34,
-				},
-				{
-					Kind: token.KwIf,
-					Val:  "if",
-					Pos:  38,
+					Val:  "f",
+					Pos:  86,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  41,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  88,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "1",
-					Pos:  42,
+					Kind: token.Error,
+					Val:  "octal escape sequence out of range",
+					Pos:  91,
 				},
 				{
-					Kind: token.Eq,
-					Val:  "==",
-					Pos:  43,
+					Kind: token.Error,
+					Val:  `unexpected U+005C '\'`,
+					Pos:  91,
 				},
 				{
 					Kind: token.IntLit,
-					Val:  "0",
-					Pos:  45,
-				},
-				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  46,
+					Val:  "400",
+					Pos:  92,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  48,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  95,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  50,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  96,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "0",
-					Pos:  52,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  98,
 				},
+			},
+		},
+		{
+			path: "../../testdata/extra/lexer/multibyte-char-lit.c",
+			toks: []token.Token{
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  53,
+					Kind: token.Comment,
+					Val:  "// Invalid use of multibyte character literal.",
+					Pos:  0,
 				},
 				{
-					Kind: token.KwElse,
-					Val:  "else",
-					Pos:  58,
+					Kind: token.Ident,
+					Val:  "char",
+					Pos:  47,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "i",
-					Pos:  63,
+					Val:  "c",
+					Pos:  52,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  65,
-				},
-				{
-					Kind: token.IntLit,
-					Val:  "1",
-					Pos:  67,
+					Pos:  54,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  68,
+					Kind: token.Error,
+					Val:  "character U+00B5 'µ' too large for enclosing character literal type",
+					Pos:  57,
 				},
 				{
-					Kind: token.KwWhile,
-					Val:  "while",
-					Pos:  72,
+					Kind: token.Error,
+					Val:  "unexpected U+00B5 'µ'",
+					Pos:  57,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  78,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  59,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "1",
-					Pos:  79,
+					Kind: token.Semicolon,
+					Val:  ";",
+					Pos:  60,
 				},
 				{
-					Kind: token.Eq,
-					Val:  "==",
-					Pos:  80,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  62,
 				},
+			},
+		},
+		{
+			path: "../../testdata/extra/lexer/illegal-utf8-char-lit.c",
+			toks: []token.Token{
 				{
-					Kind: token.IntLit,
-					Val:  "0",
-					Pos:  82,
+					Kind: token.Comment,
+					Val:  "// Illegal UTF-8 encoding in character literal.",
+					Pos:  0,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  83,
+					Kind: token.Ident,
+					Val:  "char",
+					Pos:  48,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "i",
-					Pos:  85,
+					Val:  "c",
+					Pos:  53,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  87,
-				},
-				{
-					Kind: token.IntLit,
-					Val:  "0",
-					Pos:  89,
+					Pos:  55,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  90,
+					Kind: token.Error,
+					Val:  "illegal UTF-8 encoding",
+					Pos:  58,
 				},
 				{
-					Kind: token.KwReturn,
-					Val:  "return",
-					Pos:  94,
+					Kind: token.Error,
+					Val:  "illegal UTF-8 encoding",
+					Pos:  58,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "42",
-					Pos:  101,
+					Kind: token.Error,
+					Val:  "unterminated character literal",
+					Pos:  59,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  103,
-				},
-				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  105,
+					Pos:  60,
 				},
 				{
 					Kind: token.EOF,
 					Val:  "",
-					Pos:  107,
+					Pos:  62,
 				},
 			},
 		},
-
 		{
-			path: "../../testdata/quiet/lexer/l05.c",
+			path: "../../testdata/extra/lexer/string-lit.c",
 			toks: []token.Token{
 				{
-					Kind: token.Ident,
-					Val:  "int",
+					Kind: token.Comment,
+					Val:  "// String literals.",
 					Pos:  0,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "main",
-					Pos:  4,
-				},
-				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  8,
+					Val:  "char",
+					Pos:  20,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "void",
-					Pos:  9,
+					Val:  "s",
+					Pos:  25,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  13,
+					Kind: token.Lbracket,
+					Val:  "[",
+					Pos:  26,
 				},
 				{
-					Kind: token.Lbrace,
-					Val:  "{",
-					Pos:  15,
+					Kind: token.Rbracket,
+					Val:  "]",
+					Pos:  27,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "int",
-					Pos:  19,
+					Kind: token.Assign,
+					Val:  "=",
+					Pos:  29,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  23,
+					Kind: token.StringLit,
+					Val:  `"foo\n"`,
+					Pos:  31,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  24,
+					Pos:  38,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "1",
-					Pos:  28,
+					Kind: token.Ident,
+					Val:  "putstring",
+					Pos:  40,
 				},
 				{
-					Kind: token.Ne,
-					Val:  "!=",
-					Pos:  29,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  49,
 				},
 				{
-					Kind: token.Not,
-					Val:  "!",
-					Pos:  31,
+					Kind: token.Error,
+					Val:  `unknown escape sequence '\q'`,
+					Pos:  52,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "3",
-					Pos:  32,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  56,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  33,
+					Pos:  57,
+				},
+				{
+					Kind: token.Ident,
+					Val:  "putstring",
+					Pos:  59,
+				},
+				{
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  68,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "4",
-					Pos:  37,
+					Kind: token.Error,
+					Val:  "unterminated string literal",
+					Pos:  69,
 				},
 				{
-					Kind: token.Land,
-					Val:  "&&",
-					Pos:  38,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  85,
 				},
+			},
+		},
+		{
+			path: "../../testdata/extra/lexer/int-lit.c",
+			toks: []token.Token{
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  40,
+					Kind: token.Comment,
+					Val:  "// Integer literals.",
+					Pos:  0,
 				},
 				{
 					Kind: token.IntLit,
-					Val:  "6",
-					Pos:  41,
+					Val:  "0",
+					Pos:  21,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  42,
+					Kind: token.IntLit,
+					Val:  "42",
+					Pos:  23,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  43,
+					Kind: token.IntLit,
+					Val:  "0x2A",
+					Pos:  26,
 				},
 				{
 					Kind: token.IntLit,
-					Val:  "7",
-					Pos:  47,
+					Val:  "0X2a",
+					Pos:  31,
 				},
 				{
-					Kind: token.Mul,
-					Val:  "*",
-					Pos:  48,
+					Kind: token.IntLit,
+					Val:  "052",
+					Pos:  36,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "8",
-					Pos:  50,
+					Kind: token.Error,
+					Val:  "invalid digit '9' in octal constant",
+					Pos:  40,
 				},
 				{
-					Kind: token.Add,
-					Val:  "+",
-					Pos:  51,
+					Kind: token.Error,
+					Val:  `invalid suffix "x" on integer constant`,
+					Pos:  43,
 				},
 				{
 					Kind: token.IntLit,
-					Val:  "10",
-					Pos:  52,
+					Val:  "10u",
+					Pos:  46,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  54,
+					Kind: token.IntLit,
+					Val:  "1L",
+					Pos:  50,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  58,
+					Kind: token.IntLit,
+					Val:  "0x1FuL",
+					Pos:  53,
 				},
 				{
 					Kind: token.IntLit,
-					Val:  "11",
-					Pos:  59,
+					Val:  "07ll",
+					Pos:  60,
 				},
 				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  61,
+					Kind: token.IntLit,
+					Val:  "1lul",
+					Pos:  65,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "12",
-					Pos:  62,
+					Kind: token.EOF,
+					Val:  "",
+					Pos:  70,
 				},
+			},
+		},
+		{
+			path: "../../testdata/extra/lexer/operators.c",
+			toks: []token.Token{
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  64,
+					Kind: token.Comment,
+					Val:  "// Operators.",
+					Pos:  0,
 				},
 				{
 					Kind: token.Add,
 					Val:  "+",
-					Pos:  65,
+					Pos:  14,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  66,
+					Kind: token.Sub,
+					Val:  "-",
+					Pos:  16,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "12",
-					Pos:  67,
+					Kind: token.Mul,
+					Val:  "*",
+					Pos:  18,
 				},
 				{
 					Kind: token.Div,
 					Val:  "/",
-					Pos:  69,
-				},
-				{
-					Kind: token.IntLit,
-					Val:  "16",
-					Pos:  70,
+					Pos:  20,
 				},
 				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  72,
+					Kind: token.Rem,
+					Val:  "%",
+					Pos:  22,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  73,
+					Kind: token.And,
+					Val:  "&",
+					Pos:  24,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "17",
-					Pos:  77,
+					Kind: token.Or,
+					Val:  "|",
+					Pos:  26,
 				},
 				{
-					Kind: token.Le,
-					Val:  "<=",
-					Pos:  79,
+					Kind: token.Xor,
+					Val:  "^",
+					Pos:  28,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "18",
-					Pos:  81,
+					Kind: token.Shl,
+					Val:  "<<",
+					Pos:  30,
 				},
 				{
-					Kind: token.Lt,
-					Val:  "<",
-					Pos:  84,
+					Kind: token.Shr,
+					Val:  ">>",
+					Pos:  33,
 				},
 				{
-					Kind: token.Sub,
-					Val:  "-",
-					Pos:  85,
+					Kind: token.Tilde,
+					Val:  "~",
+					Pos:  36,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "20",
-					Pos:  86,
+					Kind: token.Not,
+					Val:  "!",
+					Pos:  38,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  88,
+					Kind: token.Land,
+					Val:  "&&",
+					Pos:  40,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "i",
-					Pos:  92,
+					Kind: token.Lor,
+					Val:  "||",
+					Pos:  43,
 				},
 				{
 					Kind: token.Assign,
 					Val:  "=",
-					Pos:  93,
+					Pos:  46,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "21",
-					Pos:  94,
+					Kind: token.AddAssign,
+					Val:  "+=",
+					Pos:  48,
 				},
 				{
-					Kind: token.Eq,
-					Val:  "==",
-					Pos:  96,
+					Kind: token.SubAssign,
+					Val:  "-=",
+					Pos:  51,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "22",
-					Pos:  98,
+					Kind: token.MulAssign,
+					Val:  "*=",
+					Pos:  54,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  100,
+					Kind: token.DivAssign,
+					Val:  "/=",
+					Pos:  57,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "25",
-					Pos:  104,
+					Kind: token.RemAssign,
+					Val:  "%=",
+					Pos:  60,
 				},
 				{
-					Kind: token.Ge,
-					Val:  ">=",
-					Pos:  107,
+					Kind: token.AndAssign,
+					Val:  "&=",
+					Pos:  63,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "27",
-					Pos:  109,
+					Kind: token.OrAssign,
+					Val:  "|=",
+					Pos:  66,
 				},
 				{
-					Kind: token.Gt,
-					Val:  ">",
-					Pos:  111,
+					Kind: token.XorAssign,
+					Val:  "^=",
+					Pos:  69,
 				},
 				{
-					Kind: token.IntLit,
-					Val:  "28",
-					Pos:  112,
+					Kind: token.ShlAssign,
+					Val:  "<<=",
+					Pos:  72,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  114,
+					Kind: token.ShrAssign,
+					Val:  ">>=",
+					Pos:  76,
 				},
 				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  116,
+					Kind: token.Eq,
+					Val:  "==",
+					Pos:  80,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  118,
+					Kind: token.Ne,
+					Val:  "!=",
+					Pos:  83,
 				},
-			},
-		},
-
-		{
-			path: "../../testdata/quiet/lexer/l06.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "/* This file contains examples of various types of white space and comments. */",
-					Pos:  0,
+					Kind: token.Lt,
+					Val:  "<",
+					Pos:  86,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// A blank (32)",
-					Pos:  80,
+					Kind: token.Le,
+					Val:  "<=",
+					Pos:  88,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// A new-line (10)",
-					Pos:  98,
+					Kind: token.Gt,
+					Val:  ">",
+					Pos:  91,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// a carriage-return (13)",
-					Pos:  118,
+					Kind: token.Ge,
+					Val:  ">=",
+					Pos:  93,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// form-feed (12)",
-					Pos:  146,
+					Kind: token.Inc,
+					Val:  "++",
+					Pos:  96,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "// tab(9)",
-					Pos:  166,
+					Kind: token.Dec,
+					Val:  "--",
+					Pos:  99,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "/* In uC, each file must contain at least one declaration */",
-					Pos:  178,
+					Kind: token.Ident,
+					Val:  "a",
+					Pos:  102,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "/* a comment... */",
-					Pos:  240,
+					Kind: token.Inc,
+					Val:  "++",
+					Pos:  103,
+				},
+				{
+					Kind: token.Add,
+					Val:  "+",
+					Pos:  105,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "int",
-					Pos:  259,
+					Val:  "b",
+					Pos:  106,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "main",
-					Pos:  263,
+					Val:  "a",
+					Pos:  108,
 				},
 				{
-					Kind: token.Lparen,
-					Val:  "(",
-					Pos:  267,
+					Kind: token.Dec,
+					Val:  "--",
+					Pos:  109,
 				},
 				{
-					Kind: token.Comment,
-					Val:  "/* ...and another */",
-					Pos:  269,
+					Kind: token.Sub,
+					Val:  "-",
+					Pos:  111,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "void",
-					Pos:  297,
-				},
-				{
-					Kind: token.Rparen,
-					Val:  ")",
-					Pos:  302,
+					Val:  "b",
+					Pos:  112,
 				},
 				{
-					Kind: token.Lbrace,
-					Val:  "{",
-					Pos:  304,
+					Kind: token.Ident,
+					Val:  "p",
+					Pos:  114,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  306,
+					Kind: token.Period,
+					Val:  ".",
+					Pos:  115,
 				},
 				{
-					Kind: token.Rbrace,
-					Val:  "}",
-					Pos:  308,
+					Kind: token.Ident,
+					Val:  "x",
+					Pos:  116,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  313,
+					Kind: token.Ident,
+					Val:  "p",
+					Pos:  118,
 				},
-			},
-		},
-
-		// Test encodings.
-		{
-			path: "../../testdata/encoding/lexer/big5.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "/* \xA5\x40\xAC\xC9\xB1\x7A\xA6\x6E */",
-					Pos:  0,
+					Kind: token.Arrow,
+					Val:  "->",
+					Pos:  119,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "int",
-					Pos:  15,
+					Val:  "x",
+					Pos:  121,
 				},
 				{
 					Kind: token.Ident,
 					Val:  "a",
-					Pos:  19,
-				},
-				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  20,
+					Pos:  123,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  22,
+					Kind: token.Dec,
+					Val:  "--",
+					Pos:  124,
 				},
-			},
-		},
-		{
-			path: "../../testdata/encoding/lexer/iso-8859-1.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "// Hej v\xE4rlden!",
-					Pos:  0,
+					Kind: token.Gt,
+					Val:  ">",
+					Pos:  126,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "int",
-					Pos:  16,
+					Val:  "b",
+					Pos:  127,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "b",
-					Pos:  20,
+					Val:  "f",
+					Pos:  129,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  21,
+					Kind: token.Lparen,
+					Val:  "(",
+					Pos:  130,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  23,
+					Kind: token.Ident,
+					Val:  "a",
+					Pos:  131,
 				},
-			},
-		},
-		{
-			path: "../../testdata/encoding/lexer/utf-8.c",
-			toks: encTokens,
-		},
-		{
-			path: "../../testdata/encoding/lexer/utf-8_bom.c",
-			toks: encTokens,
-		},
-		{
-			path: "../../testdata/encoding/lexer/utf-16be_bom.c",
-			toks: encTokens,
-		},
-		{
-			path: "../../testdata/encoding/lexer/utf-16le_bom.c",
-			toks: encTokens,
-		},
-
-		// Extra tests.
-		{
-			path: "../../testdata/extra/lexer/invalid-esc.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "// Test invalid escape sequence.",
-					Pos:  0,
+					Kind: token.Comma,
+					Val:  ",",
+					Pos:  132,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "char",
-					Pos:  33,
+					Kind: token.Ellipsis,
+					Val:  "...",
+					Pos:  134,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "c",
-					Pos:  38,
+					Kind: token.Rparen,
+					Val:  ")",
+					Pos:  137,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  40,
+					Kind: token.Period,
+					Val:  ".",
+					Pos:  139,
 				},
 				{
-					Kind: token.Error,
-					Val:  `unknown escape sequence '\q'`,
-					Pos:  43,
+					Kind: token.Period,
+					Val:  ".",
+					Pos:  141,
 				},
 				{
-					Kind: token.Error,
-					Val:  `unexpected U+005C '\'`,
-					Pos:  43,
+					Kind: token.Period,
+					Val:  ".",
+					Pos:  142,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "q",
-					Pos:  44,
+					Kind: token.KwCase,
+					Val:  "case",
+					Pos:  144,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unterminated character literal",
-					Pos:  45,
+					Kind: token.IntLit,
+					Val:  "1",
+					Pos:  149,
 				},
 				{
-					Kind: token.Semicolon,
-					Val:  ";",
-					Pos:  46,
+					Kind: token.Colon,
+					Val:  ":",
+					Pos:  150,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  48,
+					Kind: token.KwDefault,
+					Val:  "default",
+					Pos:  152,
 				},
-			},
-		},
-		{
-			path: "../../testdata/extra/lexer/multibyte-char-lit.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "// Invalid use of multibyte character literal.",
-					Pos:  0,
+					Kind: token.Colon,
+					Val:  ":",
+					Pos:  159,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "char",
-					Pos:  47,
+					Val:  "a",
+					Pos:  161,
+				},
+				{
+					Kind: token.Question,
+					Val:  "?",
+					Pos:  163,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  52,
+					Val:  "b",
+					Pos:  165,
 				},
 				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  54,
+					Kind: token.Colon,
+					Val:  ":",
+					Pos:  167,
 				},
 				{
-					Kind: token.Error,
-					Val:  "character U+00B5 'µ' too large for enclosing character literal type",
-					Pos:  57,
+					Kind: token.Ident,
+					Val:  "c",
+					Pos:  169,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unexpected U+00B5 'µ'",
-					Pos:  57,
+					Kind: token.KwGoto,
+					Val:  "goto",
+					Pos:  171,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unterminated character literal",
-					Pos:  59,
+					Kind: token.Ident,
+					Val:  "l",
+					Pos:  176,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  60,
+					Pos:  177,
 				},
 				{
-					Kind: token.EOF,
-					Val:  "",
-					Pos:  62,
+					Kind: token.Ident,
+					Val:  "l",
+					Pos:  179,
 				},
-			},
-		},
-		{
-			path: "../../testdata/extra/lexer/illegal-utf8-char-lit.c",
-			toks: []token.Token{
 				{
-					Kind: token.Comment,
-					Val:  "// Illegal UTF-8 encoding in character literal.",
-					Pos:  0,
+					Kind: token.Colon,
+					Val:  ":",
+					Pos:  180,
 				},
 				{
-					Kind: token.Ident,
-					Val:  "char",
-					Pos:  48,
+					Kind: token.KwEnum,
+					Val:  "enum",
+					Pos:  182,
 				},
 				{
 					Kind: token.Ident,
-					Val:  "c",
-					Pos:  53,
-				},
-				{
-					Kind: token.Assign,
-					Val:  "=",
-					Pos:  55,
+					Val:  "e",
+					Pos:  187,
 				},
 				{
-					Kind: token.Error,
-					Val:  "illegal UTF-8 encoding",
-					Pos:  58,
+					Kind: token.Lbrace,
+					Val:  "{",
+					Pos:  189,
 				},
 				{
-					Kind: token.Error,
-					Val:  "illegal UTF-8 encoding",
-					Pos:  58,
+					Kind: token.Ident,
+					Val:  "A",
+					Pos:  191,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unterminated character literal",
-					Pos:  59,
+					Kind: token.Rbrace,
+					Val:  "}",
+					Pos:  193,
 				},
 				{
 					Kind: token.Semicolon,
 					Val:  ";",
-					Pos:  60,
+					Pos:  194,
 				},
 				{
 					Kind: token.EOF,
 					Val:  "",
-					Pos:  62,
+					Pos:  196,
 				},
 			},
 		},
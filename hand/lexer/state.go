@@ -7,6 +7,8 @@
 package lexer
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -24,6 +26,12 @@ const (
 	whitespace = " \t\n\v\f\r"
 	// decimal specifies the decimal digit characters.
 	decimal = "0123456789"
+	// octal specifies the octal digit characters.
+	octal = "01234567"
+	// intSuffix specifies the integer suffix characters.
+	intSuffix = "uUlL"
+	// hex specifies the hexadecimal digit characters.
+	hex = decimal + "abcdefABCDEF"
 	// upper specifies the uppercase letters.
 	upper = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	// lower specifies the lowercase letters.
@@ -75,20 +83,32 @@ func lexToken(l *lexer) stateFn {
 	case ';':
 		l.emit(token.Semicolon)
 		return lexToken
+	case ':':
+		l.emit(token.Colon)
+		return lexToken
+	case '?':
+		l.emit(token.Question)
+		return lexToken
 	case ',':
 		l.emit(token.Comma)
 		return lexToken
+	case '.':
+		return lexPeriod
 	case '+':
-		l.emit(token.Add)
-		return lexToken
+		return lexPlus
 	case '-':
-		l.emit(token.Sub)
-		return lexToken
+		return lexMinus
 	case '*':
-		l.emit(token.Mul)
-		return lexToken
+		return lexAsterisk
 	case '/':
 		return lexSlash
+	case '%':
+		return lexPercent
+	case '^':
+		return lexCaret
+	case '~':
+		l.emit(token.Tilde)
+		return lexToken
 	case '<':
 		return lexLess
 	case '>':
@@ -97,8 +117,12 @@ func lexToken(l *lexer) stateFn {
 		return lexEqual
 	case '&':
 		return lexAmpersand
+	case '|':
+		return lexPipe
 	case '\'':
 		return lexCharLit
+	case '"':
+		return lexStringLit
 	case '!':
 		return lexExclaim
 	}
@@ -118,8 +142,86 @@ func lexToken(l *lexer) stateFn {
 	return lexToken
 }
 
-// lexSlash lexes a division operator (/), a line comment (//), or a block
-// comment (/*). A slash character (/) has already been consumed.
+// lexPlus lexes an addition operator (+), an addition assignment operator (+=)
+// or an increment operator (++). A plus character (+) has already been
+// consumed.
+func lexPlus(l *lexer) stateFn {
+	switch {
+	case l.accept("="):
+		l.emit(token.AddAssign)
+	case l.accept("+"):
+		l.emit(token.Inc)
+	default:
+		l.emit(token.Add)
+	}
+	return lexToken
+}
+
+// lexMinus lexes a subtraction operator (-), a subtraction assignment operator
+// (-=), a decrement operator (--) or a member access operator (->). A minus
+// character (-) has already been consumed.
+func lexMinus(l *lexer) stateFn {
+	switch {
+	case l.accept("="):
+		l.emit(token.SubAssign)
+	case l.accept("-"):
+		l.emit(token.Dec)
+	case l.accept(">"):
+		l.emit(token.Arrow)
+	default:
+		l.emit(token.Sub)
+	}
+	return lexToken
+}
+
+// lexPeriod lexes a member access operator (.) or an ellipsis (...). A period
+// (.) has already been consumed.
+func lexPeriod(l *lexer) stateFn {
+	if strings.HasPrefix(l.input[l.cur:], "..") {
+		l.cur += len("..")
+		l.emit(token.Ellipsis)
+	} else {
+		l.emit(token.Period)
+	}
+	return lexToken
+}
+
+// lexAsterisk lexes a multiplication operator (*) or a multiplication
+// assignment operator (*=). An asterisk (*) has already been consumed.
+func lexAsterisk(l *lexer) stateFn {
+	if l.accept("=") {
+		l.emit(token.MulAssign)
+	} else {
+		l.emit(token.Mul)
+	}
+	return lexToken
+}
+
+// lexPercent lexes a remainder operator (%) or a remainder assignment operator
+// (%=). A percent sign (%) has already been consumed.
+func lexPercent(l *lexer) stateFn {
+	if l.accept("=") {
+		l.emit(token.RemAssign)
+	} else {
+		l.emit(token.Rem)
+	}
+	return lexToken
+}
+
+// lexCaret lexes a bitwise XOR operator (^) or a bitwise XOR assignment
+// operator (^=). A caret (^) has already been consumed.
+func lexCaret(l *lexer) stateFn {
+	if l.accept("=") {
+		l.emit(token.XorAssign)
+	} else {
+		l.emit(token.Xor)
+	}
+	return lexToken
+}
+
+// lexSlash lexes a division operator (/), a division assignment operator (/=),
+// a line comment (//), or a block comment (/*). A slash character (/) has
+// already been consumed.
 func lexSlash(l *lexer) stateFn {
 	switch l.next() {
 	case '/':
@@ -128,6 +230,10 @@ func lexSlash(l *lexer) stateFn {
 	case '*':
 		// Block comment (/*).
 		return lexBlockComment
+	case '=':
+		// Division assignment operator (/=).
+		l.emit(token.DivAssign)
+		return lexToken
 	default:
 		// Division operator (/).
 		l.backup()
@@ -181,36 +287,69 @@ func lexBlockComment(l *lexer) stateFn {
 	return lexToken
 }
 
-// lexAmpersand lexes a logical AND operator (&&). An ampersand (&) has already
+// lexAmpersand lexes a bitwise AND operator (&), a bitwise AND assignment
+// operator (&=) or a logical AND operator (&&). An ampersand (&) has already
 // been consumed.
 func lexAmpersand(l *lexer) stateFn {
-	if r := l.next(); r == '&' {
+	switch {
+	case l.accept("="):
+		l.emit(token.AndAssign)
+	case l.accept("&"):
 		l.emit(token.Land)
-	} else {
-		// Emit error token but continue lexing next token.
-		l.backup()
-		l.emitErrorf("expected '&' after '&', got %#U", r)
+	default:
+		l.emit(token.And)
+	}
+	return lexToken
+}
+
+// lexPipe lexes a bitwise OR operator (|), a bitwise OR assignment operator
+// (|=) or a logical OR operator (||). A vertical bar (|) has already been
+// consumed.
+func lexPipe(l *lexer) stateFn {
+	switch {
+	case l.accept("="):
+		l.emit(token.OrAssign)
+	case l.accept("|"):
+		l.emit(token.Lor)
+	default:
+		l.emit(token.Or)
 	}
 	return lexToken
 }
 
-// lexLess lexes a less-than operator (<) or a less-than-or-equal-to
-// operator (<=). A less than character (<) has already been consumed.
+// lexLess lexes a less-than operator (<), a less-than-or-equal-to operator
+// (<=), a left shift operator (<<) or a left shift assignment operator (<<=). A
+// less than character (<) has already been consumed.
 func lexLess(l *lexer) stateFn {
-	if l.accept("=") {
+	switch {
+	case l.accept("="):
 		l.emit(token.Le)
-	} else {
+	case l.accept("<"):
+		if l.accept("=") {
+			l.emit(token.ShlAssign)
+		} else {
+			l.emit(token.Shl)
+		}
+	default:
 		l.emit(token.Lt)
 	}
 	return lexToken
 }
 
-// lexGreater lexes a greater-than operator (>) or a greater-than-or-equal-to
-// operator (>=). A greater than character (>) has already been consumed.
+// lexGreater lexes a greater-than operator (>), a greater-than-or-equal-to
+// operator (>=), a right shift operator (>>) or a right shift assignment
+// operator (>>=). A greater than character (>) has already been consumed.
 func lexGreater(l *lexer) stateFn {
-	if l.accept("=") {
+	switch {
+	case l.accept("="):
 		l.emit(token.Ge)
-	} else {
+	case l.accept(">"):
+		if l.accept("=") {
+			l.emit(token.ShrAssign)
+		} else {
+			l.emit(token.Shr)
+		}
+	default:
 		l.emit(token.Gt)
 	}
 	return lexToken
@@ -241,10 +380,10 @@ func lexExclaim(l *lexer) stateFn {
 	return lexToken
 }
 
-// lexCharLit lexes a character literal (e.g. 'a', '\n'). An apostrophe (') has
-// already been consumed.
+// lexCharLit lexes a character literal (e.g. 'a', '\n', '\x41'). An apostrophe
+// (') has already been consumed.
 //
-//    CharLit = "'" ([^'] | "\n" ) "'"
+//    CharLit = "'" ([^'\\] | EscapeSeq ) "'"
 func lexCharLit(l *lexer) stateFn {
 	// Store position directly after the token prefix, i.e. after the apostrophe.
 	cur := l.cur
@@ -265,17 +404,10 @@ func lexCharLit(l *lexer) stateFn {
 		l.ignore()
 		return lexToken
 	case r == '\\':
-		switch {
-		case l.accept("n"):
-			// Valid escape sequence.
-		default:
-			// TODO: Evaluate if errorfCur is always used before restoring the
-			// posision to the one directly after the token prefix. If that should
-			// be the case, rewrite errorfCur to take another arugment cur, and let
-			// it restore the position.
-			r := l.next()
-			l.backup()
-			l.errorfCur(`unknown escape sequence '\%c'`, r)
+		if err := lexEscape(l); err != "" {
+			// Emit error token at the backslash, but continue lexing next token.
+			l.cur = cur + 1
+			l.errorfCur("%s", err)
 			// Continue lexing directly after the token prefix.
 			l.cur = cur
 			l.ignore()
@@ -296,12 +428,123 @@ func lexCharLit(l *lexer) stateFn {
 	return lexToken
 }
 
-// lexIntLit lexes an integer literal (e.g. 123). A decimal digit (0-9) has
+// lexStringLit lexes a string literal (e.g. "foo\n"). A double quote (") has
 // already been consumed.
 //
-//    IntLit = [0-9]+
+//    StringLit = "\"" ([^"\\\n] | EscapeSeq )* "\""
+func lexStringLit(l *lexer) stateFn {
+	valid := true
+	for {
+		switch r := l.next(); r {
+		case '"':
+			if valid {
+				l.emit(token.StringLit)
+			} else {
+				// Errors have already been reported; skip the string literal.
+				l.ignore()
+			}
+			return lexToken
+		case '\n', eof:
+			// Emit error token but continue lexing at the end of the line.
+			if r == '\n' {
+				l.backup()
+			}
+			l.errorf("unterminated string literal")
+			l.ignore()
+			return lexToken
+		case utf8.RuneError:
+			// Emit error token but continue lexing the string literal.
+			l.errorfCur("illegal UTF-8 encoding")
+			valid = false
+		case '\\':
+			cur := l.cur
+			if err := lexEscape(l); err != "" {
+				// Emit error token at the backslash, but continue lexing the
+				// string literal directly after the backslash.
+				l.cur = cur
+				l.errorfCur("%s", err)
+				valid = false
+			}
+		}
+	}
+}
+
+// lexEscape lexes the remainder of an escape sequence (§6.4.4.4). A backslash
+// (\) has already been consumed. The returned error message is empty if the
+// escape sequence is valid.
+//
+//    EscapeSeq    = "\\" ( SimpleEscape | OctalEscape | HexEscape )
+//    SimpleEscape = ['"?\\abfnrtv]
+//    OctalEscape  = [0-7]{1,3}
+//    HexEscape    = "x" [0-9a-fA-F]+
+func lexEscape(l *lexer) string {
+	switch {
+	case l.accept(`'"?\abfnrtv`):
+		// Valid simple escape sequence.
+		return ""
+	case l.accept(octal):
+		x := int(l.input[l.cur-1] - '0')
+		for i := 1; i < 3 && l.accept(octal); i++ {
+			x = x*8 + int(l.input[l.cur-1]-'0')
+		}
+		if x > 0xFF {
+			return "octal escape sequence out of range"
+		}
+		return ""
+	case l.accept("x"):
+		start := l.cur
+		l.acceptRun(hex)
+		if l.cur == start {
+			return `\x used with no following hex digits`
+		}
+		x, err := strconv.ParseUint(l.input[start:l.cur], 16, 64)
+		if err != nil || x > 0xFF {
+			return "hex escape sequence out of range"
+		}
+		return ""
+	}
+	r := l.next()
+	l.backup()
+	return fmt.Sprintf(`unknown escape sequence '\%c'`, r)
+}
+
+// lexIntLit lexes an integer literal (e.g. 123, 0x7B, 0173, 10u). A decimal
+// digit (0-9) has already been consumed. The integer suffix is validated during
+// type checking.
+//
+//    IntLit = ( DecimalLit | OctalLit | HexLit ) IntSuffix
+//
+//    DecimalLit = [1-9][0-9]*
+//    OctalLit   = "0" [0-7]*
+//    HexLit     = "0" [xX] [0-9a-fA-F]+
+//    IntSuffix  = [uUlL]*
 func lexIntLit(l *lexer) stateFn {
-	l.acceptRun(decimal)
+	if l.input[l.start] != '0' {
+		// Decimal integer literal.
+		l.acceptRun(decimal)
+		l.acceptRun(intSuffix)
+		l.emit(token.IntLit)
+		return lexToken
+	}
+	if l.accept("xX") {
+		// Hexadecimal integer literal.
+		if !l.acceptRun(hex) {
+			// Emit error token but continue lexing next token.
+			l.emitErrorf("invalid suffix %q on integer constant", l.input[l.start+1:l.cur])
+			return lexToken
+		}
+		l.acceptRun(intSuffix)
+		l.emit(token.IntLit)
+		return lexToken
+	}
+	// Octal integer literal.
+	l.acceptRun(octal)
+	if cur := l.cur; l.acceptRun(decimal) {
+		// Emit error token but continue lexing next token.
+		l.emitErrorf("invalid digit %q in octal constant", l.input[cur])
+		return lexToken
+	}
+	l.acceptRun(intSuffix)
 	l.emit(token.IntLit)
 	return lexToken
 }
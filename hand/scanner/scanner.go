@@ -21,6 +21,10 @@ type scanner struct {
 	toks []uctoken.Token
 	// Current token.
 	cur int
+	// Source file of the lexed tokens; used to translate token offsets into
+	// positions within the file set of the source file. Token positions are
+	// plain byte offsets if file is nil.
+	file *uctoken.File
 }
 
 // Ensure that scanner implements the Gocc Scanner interface.
@@ -46,16 +50,30 @@ func (s *scanner) Scan() *token.Token {
 		// Skip comments.
 		return s.Scan()
 	case uctoken.Ident:
-		typ = token.TokMap.Type("ident")
+		switch tok.Val {
+		case "char", "int", "long", "short", "unsigned", "void":
+			// Type keywords are lexed as identifiers by the hand-written lexer,
+			// but are distinct tokens of the Gocc grammar.
+			typ = token.TokMap.Type(tok.Val)
+		default:
+			typ = token.TokMap.Type("ident")
+		}
 	case uctoken.IntLit:
 		typ = token.TokMap.Type("int_lit")
 	case uctoken.CharLit:
 		typ = token.TokMap.Type("char_lit")
+	case uctoken.StringLit:
+		typ = token.TokMap.Type("string_lit")
 	default:
 		typ = token.TokMap.Type(tok.Val)
 	}
 	lit := []byte(tok.Val)
-	pos := token.Pos{Offset: tok.Pos}
+	pos := token.Pos{Offset: int(tok.Pos)}
+	if s.file != nil {
+		p := s.file.Pos(int(tok.Pos))
+		position := s.file.Position(p)
+		pos = token.Pos{Offset: int(p), Line: position.Line, Column: position.Column}
+	}
 	return &token.Token{
 		Type: typ,
 		Lit:  lit,
@@ -83,12 +101,20 @@ func Open(path string) (Scanner, error) {
 
 // NewFromString returns a new scanner lexing from input.
 func NewFromString(input string) Scanner {
-	toks := lexer.ParseString(input)
-	return &scanner{toks: toks}
+	return NewFromBytes([]byte(input))
 }
 
 // NewFromBytes returns a new scanner lexing from input.
 func NewFromBytes(input []byte) Scanner {
+	file := uctoken.NewFile("", len(input))
+	return NewFromFile(file, input)
+}
+
+// NewFromFile returns a new scanner lexing from input, which holds the contents
+// of the given source file. Token positions are relative to the file set of the
+// source file.
+func NewFromFile(file *uctoken.File, input []byte) Scanner {
 	toks := lexer.ParseString(string(input))
-	return &scanner{toks: toks}
+	file.SetLinesForContent(input)
+	return &scanner{toks: toks, file: file}
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/mewkiz/pkg/term"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/token"
+	uctypes "github.com/mewmew/uc/types"
 )
 
 // TODO: Remove debug output.
@@ -26,13 +28,34 @@ type Module struct {
 	// info holds semantic information about the program from the type-checker.
 	info *sem.Info
 	// Maps from identifier source code position to the associated value.
-	idents map[int]value.Value
+	idents map[token.Pos]value.Value
+	// Number of string literals emitted; used to name their global variables.
+	strs int
+	// Maps from tagged structure types to the associated named structure types.
+	structs map[*uctypes.Struct]*irtypes.StructType
+	// Set of named structure type names.
+	structNames map[string]bool
+	// The llvm.memset intrinsic; or nil if not yet declared.
+	memset *ir.Func
+	// Maps from nested function definitions to the local variables of
+	// enclosing functions captured by them.
+	captures map[*ast.FuncDecl][]*ast.VarDecl
+	// Set of nested function names.
+	funcNames map[string]bool
 }
 
 // NewModule returns a new module generator.
 func NewModule(info *sem.Info) *Module {
 	m := ir.NewModule()
-	return &Module{Module: m, info: info, idents: make(map[int]value.Value)}
+	return &Module{
+		Module:      m,
+		info:        info,
+		idents:      make(map[token.Pos]value.Value),
+		structs:     make(map[*uctypes.Struct]*irtypes.StructType),
+		structNames: make(map[string]bool),
+		captures:    make(map[*ast.FuncDecl][]*ast.VarDecl),
+		funcNames:   make(map[string]bool),
+	}
 }
 
 // emitFunc emits to m the given function.
@@ -49,12 +72,38 @@ func (m *Module) emitGlobal(global *ir.Global) {
 type Func struct {
 	// Function being generated.
 	*ir.Func
-	// Current basic block being generated.
+	// Entry basic block of the function.
+	entry *Block
+	// Current basic block being generated; or nil if following a terminator.
 	curBlock *Block
 	// Maps from identifier source code position to the associated value.
-	idents map[int]value.Value
+	idents map[token.Pos]value.Value
 	// Map of existing local variable names.
 	exists map[string]bool
+	// Target basic blocks of break and continue statements within the
+	// innermost loop or switch statement; or nil if outside of loops and switch
+	// statements. The continue target of switch statements is that of the
+	// enclosing loop.
+	breakTarget    *Block
+	continueTarget *Block
+	// Maps from case labels of switch statements to their target basic blocks.
+	caseTargets map[*ast.CaseStmt]*Block
+	// Maps from label names to their target basic blocks, as the labeled
+	// statements are lowered.
+	labels map[string]*Block
+	// Maps from label names to the branch terminators of goto statements
+	// preceding the labeled statement; resolved at the end of the function body.
+	gotos map[string][]*ir.TermBr
+	// hasLabels specifies whether the function contains labeled statements, in
+	// which case local variables are allocated in the entry basic block, as
+	// goto statements may jump into the scope of a variable past its
+	// declaration.
+	hasLabels bool
+	// Static chain parameter of nested functions, holding the environment of
+	// captured variables; or nil if no variables are captured.
+	env *ir.Param
+	// Local variables of enclosing functions captured by the nested function.
+	captures []*ast.VarDecl
 }
 
 // NewFunc returns a new function generator based on the given function name and
@@ -63,12 +112,13 @@ type Func struct {
 // The caller is responsible for initializing basic blocks.
 func NewFunc(name string, retType irtypes.Type, params ...*ir.Param) *Func {
 	f := ir.NewFunc(name, retType, params...)
-	return &Func{Func: f, idents: make(map[int]value.Value), exists: make(map[string]bool)}
+	return &Func{Func: f, idents: make(map[token.Pos]value.Value), exists: make(map[string]bool), caseTargets: make(map[*ast.CaseStmt]*Block), labels: make(map[string]*Block), gotos: make(map[string][]*ir.TermBr)}
 }
 
 // startBody initializes the generation of the function body.
 func (f *Func) startBody() {
 	entry := f.NewBlock("") // "entry"
+	f.entry = entry
 	f.curBlock = entry
 }
 
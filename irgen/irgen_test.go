@@ -110,6 +110,18 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/unary_expr_not.c",
 			want: "../testdata/extra/irgen/unary_expr_not.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/unary_expr_tilde.c",
+			want: "../testdata/extra/irgen/unary_expr_tilde.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/unary_expr_inc_dec.c",
+			want: "../testdata/extra/irgen/unary_expr_inc_dec.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/postfix_expr.c",
+			want: "../testdata/extra/irgen/postfix_expr.ll",
+		},
 		// Binary expressions.
 		{
 			path: "../testdata/extra/irgen/binary_expr_add.c",
@@ -127,6 +139,18 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/binary_expr_div.c",
 			want: "../testdata/extra/irgen/binary_expr_div.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_rem.c",
+			want: "../testdata/extra/irgen/binary_expr_rem.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_shl.c",
+			want: "../testdata/extra/irgen/binary_expr_shl.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_shr.c",
+			want: "../testdata/extra/irgen/binary_expr_shr.ll",
+		},
 		{
 			path: "../testdata/extra/irgen/binary_expr_lt.c",
 			want: "../testdata/extra/irgen/binary_expr_lt.ll",
@@ -155,14 +179,58 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/binary_expr_land.c",
 			want: "../testdata/extra/irgen/binary_expr_land.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_lor.c",
+			want: "../testdata/extra/irgen/binary_expr_lor.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_lor_land.c",
+			want: "../testdata/extra/irgen/binary_expr_lor_land.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_and.c",
+			want: "../testdata/extra/irgen/binary_expr_and.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_or.c",
+			want: "../testdata/extra/irgen/binary_expr_or.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_xor.c",
+			want: "../testdata/extra/irgen/binary_expr_xor.ll",
+		},
 		{
 			path: "../testdata/extra/irgen/binary_expr_assign.c",
 			want: "../testdata/extra/irgen/binary_expr_assign.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_add_assign.c",
+			want: "../testdata/extra/irgen/binary_expr_add_assign.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_add_assign_index.c",
+			want: "../testdata/extra/irgen/binary_expr_add_assign_index.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_compound_assign.c",
+			want: "../testdata/extra/irgen/binary_expr_compound_assign.ll",
+		},
 		{
 			path: "../testdata/extra/irgen/while_stmt.c",
 			want: "../testdata/extra/irgen/while_stmt.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/for_stmt.c",
+			want: "../testdata/extra/irgen/for_stmt.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/do_while_stmt.c",
+			want: "../testdata/extra/irgen/do_while_stmt.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/break_continue_stmt.c",
+			want: "../testdata/extra/irgen/break_continue_stmt.ll",
+		},
 		// Function parameters.
 		{
 			path: "../testdata/extra/irgen/func_param.c",
@@ -285,6 +353,136 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/global_array_param.c",
 			want: "../testdata/extra/irgen/global_array_param.ll",
 		},
+		// Escape sequences of character literals.
+		{
+			path: "../testdata/extra/irgen/char_lit_esc.c",
+			want: "../testdata/extra/irgen/char_lit_esc.ll",
+		},
+		// String literals.
+		{
+			path: "../testdata/extra/irgen/string_lit.c",
+			want: "../testdata/extra/irgen/string_lit.ll",
+		},
+		// Hexadecimal and octal integer literals.
+		{
+			path: "../testdata/extra/irgen/int_lit_hex_oct.c",
+			want: "../testdata/extra/irgen/int_lit_hex_oct.ll",
+		},
+		// Integer suffixes.
+		{
+			path: "../testdata/extra/irgen/int_lit_suffix.c",
+			want: "../testdata/extra/irgen/int_lit_suffix.ll",
+		},
+		// Pointers.
+		{
+			path: "../testdata/extra/irgen/pointer_addr_deref.c",
+			want: "../testdata/extra/irgen/pointer_addr_deref.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/pointer_arith.c",
+			want: "../testdata/extra/irgen/pointer_arith.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/pointer_param.c",
+			want: "../testdata/extra/irgen/pointer_param.ll",
+		},
+		// Structures.
+		{
+			path: "../testdata/extra/irgen/struct_member.c",
+			want: "../testdata/extra/irgen/struct_member.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/struct_list.c",
+			want: "../testdata/extra/irgen/struct_list.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/struct_rvalue_member.c",
+			want: "../testdata/extra/irgen/struct_rvalue_member.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/struct_cond_member.c",
+			want: "../testdata/extra/irgen/struct_cond_member.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/struct_incomplete.c",
+			want: "../testdata/extra/irgen/struct_incomplete.ll",
+		},
+		// Type definition names in pointer, cast and sizeof types.
+		{
+			path: "../testdata/extra/irgen/typedef_ptr.c",
+			want: "../testdata/extra/irgen/typedef_ptr.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/typedef_tag.c",
+			want: "../testdata/extra/irgen/typedef_tag.ll",
+		},
+		// Multi-dimensional arrays.
+		{
+			path: "../testdata/extra/irgen/matrix_local.c",
+			want: "../testdata/extra/irgen/matrix_local.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/matrix_global.c",
+			want: "../testdata/extra/irgen/matrix_global.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/matrix_row.c",
+			want: "../testdata/extra/irgen/matrix_row.ll",
+		},
+		// Initializers.
+		{
+			path: "../testdata/extra/irgen/init_global.c",
+			want: "../testdata/extra/irgen/init_global.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/init_local.c",
+			want: "../testdata/extra/irgen/init_local.ll",
+		},
+		// Constant expressions.
+		{
+			path: "../testdata/extra/irgen/const_fold.c",
+			want: "../testdata/extra/irgen/const_fold.ll",
+		},
+		// Variadic functions.
+		{
+			path: "../testdata/extra/irgen/variadic.c",
+			want: "../testdata/extra/irgen/variadic.ll",
+		},
+		// Integer types.
+		{
+			path: "../testdata/extra/irgen/unsigned.c",
+			want: "../testdata/extra/irgen/unsigned.ll",
+		},
+		// Cast expressions and sizeof.
+		{
+			path: "../testdata/extra/irgen/cast.c",
+			want: "../testdata/extra/irgen/cast.ll",
+		},
+		// Switch statements.
+		{
+			path: "../testdata/extra/irgen/switch.c",
+			want: "../testdata/extra/irgen/switch.ll",
+		},
+		// Conditional expressions.
+		{
+			path: "../testdata/extra/irgen/cond_expr.c",
+			want: "../testdata/extra/irgen/cond_expr.ll",
+		},
+		// Goto statements.
+		{
+			path: "../testdata/extra/irgen/goto.c",
+			want: "../testdata/extra/irgen/goto.ll",
+		},
+		// Enumeration types.
+		{
+			path: "../testdata/extra/irgen/enum.c",
+			want: "../testdata/extra/irgen/enum.ll",
+		},
+		// Nested functions.
+		{
+			path: "../testdata/extra/irgen/nested_func.c",
+			want: "../testdata/extra/irgen/nested_func.ll",
+		},
 	}
 
 	for _, g := range golden {
@@ -299,7 +497,7 @@ func TestGen(t *testing.T) {
 
 		// Parse input.
 		p := parser.NewParser()
-		f, err := p.Parse(s)
+		f, err := p.ParseAll(s)
 		if err != nil {
 			t.Errorf("%q: parse error: %v", g.path, err)
 			continue
@@ -5,7 +5,6 @@ package irgen
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/llir/llvm/ir"
 	"github.com/llir/llvm/ir/constant"
@@ -14,8 +13,10 @@ import (
 	"github.com/llir/llvm/ir/value"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/gocc/util"
 	"github.com/mewmew/uc/sem"
 	"github.com/mewmew/uc/token"
+	uctypes "github.com/mewmew/uc/types"
 )
 
 // Gen generates LLVM IR based on the syntax tree of the given file.
@@ -28,7 +29,14 @@ func Gen(file *ast.File, info *sem.Info) *ir.Module {
 // gen generates LLVM IR based on the syntax tree of the given file.
 func gen(file *ast.File, info *sem.Info) *ir.Module {
 	m := NewModule(info)
+	m.captures = findCaptures(file)
 	for _, decl := range file.Decls {
+		// Ignore structure and enumeration declarations without declarator, as
+		// structure types are emitted when used, and enumeration constants are
+		// folded.
+		if decl.Name() == nil {
+			continue
+		}
 		// Ignore tentative definitions.
 		if isTentativeDef(decl) {
 			dbg.Printf("ignoring tentative definition of %q", decl.Name())
@@ -36,7 +44,7 @@ func gen(file *ast.File, info *sem.Info) *ir.Module {
 		}
 		switch decl := decl.(type) {
 		case *ast.FuncDecl:
-			m.funcDecl(decl)
+			m.funcDecl(decl.Name().String(), decl)
 		case *ast.VarDecl:
 			m.globalVarDecl(decl)
 		case *ast.TypeDef:
@@ -51,33 +59,48 @@ func gen(file *ast.File, info *sem.Info) *ir.Module {
 // --- [ Function declaration ] ------------------------------------------------
 
 // funcDecl lowers the given function declaration to LLVM IR, emitting code to
-// m.
-func (m *Module) funcDecl(n *ast.FuncDecl) {
+// m. The function is emitted with the given name.
+func (m *Module) funcDecl(name string, n *ast.FuncDecl) {
 	// Generate function signature.
 	ident := n.Name()
-	name := ident.String()
-	typ := toIrType(n.Type())
+	typ := m.toIrType(n.Type())
 	sig, ok := typ.(*irtypes.FuncType)
 	if !ok {
 		panic(fmt.Sprintf("invalid function type; expected *types.FuncType, got %T", typ))
 	}
 	var params []*ir.Param
-	for i, p := range n.FuncType.Params {
-		paramType := sig.Params[i]
+	for _, p := range n.FuncType.Params {
+		if uctypes.IsVoid(p.Type()) {
+			// Skip void parameter of functions without parameters; e.g. f(void).
+			continue
+		}
+		paramType := sig.Params[len(params)]
 		param := ir.NewParam(p.Name().String(), paramType)
 		params = append(params, param)
 	}
+	// Pass captured variables of nested functions through the static chain
+	// parameter.
+	var env *ir.Param
+	captures := m.captures[n]
+	if len(captures) > 0 {
+		env = m.newEnvParam(captures)
+		params = append([]*ir.Param{env}, params...)
+	}
 	f := NewFunc(name, sig.RetType, params...)
+	f.Sig.Variadic = sig.Variadic
+	f.env = env
+	f.captures = captures
+	m.setIdentValue(ident, f.Func)
 	if !astutil.IsDef(n) {
 		dbg.Printf("create function declaration: %v", n)
 		// Emit function declaration.
 		m.emitFunc(f)
 		return
 	}
-	m.setIdentValue(ident, f.Func)
 
 	// Generate function body.
 	dbg.Printf("create function definition: %v", n)
+	f.hasLabels = len(m.info.Scopes[n].Labels) > 0
 	m.funcBody(f, n.FuncType.Params, n.Body)
 }
 
@@ -94,7 +117,11 @@ func (m *Module) funcBody(f *Func, params []*ast.VarDecl, body *ast.BlockStmt) {
 	// approach which only needs one of these two.
 
 	// Emit local variable declarations for function parameters.
-	for i, param := range f.Params {
+	irParams := f.Params
+	if f.env != nil {
+		irParams = irParams[1:]
+	}
+	for i, param := range irParams {
 		p := m.funcParam(f, param)
 		// Add mapping from parameter name to the corresponding allocated local
 		// variable; i.e.
@@ -121,9 +148,27 @@ func (m *Module) funcBody(f *Func, params []*ast.VarDecl, body *ast.BlockStmt) {
 		f.setIdentValue(ident, p)
 	}
 
+	// Map captured variables of nested functions to their addresses, as loaded
+	// from the environment.
+	if f.env != nil {
+		f.env.SetName(f.genUnique(&ast.Ident{Name: "env"}))
+		m.loadEnv(f)
+	}
+
 	// Generate function body.
 	m.stmt(f, body)
 
+	// Resolve the forward-referenced target basic blocks of goto statements.
+	for name, terms := range f.gotos {
+		target, ok := f.labels[name]
+		if !ok {
+			panic(fmt.Sprintf("unable to locate target basic block of label %q", name))
+		}
+		for _, term := range terms {
+			term.Target = target.Block
+		}
+	}
+
 	// Finalize function body.
 	if err := f.endBody(); err != nil {
 		panic(fmt.Sprintf("unable to finalize function body; %v", err))
@@ -157,17 +202,13 @@ func (m *Module) globalVarDecl(n *ast.VarDecl) {
 	//    @x = global i32 0
 	ident := n.Name()
 	dbg.Printf("create global variable: %v", n)
-	typ := toIrType(n.Type())
+	typ := m.toIrType(n.Type())
 	var val constant.Constant
 	switch {
 	case n.Val != nil:
-		panic("support for global variable initializer not yet implemented")
+		val = m.constInit(n.Type(), n.Val)
 	default:
-		if intType, ok := typ.(*irtypes.IntType); ok {
-			val = constant.NewInt(intType, 0)
-		} else {
-			val = constant.NewZeroInitializer(typ)
-		}
+		val = zeroValue(typ)
 	}
 	global := ir.NewGlobalDef(ident.Name, val)
 	m.setIdentValue(ident, global)
@@ -175,11 +216,98 @@ func (m *Module) globalVarDecl(n *ast.VarDecl) {
 	m.emitGlobal(global)
 }
 
+// constInit lowers the given initializer of a global variable of type typ to
+// an LLVM IR constant. Elements without explicit initializer are initialized to
+// zero.
+func (m *Module) constInit(typ uctypes.Type, val ast.Expr) constant.Constant {
+	// Input:
+	//    int a[4] = {1, 2};
+	// Output:
+	//    @a = global [4 x i32] [i32 1, i32 2, i32 0, i32 0]
+	irType := m.toIrType(typ)
+	list, isList := val.(*ast.InitList)
+	switch typ := typ.(type) {
+	case *uctypes.Array:
+		if !isList {
+			// Character array initialized by string literal. The remaining
+			// elements are padded with null characters.
+			buf := make([]byte, typ.Len)
+			copy(buf, stringValue(val))
+			return constant.NewCharArray(buf)
+		}
+		elems := make([]constant.Constant, typ.Len)
+		for i := range elems {
+			if i < len(list.Elems) {
+				elems[i] = m.constInit(typ.Elem, list.Elems[i])
+			} else {
+				elems[i] = zeroValue(m.toIrType(typ.Elem))
+			}
+		}
+		return constant.NewArray(irType.(*irtypes.ArrayType), elems...)
+	case *uctypes.Struct:
+		if isList {
+			fields := make([]constant.Constant, len(typ.Fields))
+			for i := range fields {
+				if i < len(list.Elems) {
+					fields[i] = m.constInit(typ.Fields[i].Type, list.Elems[i])
+				} else {
+					fields[i] = zeroValue(m.toIrType(typ.Fields[i].Type))
+				}
+			}
+			return constant.NewStruct(irType.(*irtypes.StructType), fields...)
+		}
+	default:
+		if isList {
+			return m.constInit(typ, list.Elems[0])
+		}
+	}
+	return m.constExpr(irType, val)
+}
+
+// constExpr lowers the given constant expression to an LLVM IR constant of the
+// given type.
+func (m *Module) constExpr(typ irtypes.Type, n ast.Expr) constant.Constant {
+	if v, ok := m.info.Values[n]; ok {
+		return constConvert(constant.NewInt(irtypes.I64, int64(v)), typ)
+	}
+	switch n := n.(type) {
+	case *ast.BasicLit:
+		if n.Kind == token.StringLit {
+			c, ok := m.stringLit(n).(constant.Constant)
+			if !ok {
+				panic(fmt.Sprintf("invalid string literal value type; expected constant.Constant, got %T", c))
+			}
+			return constConvert(c, typ)
+		}
+	case *ast.ParenExpr:
+		return m.constExpr(typ, n.X)
+	}
+	panic(fmt.Sprintf("support for constant expression %v of type %T not yet implemented", n, n))
+}
+
+// constValue returns the LLVM IR constant of the folded value of the given
+// expression, and a boolean indicating whether the expression is an integer
+// constant expression.
+func (m *Module) constValue(n ast.Expr) (*constant.Int, bool) {
+	v, ok := m.info.Values[n]
+	if !ok {
+		return nil, false
+	}
+	typ, ok := m.typeOf(n).(*irtypes.IntType)
+	if !ok {
+		panic(fmt.Sprintf("invalid integer constant expression type; expected *types.IntType, got %T", m.typeOf(n)))
+	}
+	// Values of unsigned type are represented in two's complement form.
+	return constConvert(constant.NewInt(irtypes.I64, int64(v)), typ).(*constant.Int), true
+}
+
 // --- [ Type definition ] -----------------------------------------------------
 
 // typeDef lowers the given type definition to LLVM IR, emitting code to m.
 func (m *Module) typeDef(def *ast.TypeDef) {
-	panic("not yet implemented")
+	// Type definitions are resolved during semantic analysis, and the named
+	// structure types they refer to are emitted when used. Thus, no code is
+	// emitted for type definitions.
 }
 
 // === [ Function scope ] ======================================================
@@ -197,35 +325,158 @@ func (m *Module) localVarDef(f *Func, n *ast.VarDecl) {
 	//    %a = alloca i32
 	ident := n.Name()
 	dbg.Printf("create local variable: %v", n)
-	typ := toIrType(n.Type())
-	allocaInst := f.curBlock.NewAlloca(typ)
+	typ := m.toIrType(n.Type())
+	block := f.curBlock
+	if block == nil || f.hasLabels {
+		// Declarations following a terminator (e.g. preceding the first case
+		// label of a switch statement) are unreachable, yet their variables may
+		// be used by subsequent code. Allocate such variables in the entry basic
+		// block; their initializers are never executed.
+		block = f.entry
+	}
+	allocaInst := block.NewAlloca(typ)
 	// Emit local variable definition.
-	f.emitLocal(ident, allocaInst)
-	if n.Val != nil {
-		panic("support for local variable definition initializer not yet implemented")
+	block.emitLocal(ident, allocaInst)
+	if n.Val != nil && f.curBlock != nil {
+		typ := n.Type()
+		if !isCompleteInit(typ, n.Val) {
+			// Initialize elements without explicit initializer to zero.
+			m.memsetZero(f, allocaInst)
+		}
+		m.localInit(f, allocaInst, typ, n.Val)
+	}
+}
+
+// localInit lowers the given initializer of the local variable at addr of type
+// typ to LLVM IR, emitting code to f.
+func (m *Module) localInit(f *Func, addr value.Value, typ uctypes.Type, val ast.Expr) {
+	// Input:
+	//    void f() {
+	//       int a[2] = {1, 2};   // <-- relevant line
+	//    }
+	// Output:
+	//    %a = alloca [2 x i32]
+	//    %1 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 0
+	//    store i32 1, i32* %1
+	//    %2 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 1
+	//    store i32 2, i32* %2
+	list, isList := val.(*ast.InitList)
+	addrElemType := addr.Type().(*irtypes.PointerType).ElemType
+	switch typ := typ.(type) {
+	case *uctypes.Array:
+		if !isList {
+			// Character array initialized by string literal. The remaining
+			// elements are padded with null characters.
+			buf := make([]byte, typ.Len)
+			copy(buf, stringValue(val))
+			f.curBlock.NewStore(constant.NewCharArray(buf), addr)
+			return
+		}
+		zero := constZero(irtypes.I64)
+		for i, elem := range list.Elems {
+			index := constant.NewInt(irtypes.I64, int64(i))
+			elemAddr := f.curBlock.NewGetElementPtr(addrElemType, addr, zero, index)
+			m.localInit(f, elemAddr, typ.Elem, elem)
+		}
+		return
+	case *uctypes.Struct:
+		if isList {
+			zero := constZero(irtypes.I32)
+			for i, elem := range list.Elems {
+				index := constant.NewInt(irtypes.I32, int64(i))
+				fieldAddr := f.curBlock.NewGetElementPtr(addrElemType, addr, zero, index)
+				m.localInit(f, fieldAddr, typ.Fields[i].Type, elem)
+			}
+			return
+		}
+	default:
+		if isList {
+			m.localInit(f, addr, typ, list.Elems[0])
+			return
+		}
 	}
+	m.store(f, addr, m.exprAs(f, val, m.toIrType(typ)))
+}
+
+// memsetZero sets the memory of the object at addr to zero, emitting code to
+// f.
+func (m *Module) memsetZero(f *Func, addr value.Value) {
+	// Input:
+	//    void f() {
+	//       int a[4] = {1};      // <-- relevant line
+	//    }
+	// Output:
+	//    %a = alloca [4 x i32]
+	//    %1 = bitcast [4 x i32]* %a to i8*
+	//    call void @llvm.memset.p0i8.i64(i8* %1, i8 0, i64 ptrtoint ([4 x i32]* getelementptr ([4 x i32], [4 x i32]* null, i64 1) to i64), i1 false)
+	if m.memset == nil {
+		dst := ir.NewParam("", irtypes.I8Ptr)
+		val := ir.NewParam("", irtypes.I8)
+		len := ir.NewParam("", irtypes.I64)
+		isVolatile := ir.NewParam("", irtypes.I1)
+		m.memset = m.NewFunc("llvm.memset.p0i8.i64", irtypes.Void, dst, val, len, isVolatile)
+	}
+	addrType := addr.Type().(*irtypes.PointerType)
+	dst := f.curBlock.NewBitCast(addr, irtypes.I8Ptr)
+	f.curBlock.NewCall(m.memset, dst, constZero(irtypes.I8), sizeOf(addrType.ElemType), constant.False)
 }
 
 // --- [ Statements ] ----------------------------------------------------------
 
 // stmt lowers the given statement to LLVM IR, emitting code to f.
 func (m *Module) stmt(f *Func, stmt ast.Stmt) {
+	switch stmt.(type) {
+	case *ast.BlockStmt, *ast.CaseStmt, *ast.EmptyStmt, *ast.LabeledStmt:
+		// Block statements and labeled statements start basic blocks as needed.
+	default:
+		if f.curBlock == nil {
+			// Statements following a terminator (e.g. the statements between a
+			// break statement and the next case label of a switch statement)
+			// are unreachable, and are lowered to a new basic block without
+			// predecessors.
+			f.curBlock = f.NewBlock("")
+		}
+	}
 	switch stmt := stmt.(type) {
 	case *ast.BlockStmt:
 		m.blockStmt(f, stmt)
 		return
+	case *ast.BreakStmt:
+		m.breakStmt(f, stmt)
+		return
+	case *ast.CaseStmt:
+		m.caseStmt(f, stmt)
+		return
+	case *ast.ContinueStmt:
+		m.continueStmt(f, stmt)
+		return
+	case *ast.DoWhileStmt:
+		m.doWhileStmt(f, stmt)
+		return
 	case *ast.EmptyStmt:
 		// nothing to do.
 		return
 	case *ast.ExprStmt:
 		m.exprStmt(f, stmt)
 		return
+	case *ast.ForStmt:
+		m.forStmt(f, stmt)
+		return
+	case *ast.GotoStmt:
+		m.gotoStmt(f, stmt)
+		return
 	case *ast.IfStmt:
 		m.ifStmt(f, stmt)
 		return
+	case *ast.LabeledStmt:
+		m.labeledStmt(f, stmt)
+		return
 	case *ast.ReturnStmt:
 		m.returnStmt(f, stmt)
 		return
+	case *ast.SwitchStmt:
+		m.switchStmt(f, stmt)
+		return
 	case *ast.WhileStmt:
 		m.whileStmt(f, stmt)
 		return
@@ -241,11 +492,15 @@ func (m *Module) blockStmt(f *Func, stmt *ast.BlockStmt) {
 		case ast.Decl:
 			switch decl := item.(type) {
 			case *ast.FuncDecl:
-				panic(fmt.Sprintf("support for nested function declarations not yet implemented: %v", decl))
+				m.nestedFuncDecl(f, decl)
 			case *ast.VarDecl:
+				if decl.Name() == nil {
+					// Structure or enumeration declaration without declarator.
+					continue
+				}
 				m.localVarDef(f, decl)
 			case *ast.TypeDef:
-				panic(fmt.Sprintf("support for scoped type definitions not yet implemented: %v", decl))
+				m.typeDef(decl)
 			}
 		case ast.Stmt:
 			m.stmt(f, item)
@@ -253,12 +508,116 @@ func (m *Module) blockStmt(f *Func, stmt *ast.BlockStmt) {
 	}
 }
 
+// breakStmt lowers the given break statement to LLVM IR, emitting code to f.
+func (m *Module) breakStmt(f *Func, stmt *ast.BreakStmt) {
+	termBr := ir.NewBr(f.breakTarget.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = nil
+}
+
+// caseStmt lowers the given case statement to LLVM IR, emitting code to f.
+func (m *Module) caseStmt(f *Func, stmt *ast.CaseStmt) {
+	target := f.caseTargets[stmt]
+	// Fall through from the preceding case, if the current basic block doesn't
+	// end with a branching statement (i.e. the current basic block is non-nil).
+	if f.curBlock != nil {
+		termBr := ir.NewBr(target.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = target
+	m.stmt(f, stmt.Body)
+}
+
+// continueStmt lowers the given continue statement to LLVM IR, emitting code to
+// f.
+func (m *Module) continueStmt(f *Func, stmt *ast.ContinueStmt) {
+	termBr := ir.NewBr(f.continueTarget.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = nil
+}
+
+// doWhileStmt lowers the given do-while statement to LLVM IR, emitting code to
+// f.
+func (m *Module) doWhileStmt(f *Func, stmt *ast.DoWhileStmt) {
+	bodyBranch := f.NewBlock("")
+	termBr := ir.NewBr(bodyBranch.Block)
+	f.curBlock.SetTerm(termBr)
+	condBranch := f.NewBlock("")
+	endBranch := f.NewBlock("")
+	f.curBlock = bodyBranch
+	m.loopBody(f, stmt.Body, endBranch, condBranch)
+	// Emit jump if body doesn't end with a branching statement (i.e. the
+	// current basic block is none nil).
+	if f.curBlock != nil {
+		termBr := ir.NewBr(condBranch.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = condBranch
+	cond := m.cond(f, stmt.Cond)
+	termCondBr := ir.NewCondBr(cond, bodyBranch.Block, endBranch.Block)
+	f.curBlock.SetTerm(termCondBr)
+	f.curBlock = endBranch
+}
+
 // exprStmt lowers the given expression statement to LLVM IR, emitting code to
 // f.
 func (m *Module) exprStmt(f *Func, stmt *ast.ExprStmt) {
 	m.expr(f, stmt.X)
 }
 
+// forStmt lowers the given for statement to LLVM IR, emitting code to f.
+func (m *Module) forStmt(f *Func, stmt *ast.ForStmt) {
+	if stmt.Init != nil {
+		m.expr(f, stmt.Init)
+	}
+	condBranch := f.NewBlock("")
+	termBr := ir.NewBr(condBranch.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = condBranch
+	bodyBranch := f.NewBlock("")
+	postBranch := f.NewBlock("")
+	endBranch := f.NewBlock("")
+	// A missing condition is replaced by a non-zero constant (§6.8.5.3).
+	if stmt.Cond != nil {
+		cond := m.cond(f, stmt.Cond)
+		termCondBr := ir.NewCondBr(cond, bodyBranch.Block, endBranch.Block)
+		f.curBlock.SetTerm(termCondBr)
+	} else {
+		termBr := ir.NewBr(bodyBranch.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = bodyBranch
+	m.loopBody(f, stmt.Body, endBranch, postBranch)
+	// Emit jump if body doesn't end with a branching statement (i.e. the
+	// current basic block is none nil).
+	if f.curBlock != nil {
+		termBr := ir.NewBr(postBranch.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = postBranch
+	if stmt.Post != nil {
+		m.expr(f, stmt.Post)
+	}
+	termBr = ir.NewBr(condBranch.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = endBranch
+}
+
+// gotoStmt lowers the given goto statement to LLVM IR, emitting code to f.
+func (m *Module) gotoStmt(f *Func, stmt *ast.GotoStmt) {
+	name := stmt.Label.Name
+	termBr := ir.NewBr(nil)
+	if target, ok := f.labels[name]; ok {
+		termBr.Target = target.Block
+	} else {
+		// Forward reference to label; the target basic block is resolved at the
+		// end of the function body.
+		f.gotos[name] = append(f.gotos[name], termBr)
+	}
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = nil
+}
+
 // ifStmt lowers the given if statement to LLVM IR, emitting code to f.
 func (m *Module) ifStmt(f *Func, stmt *ast.IfStmt) {
 	cond := m.cond(f, stmt.Cond)
@@ -291,6 +650,22 @@ func (m *Module) ifStmt(f *Func, stmt *ast.IfStmt) {
 	f.curBlock = end
 }
 
+// labeledStmt lowers the given labeled statement to LLVM IR, emitting code to
+// f.
+func (m *Module) labeledStmt(f *Func, stmt *ast.LabeledStmt) {
+	target := f.NewBlock("")
+	f.labels[stmt.Label.Name] = target
+	// Fall through from the preceding statement, if the current basic block
+	// doesn't end with a branching statement (i.e. the current basic block is
+	// non-nil).
+	if f.curBlock != nil {
+		termBr := ir.NewBr(target.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = target
+	m.stmt(f, stmt.Stmt)
+}
+
 // returnStmt lowers the given return statement to LLVM IR, emitting code to f.
 func (m *Module) returnStmt(f *Func, stmt *ast.ReturnStmt) {
 	// Input:
@@ -305,15 +680,53 @@ func (m *Module) returnStmt(f *Func, stmt *ast.ReturnStmt) {
 		f.curBlock = nil
 		return
 	}
-	result := m.expr(f, stmt.Result)
 	// Implicit conversion.
-	resultType := f.Sig.RetType
-	result = m.convert(f, result, resultType)
+	result := m.exprAs(f, stmt.Result, f.Sig.RetType)
 	termRet := ir.NewRet(result)
 	f.curBlock.SetTerm(termRet)
 	f.curBlock = nil
 }
 
+// switchStmt lowers the given switch statement to LLVM IR, emitting code to f.
+func (m *Module) switchStmt(f *Func, stmt *ast.SwitchStmt) {
+	// "The integer promotions are performed on the controlling expression. The
+	// constant expression in each case label is converted to the promoted type
+	// of the controlling expression." [C99 draft 6.8.4.2.5]
+	tagType := m.toIrType(uctypes.Promote(m.info.Types[stmt.Tag]))
+	tag := m.exprAs(f, stmt.Tag, tagType)
+	endBranch := f.NewBlock("")
+	// Control is transferred to the end of the switch statement if no case
+	// label matches and there is no default label.
+	defaultTarget := endBranch
+	var cases []*ir.Case
+	for _, c := range astutil.SwitchCases(stmt) {
+		target := f.NewBlock("")
+		f.caseTargets[c] = target
+		if c.Val == nil {
+			defaultTarget = target
+			continue
+		}
+		// Values of unsigned type are represented in two's complement form.
+		v := m.info.Values[c.Val]
+		x := constConvert(constant.NewInt(irtypes.I64, int64(v)), tagType).(*constant.Int)
+		cases = append(cases, ir.NewCase(x, target.Block))
+	}
+	termSwitch := ir.NewSwitch(tag, defaultTarget.Block, cases...)
+	f.curBlock.SetTerm(termSwitch)
+	f.curBlock = nil
+	breakTarget := f.breakTarget
+	f.breakTarget = endBranch
+	m.stmt(f, stmt.Body)
+	f.breakTarget = breakTarget
+	// Emit jump if body doesn't end with a branching statement (i.e. the
+	// current basic block is none nil).
+	if f.curBlock != nil {
+		termBr := ir.NewBr(endBranch.Block)
+		f.curBlock.SetTerm(termBr)
+	}
+	f.curBlock = endBranch
+}
+
 // whileStmt lowers the given while statement to LLVM IR, emitting code to f.
 func (m *Module) whileStmt(f *Func, stmt *ast.WhileStmt) {
 	condBranch := f.NewBlock("")
@@ -326,9 +739,9 @@ func (m *Module) whileStmt(f *Func, stmt *ast.WhileStmt) {
 	termCondBr := ir.NewCondBr(cond, bodyBranch.Block, endBranch.Block)
 	f.curBlock.SetTerm(termCondBr)
 	f.curBlock = bodyBranch
-	m.stmt(f, stmt.Body)
-	// Emit jump if body doesn't end with return statement (i.e. the current
-	// basic block is none nil).
+	m.loopBody(f, stmt.Body, endBranch, condBranch)
+	// Emit jump if body doesn't end with a branching statement (i.e. the
+	// current basic block is none nil).
 	if f.curBlock != nil {
 		termBr := ir.NewBr(condBranch.Block)
 		f.curBlock.SetTerm(termBr)
@@ -336,6 +749,16 @@ func (m *Module) whileStmt(f *Func, stmt *ast.WhileStmt) {
 	f.curBlock = endBranch
 }
 
+// loopBody lowers the given loop body to LLVM IR, emitting code to f. Break
+// and continue statements of the loop body branch to the given loop exit and
+// continue basic blocks, respectively.
+func (m *Module) loopBody(f *Func, body ast.Stmt, exit, cont *Block) {
+	breakTarget, continueTarget := f.breakTarget, f.continueTarget
+	f.breakTarget, f.continueTarget = exit, cont
+	m.stmt(f, body)
+	f.breakTarget, f.continueTarget = breakTarget, continueTarget
+}
+
 // --- [ Expressions ] ----------------------------------------------------------
 
 // cond lowers the given condition expression to LLVM IR, emitting code to f.
@@ -344,6 +767,13 @@ func (m *Module) cond(f *Func, expr ast.Expr) value.Value {
 	if cond.Type().Equal(irtypes.I1) {
 		return cond
 	}
+	if condType, ok := cond.Type().(*irtypes.PointerType); ok {
+		// Create boolean expression of pointer.
+		//
+		//    cond != null
+		null := constant.NewNull(condType)
+		return f.curBlock.NewICmp(enum.IPredNE, cond, null)
+	}
 	// Create boolean expression if cond is not already of boolean type.
 	//
 	//    cond != 0
@@ -354,6 +784,10 @@ func (m *Module) cond(f *Func, expr ast.Expr) value.Value {
 
 // expr lowers the given expression to LLVM IR, emitting code to f.
 func (m *Module) expr(f *Func, expr ast.Expr) value.Value {
+	// Emit the folded value of integer constant expressions.
+	if c, ok := m.constValue(expr); ok {
+		return c
+	}
 	switch expr := expr.(type) {
 	case *ast.BasicLit:
 		return m.basicLit(f, expr)
@@ -361,12 +795,20 @@ func (m *Module) expr(f *Func, expr ast.Expr) value.Value {
 		return m.binaryExpr(f, expr)
 	case *ast.CallExpr:
 		return m.callExpr(f, expr)
+	case *ast.CastExpr:
+		return m.castExpr(f, expr)
+	case *ast.CondExpr:
+		return m.condExpr(f, expr)
 	case *ast.Ident:
 		return m.identUse(f, expr)
 	case *ast.IndexExpr:
 		return m.indexExprUse(f, expr)
 	case *ast.ParenExpr:
 		return m.expr(f, expr.X)
+	case *ast.PostfixExpr:
+		return m.postfixExpr(f, expr)
+	case *ast.SelectorExpr:
+		return m.selectorExprUse(f, expr)
 	case *ast.UnaryExpr:
 		return m.unaryExpr(f, expr)
 	default:
@@ -379,7 +821,7 @@ func (m *Module) basicLit(f *Func, n *ast.BasicLit) value.Value {
 	typ := m.typeOf(n)
 	switch n.Kind {
 	case token.CharLit:
-		s, err := strconv.Unquote(n.Val)
+		r, err := util.CharValue([]byte(n.Val))
 		if err != nil {
 			panic(fmt.Sprintf("unable to unquote character literal; %v", err))
 		}
@@ -387,85 +829,93 @@ func (m *Module) basicLit(f *Func, n *ast.BasicLit) value.Value {
 		if !ok {
 			panic(fmt.Errorf("invalid character literal type; expected *types.IntType, got %T", typ))
 		}
-		return constant.NewInt(intType, int64(s[0]))
+		// Character literals of type char are signed; e.g. '\xFF' has the value
+		// -1.
+		return constant.NewInt(intType, int64(int8(r)))
 	case token.IntLit:
 		intType, ok := typ.(*irtypes.IntType)
 		if !ok {
 			panic(fmt.Errorf("invalid integer literal type; expected *types.IntType, got %T", typ))
 		}
-		c, err := constant.NewIntFromString(intType, n.Val)
+		x, err := util.UintValue([]byte(n.Val))
 		if err != nil {
 			panic(fmt.Errorf("unable to parse integer literal %q; %v", n.Val, err))
 		}
-		return c
+		return constant.NewInt(intType, int64(x))
+	case token.StringLit:
+		return m.stringLit(n)
 	default:
 		panic(fmt.Sprintf("support for basic literal kind %v not yet implemented", n.Kind))
 	}
 }
 
+// stringLit lowers the given string literal to LLVM IR, emitting a global
+// constant array to m. The returned value is a pointer to the first character
+// of the string, in accordance with the existing handling of global arrays in
+// Module.ident.
+func (m *Module) stringLit(n *ast.BasicLit) value.Value {
+	// Input:
+	//    "foo"
+	// Output:
+	//    @.str = private unnamed_addr constant [4 x i8] c"foo\00"
+	//
+	//    getelementptr ([4 x i8], [4 x i8]* @.str, i64 0, i64 0)
+	s := stringValue(n)
+	// Include the terminating null character.
+	init := constant.NewCharArray(append(s, 0))
+	// In accordance with Clang, name string literals .str, .str.1, .str.2, ...
+	name := ".str"
+	if m.strs > 0 {
+		name = fmt.Sprintf(".str.%d", m.strs)
+	}
+	m.strs++
+	global := ir.NewGlobalDef(name, init)
+	global.Immutable = true
+	global.Linkage = enum.LinkagePrivate
+	global.UnnamedAddr = enum.UnnamedAddrUnnamedAddr
+	// Emit global variable definition.
+	m.emitGlobal(global)
+	zero := constZero(irtypes.I64)
+	return constant.NewGetElementPtr(init.Typ, global, zero, zero)
+}
+
 // binaryExpr lowers the given binary expression to LLVM IR, emitting code to f.
 func (m *Module) binaryExpr(f *Func, n *ast.BinaryExpr) value.Value {
 	switch n.Op {
-	// +
-	case token.Add:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewAdd(x, y)
-
-	// -
-	case token.Sub:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewSub(x, y)
-
-	// *
-	case token.Mul:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewMul(x, y)
-
-	// /
-	case token.Div:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		// TODO: Add support for unsigned division.
-		return f.curBlock.NewSDiv(x, y)
-
-	// <
-	case token.Lt:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredSLT, x, y)
-
-	// >
-	case token.Gt:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredSGT, x, y)
-
-	// <=
-	case token.Le:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredSLE, x, y)
-
-	// >=
-	case token.Ge:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredSGE, x, y)
+	// +, -, *, /, %, &, |, ^
+	case token.Add, token.Sub, token.Mul, token.Div, token.Rem, token.And, token.Or, token.Xor:
+		typ := m.info.Types[n]
+		if !uctypes.IsInteger(typ) || m.isPointer(n.X) || m.isPointer(n.Y) {
+			// Pointer arithmetic; the difference of two pointers is of integer
+			// type.
+			x, y := m.ptrOperands(f, n.X, n.Y)
+			return m.binaryOp(f, n.Op, x, y, false)
+		}
+		// The operands are converted to their common type, as determined by the
+		// usual arithmetic conversions.
+		x, y := m.operands(f, n.X, n.Y, m.typeOf(n))
+		return m.binaryOp(f, n.Op, x, y, uctypes.IsUnsigned(typ))
 
-	// !=
-	case token.Ne:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredNE, x, y)
+	// <<, >>
+	case token.Shl, token.Shr:
+		// The operands are converted to the type of the promoted left operand.
+		x, y := m.operands(f, n.X, n.Y, m.typeOf(n))
+		return m.binaryOp(f, n.Op, x, y, uctypes.IsUnsigned(m.info.Types[n]))
 
-	// ==
-	case token.Eq:
+	// <, >, <=, >=, ==, !=
+	case token.Lt, token.Gt, token.Le, token.Ge, token.Eq, token.Ne:
+		xType, yType := m.info.Types[n.X], m.info.Types[n.Y]
+		if uctypes.IsInteger(xType) && uctypes.IsInteger(yType) {
+			// The operands are converted to their common type, as determined by
+			// the usual arithmetic conversions.
+			typ := uctypes.ArithmeticConversion(xType, yType)
+			x, y := m.operands(f, n.X, n.Y, m.toIrType(typ))
+			return f.curBlock.NewICmp(relPred(n.Op, uctypes.IsUnsigned(typ)), x, y)
+		}
+		// Pointers are compared as unsigned integers.
 		x, y := m.expr(f, n.X), m.expr(f, n.Y)
 		x, y = m.implicitConversion(f, x, y)
-		return f.curBlock.NewICmp(enum.IPredEQ, x, y)
+		return f.curBlock.NewICmp(relPred(n.Op, true), x, y)
 
 	// &&
 	case token.Land:
@@ -479,39 +929,270 @@ func (m *Module) binaryExpr(f *Func, n *ast.BinaryExpr) value.Value {
 		f.curBlock = trueBranch
 
 		y := m.cond(f, n.Y)
+		// The evaluation of y may have added new basic blocks (e.g. nested
+		// logical expressions), so the incoming block is the current block.
+		yEnd := f.curBlock
 		termBr := ir.NewBr(end.Block)
-		trueBranch.SetTerm(termBr)
+		f.curBlock.SetTerm(termBr)
 		f.curBlock = end
 
 		var incs []*ir.Incoming
 		zero := constZero(irtypes.I1)
 		inc := ir.NewIncoming(zero, start.Block)
 		incs = append(incs, inc)
-		inc = ir.NewIncoming(y, trueBranch.Block)
+		inc = ir.NewIncoming(y, yEnd.Block)
+		incs = append(incs, inc)
+		return f.curBlock.NewPhi(incs...)
+
+	// ||
+	case token.Lor:
+		x := m.cond(f, n.X)
+
+		start := f.curBlock
+		falseBranch := f.NewBlock("")
+		end := f.NewBlock("")
+		termCondBr := ir.NewCondBr(x, end.Block, falseBranch.Block)
+		f.curBlock.SetTerm(termCondBr)
+		f.curBlock = falseBranch
+
+		y := m.cond(f, n.Y)
+		yEnd := f.curBlock
+		termBr := ir.NewBr(end.Block)
+		f.curBlock.SetTerm(termBr)
+		f.curBlock = end
+
+		var incs []*ir.Incoming
+		one := constOne(irtypes.I1)
+		inc := ir.NewIncoming(one, start.Block)
+		incs = append(incs, inc)
+		inc = ir.NewIncoming(y, yEnd.Block)
 		incs = append(incs, inc)
 		return f.curBlock.NewPhi(incs...)
 
 	// =
 	case token.Assign:
-		y := m.expr(f, n.Y)
+		y := m.exprAs(f, n.Y, m.typeOf(n.X))
 		switch expr := n.X.(type) {
 		case *ast.Ident:
 			m.identDef(f, expr, y)
 		case *ast.IndexExpr:
 			m.indexExprDef(f, expr, y)
+		case *ast.ParenExpr, *ast.SelectorExpr, *ast.UnaryExpr:
+			m.store(f, m.lvalue(f, expr), y)
 		default:
 			panic(fmt.Sprintf("support for assignment to type %T not yet implemented", expr))
 		}
 		return y
 
+	// +=, -=, *=, /=, %=, <<=, >>=, &=, ^=, |=
+	case token.AddAssign, token.SubAssign, token.MulAssign, token.DivAssign, token.RemAssign, token.ShlAssign, token.ShrAssign, token.AndAssign, token.XorAssign, token.OrAssign:
+		// The lvalue is evaluated only once, as in:
+		//
+		//    addr = &x
+		//    *addr = *addr op y
+		op, _ := n.Op.CompoundOp()
+		xType := m.info.Types[n.X]
+		y := m.expr(f, n.Y)
+		addr := m.lvalue(f, n.X)
+		x := m.load(f, addr)
+		if uctypes.IsPointer(xType) {
+			y = m.convertExpr(f, n.Y, y, irtypes.I64)
+			return m.store(f, addr, m.binaryOp(f, op, x, y, false))
+		}
+		// The operation is performed in the common type of the operands, and
+		// the result is converted back to the type of the left operand.
+		typ := uctypes.ArithmeticConversion(xType, m.info.Types[n.Y])
+		if op == token.Shl || op == token.Shr {
+			typ = uctypes.Promote(xType)
+		}
+		x = m.convertFrom(f, x, xType, m.toIrType(typ))
+		y = m.convertExpr(f, n.Y, y, m.toIrType(typ))
+		v := m.binaryOp(f, op, x, y, uctypes.IsUnsigned(typ))
+		return m.store(f, addr, v)
+
 	default:
 		panic(fmt.Sprintf("support for binary operator %v not yet implemented", n.Op))
 	}
 }
 
+// binaryOp lowers the binary operation x op y of the given arithmetic, bitwise
+// or shift operator to LLVM IR, emitting code to f. Integer operands are
+// treated as unsigned if unsigned is true.
+func (m *Module) binaryOp(f *Func, op token.Kind, x, y value.Value, unsigned bool) value.Value {
+	switch op {
+	// <<
+	case token.Shl:
+		// The right operand is converted to the type of the left operand.
+		y = m.convert(f, y, x.Type())
+		return f.curBlock.NewShl(x, y)
+
+	// >>
+	case token.Shr:
+		// The right operand is converted to the type of the left operand.
+		y = m.convert(f, y, x.Type())
+		if unsigned {
+			return f.curBlock.NewLShr(x, y)
+		}
+		return f.curBlock.NewAShr(x, y)
+	}
+
+	// Pointer arithmetic.
+	if _, ok := y.Type().(*irtypes.PointerType); ok && op == token.Add {
+		// int + ptr is lowered as ptr + int.
+		x, y = y, x
+	}
+	if xType, ok := x.Type().(*irtypes.PointerType); ok {
+		return m.pointerOp(f, op, x, xType, y)
+	}
+
+	x, y = m.implicitConversion(f, x, y)
+	switch op {
+	// +
+	case token.Add:
+		return f.curBlock.NewAdd(x, y)
+
+	// -
+	case token.Sub:
+		return f.curBlock.NewSub(x, y)
+
+	// *
+	case token.Mul:
+		return f.curBlock.NewMul(x, y)
+
+	// /
+	case token.Div:
+		if unsigned {
+			return f.curBlock.NewUDiv(x, y)
+		}
+		return f.curBlock.NewSDiv(x, y)
+
+	// %
+	case token.Rem:
+		if unsigned {
+			return f.curBlock.NewURem(x, y)
+		}
+		return f.curBlock.NewSRem(x, y)
+
+	// &
+	case token.And:
+		return f.curBlock.NewAnd(x, y)
+
+	// |
+	case token.Or:
+		return f.curBlock.NewOr(x, y)
+
+	// ^
+	case token.Xor:
+		return f.curBlock.NewXor(x, y)
+
+	default:
+		panic(fmt.Sprintf("support for binary operator %v not yet implemented", op))
+	}
+}
+
+// pointerOp lowers the pointer arithmetic x op y to LLVM IR, emitting code to
+// f, where x is a pointer of type xType.
+func (m *Module) pointerOp(f *Func, op token.Kind, x value.Value, xType *irtypes.PointerType, y value.Value) value.Value {
+	// ptr - ptr
+	if yType, ok := y.Type().(*irtypes.PointerType); ok {
+		// Input:
+		//    int f(int *p, int *q) {
+		//       return p - q;    // <-- relevant line
+		//    }
+		// Output:
+		//    %3 = ptrtoint i32* %1 to i64
+		//    %4 = ptrtoint i32* %2 to i64
+		//    %5 = sub i64 %3, %4
+		//    %6 = sdiv exact i64 %5, 4
+		if op != token.Sub {
+			panic(fmt.Sprintf("support for binary operator %v on pointers %v and %v not yet implemented", op, xType, yType))
+		}
+		x := f.curBlock.NewPtrToInt(x, irtypes.I64)
+		y := f.curBlock.NewPtrToInt(y, irtypes.I64)
+		diff := f.curBlock.NewSub(x, y)
+		quo := f.curBlock.NewSDiv(diff, sizeOf(xType.ElemType))
+		quo.Exact = true
+		return quo
+	}
+
+	// ptr + int, ptr - int
+	//
+	// Input:
+	//    int *f(int *p, int i) {
+	//       return p + i;    // <-- relevant line
+	//    }
+	// Output:
+	//    %3 = sext i32 %2 to i64
+	//    %4 = getelementptr i32, i32* %1, i64 %3
+	index := m.convert(f, y, irtypes.I64)
+	switch op {
+	case token.Add:
+	case token.Sub:
+		if c, ok := index.(*constant.Int); ok {
+			index = constant.NewInt(irtypes.I64, -c.X.Int64())
+		} else {
+			zero := constZero(irtypes.I64)
+			index = f.curBlock.NewSub(zero, index)
+		}
+	default:
+		panic(fmt.Sprintf("support for binary operator %v on pointer %v not yet implemented", op, xType))
+	}
+	return f.curBlock.NewGetElementPtr(xType.ElemType, x, index)
+}
+
+// castExpr lowers the given cast expression to LLVM IR, emitting code to f.
+func (m *Module) castExpr(f *Func, n *ast.CastExpr) value.Value {
+	x := m.expr(f, n.X)
+	if uctypes.IsVoid(m.info.Types[n]) {
+		// The value of the operand is discarded.
+		return x
+	}
+	return m.convertExpr(f, n.X, x, m.typeOf(n))
+}
+
+// condExpr lowers the given conditional expression to LLVM IR, emitting code to
+// f.
+func (m *Module) condExpr(f *Func, n *ast.CondExpr) value.Value {
+	cond := m.cond(f, n.Cond)
+	typ := m.typeOf(n)
+
+	trueBranch := f.NewBlock("")
+	falseBranch := f.NewBlock("")
+	end := f.NewBlock("")
+	termCondBr := ir.NewCondBr(cond, trueBranch.Block, falseBranch.Block)
+	f.curBlock.SetTerm(termCondBr)
+	f.curBlock = trueBranch
+
+	// Both operands are converted to the type of the result.
+	x := m.exprAs(f, n.X, typ)
+	// The evaluation of x may have added new basic blocks (e.g. nested
+	// conditional expressions), so the incoming block is the current block.
+	xEnd := f.curBlock
+	termBr := ir.NewBr(end.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = falseBranch
+
+	y := m.exprAs(f, n.Y, typ)
+	yEnd := f.curBlock
+	termBr = ir.NewBr(end.Block)
+	f.curBlock.SetTerm(termBr)
+	f.curBlock = end
+
+	if uctypes.IsVoid(m.info.Types[n]) {
+		// The values of void operands are discarded.
+		return x
+	}
+	var incs []*ir.Incoming
+	inc := ir.NewIncoming(x, xEnd.Block)
+	incs = append(incs, inc)
+	inc = ir.NewIncoming(y, yEnd.Block)
+	incs = append(incs, inc)
+	return f.curBlock.NewPhi(incs...)
+}
+
 // callExpr lowers the given identifier to LLVM IR, emitting code to f.
 func (m *Module) callExpr(f *Func, callExpr *ast.CallExpr) value.Value {
-	typ := toIrType(callExpr.Name.Decl.Type())
+	typ := m.toIrType(callExpr.Name.Decl.Type())
 	sig, ok := typ.(*irtypes.FuncType)
 	if !ok {
 		panic(fmt.Sprintf("invalid function type; expected *types.FuncType, got %T", typ))
@@ -521,10 +1202,18 @@ func (m *Module) callExpr(f *Func, callExpr *ast.CallExpr) value.Value {
 	// TODO: Validate result against function return type.
 	_ = result
 	var args []value.Value
-	// TODO: Add support for variadic arguments.
 	for i, arg := range callExpr.Args {
-		expr := m.expr(f, arg)
-		expr = m.convert(f, expr, params[i])
+		var expr value.Value
+		switch argType := m.info.Types[arg]; {
+		case i < len(params):
+			expr = m.exprAs(f, arg, params[i])
+		case uctypes.IsInteger(argType):
+			// Default argument promotion of trailing arguments of variadic
+			// functions.
+			expr = m.exprAs(f, arg, m.toIrType(uctypes.Promote(argType)))
+		default:
+			expr = m.expr(f, arg)
+		}
 		args = append(args, expr)
 	}
 	v := m.valueFromIdent(f, callExpr.Name)
@@ -532,6 +1221,12 @@ func (m *Module) callExpr(f *Func, callExpr *ast.CallExpr) value.Value {
 	if !ok {
 		panic(fmt.Sprintf("invalid callee type; expected *ir.Func, got %T", v))
 	}
+	// Pass the environment of captured variables to nested functions.
+	if fn, ok := callExpr.Name.Decl.(*ast.FuncDecl); ok {
+		if captures := m.captures[fn]; len(captures) > 0 {
+			args = append([]value.Value{m.env(f, captures)}, args...)
+		}
+	}
 	return f.curBlock.NewCall(callee, args...)
 }
 
@@ -567,6 +1262,12 @@ func (m *Module) ident(f *Func, ident *ast.Ident) value.Value {
 		}
 		return f.curBlock.NewGetElementPtr(arrayElemType, array, indices...)
 	case *irtypes.PointerType:
+		if !m.isArray(ident) {
+			// Pointer variable.
+			return m.valueFromIdent(f, ident)
+		}
+		// Array parameter.
+		//
 		// Emit load instruction.
 		// TODO: Validate typ against srcAddr.Elem().
 		src := m.valueFromIdent(f, ident)
@@ -580,8 +1281,7 @@ func (m *Module) ident(f *Func, ident *ast.Ident) value.Value {
 // identUse lowers the given identifier usage to LLVM IR, emitting code to f.
 func (m *Module) identUse(f *Func, ident *ast.Ident) value.Value {
 	v := m.ident(f, ident)
-	typ := m.typeOf(ident)
-	if isRef(typ) {
+	if m.isArray(ident) {
 		return v
 	}
 	// TODO: Validate typ against v.Elem()
@@ -593,42 +1293,38 @@ func (m *Module) identUse(f *Func, ident *ast.Ident) value.Value {
 // f.
 func (m *Module) identDef(f *Func, ident *ast.Ident, v value.Value) {
 	addr := m.ident(f, ident)
-	addrType, ok := addr.Type().(*irtypes.PointerType)
-	if !ok {
-		panic(fmt.Sprintf("invalid pointer type; expected *types.PointerType, got %T", addr.Type()))
-	}
-	v = m.convert(f, v, addrType.ElemType)
-	f.curBlock.NewStore(v, addr)
+	m.store(f, addr, v)
 }
 
 // indexExpr lowers the given index expression to LLVM IR, emitting code to f.
+// The address of the indexed element is returned.
 func (m *Module) indexExpr(f *Func, n *ast.IndexExpr) value.Value {
-	index := m.expr(f, n.Index)
+	// Input:
+	//    void f() {
+	//       int a[2][3];
+	//       a[1][2];         // <-- relevant line
+	//    }
+	// Output:
+	//    %1 = getelementptr [2 x [3 x i32]], [2 x [3 x i32]]* %a, i64 0, i64 1
+	//    %2 = getelementptr [3 x i32], [3 x i32]* %1, i64 0, i64 2
 	// Extend the index to a 64-bit integer.
-	if !irtypes.Equal(index.Type(), irtypes.I64) {
-		index = m.convert(f, index, irtypes.I64)
-	}
-	typ := m.typeOf(n.Name)
-	array := m.valueFromIdent(f, n.Name)
-
-	// Dereference pointer pointer.
-	elem := typ
-	addr := array
-	zero := constZero(irtypes.I64)
-	indices := []value.Value{zero, index}
-	if typ, ok := typ.(*irtypes.PointerType); ok {
-		elem = typ.ElemType
-
-		// Emit load instruction.
-		// TODO: Validate typ against array.Elem().
-		arrayElemType := array.Type().(*irtypes.PointerType).ElemType
-		addr = f.curBlock.NewLoad(arrayElemType, array)
+	index := m.exprAs(f, n.Index, irtypes.I64)
+	var addr value.Value
+	var indices []value.Value
+	if _, ok := n.X.(*ast.BasicLit); !ok && m.isSizedArray(n.X) {
+		// Index into the array object.
+		addr = m.lvalue(f, n.X)
+		indices = []value.Value{constZero(irtypes.I64), index}
+	} else {
+		// Index relative to the pointer; unsized array parameters and string
+		// literals are lowered to pointers.
+		addr = m.expr(f, n.X)
 		indices = []value.Value{index}
 	}
 
 	// Emit getelementptr instruction.
 	addrElemType := addr.Type().(*irtypes.PointerType).ElemType
-	if m.isGlobal(n.Name) {
+	if addr, ok := addr.(constant.Constant); ok {
 		var is []constant.Constant
 		for _, index := range indices {
 			i, ok := index.(constant.Constant)
@@ -640,15 +1336,9 @@ func (m *Module) indexExpr(f *Func, n *ast.IndexExpr) value.Value {
 		if len(is) == len(indices) {
 			// In accordance with Clang, emit getelementptr constant expressions
 			// for global variables.
-			// TODO: Validate elem against addr.
-			_ = elem
-			if addr, ok := addr.(constant.Constant); ok {
-				return constant.NewGetElementPtr(addrElemType, addr, is...)
-			}
-			panic(fmt.Sprintf("invalid constant address type; expected constant.Constant, got %T", addr))
+			return constant.NewGetElementPtr(addrElemType, addr, is...)
 		}
 	}
-	// TODO: Validate elem against array.Elem().
 	return f.curBlock.NewGetElementPtr(addrElemType, addr, indices...)
 }
 
@@ -656,9 +1346,9 @@ func (m *Module) indexExpr(f *Func, n *ast.IndexExpr) value.Value {
 // code to f.
 func (m *Module) indexExprUse(f *Func, n *ast.IndexExpr) value.Value {
 	v := m.indexExpr(f, n)
-	typ := m.typeOf(n)
-	if isRef(typ) {
-		return v
+	if m.isArray(n) {
+		// Arrays decay to pointers to their first element.
+		return m.decay(f, v)
 	}
 	// TODO: Validate typ against v.Elem().
 	elemType := v.Type().(*irtypes.PointerType).ElemType
@@ -669,12 +1359,198 @@ func (m *Module) indexExprUse(f *Func, n *ast.IndexExpr) value.Value {
 // emitting code to f.
 func (m *Module) indexExprDef(f *Func, n *ast.IndexExpr, v value.Value) {
 	addr := m.indexExpr(f, n)
+	m.store(f, addr, v)
+}
+
+// selectorExpr lowers the given selector expression to LLVM IR, emitting code
+// to f. The address of the selected field is returned.
+func (m *Module) selectorExpr(f *Func, n *ast.SelectorExpr) value.Value {
+	// Input:
+	//    void f() {
+	//       struct point p;
+	//       p.y;             // <-- relevant line
+	//    }
+	// Output:
+	//    %1 = getelementptr %struct.point, %struct.point* %p, i32 0, i32 1
+	var addr value.Value
+	switch {
+	case n.Op == token.Arrow:
+		addr = m.expr(f, n.X)
+	case isLvalue(n.X):
+		addr = m.lvalue(f, n.X)
+	default:
+		// The structure operand is not an lvalue (e.g. the result of a function
+		// call); store the structure value in a temporary object, to access
+		// array fields through their address.
+		x := m.expr(f, n.X)
+		addr = f.entry.NewAlloca(x.Type())
+		f.curBlock.NewStore(x, addr)
+	}
+	index := m.fieldIndex(n)
+	elemType := addr.Type().(*irtypes.PointerType).ElemType
+	zero := constZero(irtypes.I32)
+	return f.curBlock.NewGetElementPtr(elemType, addr, zero, constant.NewInt(irtypes.I32, int64(index)))
+}
+
+// selectorExprUse lowers the given selector expression usage to LLVM IR,
+// emitting code to f.
+func (m *Module) selectorExprUse(f *Func, n *ast.SelectorExpr) value.Value {
+	if n.Op == token.Period && !isLvalue(n.X) && !m.isArray(n) {
+		// The structure operand is not an lvalue (e.g. the result of a function
+		// call, an assignment or a conditional expression); extract the field
+		// from the structure value.
+		x := m.expr(f, n.X)
+		return f.curBlock.NewExtractValue(x, uint64(m.fieldIndex(n)))
+	}
+	v := m.selectorExpr(f, n)
+	if m.isArray(n) {
+		// Arrays decay to pointers to their first element.
+		zero := constZero(irtypes.I64)
+		elemType := v.Type().(*irtypes.PointerType).ElemType
+		return f.curBlock.NewGetElementPtr(elemType, v, zero, zero)
+	}
+	return m.load(f, v)
+}
+
+// fieldIndex returns the index of the field selected by the given selector
+// expression.
+func (m *Module) fieldIndex(n *ast.SelectorExpr) int {
+	typ := m.info.Types[n.X]
+	if ptr, ok := typ.(*uctypes.Pointer); ok {
+		typ = ptr.Elem
+	}
+	structType, ok := typ.(*uctypes.Struct)
+	if !ok {
+		panic(fmt.Sprintf("invalid structure type; expected *types.Struct, got %T", typ))
+	}
+	_, index, ok := structType.Field(n.Sel.Name)
+	if !ok {
+		panic(fmt.Sprintf("unable to locate field %q of structure type %v", n.Sel, structType))
+	}
+	return index
+}
+
+// decay lowers the decay of the array at addr to LLVM IR, emitting code to f.
+// A pointer to the first element of the array is returned.
+func (m *Module) decay(f *Func, addr value.Value) value.Value {
+	zero := constZero(irtypes.I64)
+	elemType := addr.Type().(*irtypes.PointerType).ElemType
+	if addr, ok := addr.(constant.Constant); ok {
+		return constant.NewGetElementPtr(elemType, addr, zero, zero)
+	}
+	return f.curBlock.NewGetElementPtr(elemType, addr, zero, zero)
+}
+
+// lvalue lowers the given lvalue expression to LLVM IR, emitting code to f. The
+// address of the designated object is returned.
+func (m *Module) lvalue(f *Func, expr ast.Expr) value.Value {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if m.isArray(expr) {
+			// The address of an array is the address of the array variable, not
+			// the address of its first element.
+			return m.valueFromIdent(f, expr)
+		}
+		return m.ident(f, expr)
+	case *ast.IndexExpr:
+		return m.indexExpr(f, expr)
+	case *ast.ParenExpr:
+		return m.lvalue(f, expr.X)
+	case *ast.SelectorExpr:
+		return m.selectorExpr(f, expr)
+	case *ast.UnaryExpr:
+		if expr.Op == token.Mul {
+			// The address designated by *x is the value of x.
+			return m.expr(f, expr.X)
+		}
+		panic(fmt.Sprintf("support for lvalue of unary operator %v not yet implemented", expr.Op))
+	default:
+		panic(fmt.Sprintf("support for lvalue of type %T not yet implemented", expr))
+	}
+}
+
+// isLvalue reports whether the given expression is an lvalue, i.e. designates
+// an object.
+func isLvalue(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.Ident, *ast.IndexExpr:
+		return true
+	case *ast.ParenExpr:
+		return isLvalue(expr.X)
+	case *ast.SelectorExpr:
+		// The member of a structure designated by a pointer is an lvalue, and
+		// the member of a structure is an lvalue if the structure is.
+		return expr.Op == token.Arrow || isLvalue(expr.X)
+	case *ast.UnaryExpr:
+		return expr.Op == token.Mul
+	default:
+		return false
+	}
+}
+
+// load loads the value stored at the given address, emitting code to f.
+func (m *Module) load(f *Func, addr value.Value) value.Value {
+	addrType, ok := addr.Type().(*irtypes.PointerType)
+	if !ok {
+		panic(fmt.Sprintf("invalid pointer type; expected *types.PointerType, got %T", addr.Type()))
+	}
+	return f.curBlock.NewLoad(addrType.ElemType, addr)
+}
+
+// store stores the given value at the given address, emitting code to f. The
+// value is converted to the element type of the address, and the converted
+// value is returned.
+func (m *Module) store(f *Func, addr, v value.Value) value.Value {
 	addrType, ok := addr.Type().(*irtypes.PointerType)
 	if !ok {
 		panic(fmt.Sprintf("invalid pointer type; expected *types.PointerType, got %T", addr.Type()))
 	}
 	v = m.convert(f, v, addrType.ElemType)
 	f.curBlock.NewStore(v, addr)
+	return v
+}
+
+// postfixExpr lowers the given postfix expression to LLVM IR, emitting code to
+// f.
+func (m *Module) postfixExpr(f *Func, n *ast.PostfixExpr) value.Value {
+	// Input:
+	//    void f() {
+	//       int x;
+	//       x++;             // <-- relevant line
+	//    }
+	// Output:
+	//    %1 = load i32, i32* %x
+	//    %2 = add i32 %1, 1
+	//    store i32 %2, i32* %x
+	addr := m.lvalue(f, n.X)
+	old := m.load(f, addr)
+	m.incDec(f, n.Op, addr, old)
+	// The result of the postfix operator is the value of the operand before
+	// the increment or decrement.
+	return old
+}
+
+// incDec lowers the increment or decrement of the given value x, as stored at
+// addr, to LLVM IR, emitting code to f. The new value is stored at addr and
+// returned.
+func (m *Module) incDec(f *Func, op token.Kind, addr, x value.Value) value.Value {
+	if _, ok := x.Type().(*irtypes.PointerType); ok {
+		// Pointers are incremented and decremented by one element.
+		binOp := token.Add
+		if op == token.Dec {
+			binOp = token.Sub
+		}
+		return m.store(f, addr, m.binaryOp(f, binOp, x, constOne(irtypes.I64), false))
+	}
+	one := constOne(x.Type())
+	switch op {
+	case token.Inc:
+		return m.store(f, addr, f.curBlock.NewAdd(x, one))
+	case token.Dec:
+		return m.store(f, addr, f.curBlock.NewSub(x, one))
+	default:
+		panic(fmt.Sprintf("support for increment or decrement operator %v not yet implemented", op))
+	}
 }
 
 // unaryExpr lowers the given unary expression to LLVM IR, emitting code to f.
@@ -689,7 +1565,7 @@ func (m *Module) unaryExpr(f *Func, n *ast.UnaryExpr) value.Value {
 		//    }
 		// Output:
 		//    %2 = sub i32 0, %1
-		expr := m.expr(f, n.X)
+		expr := m.exprAs(f, n.X, m.typeOf(n))
 		zero := constZero(expr.Type())
 		return f.curBlock.NewSub(zero, expr)
 	// !expr
@@ -708,7 +1584,59 @@ func (m *Module) unaryExpr(f *Func, n *ast.UnaryExpr) value.Value {
 		cond := m.cond(f, n.X)
 		one := constOne(cond.Type())
 		notCond := f.curBlock.NewXor(cond, one)
-		return f.curBlock.NewZExt(notCond, m.typeOf(n.X))
+		return f.curBlock.NewZExt(notCond, m.typeOf(n))
+	// ++expr, --expr
+	case token.Inc, token.Dec:
+		// Input:
+		//    void f() {
+		//       int x;
+		//       ++x;             // <-- relevant line
+		//    }
+		// Output:
+		//    %1 = load i32, i32* %x
+		//    %2 = add i32 %1, 1
+		//    store i32 %2, i32* %x
+		addr := m.lvalue(f, n.X)
+		x := m.load(f, addr)
+		return m.incDec(f, n.Op, addr, x)
+	// ~expr
+	case token.Tilde:
+		// Input:
+		//    void f() {
+		//       int x;
+		//       ~x;              // <-- relevant line
+		//    }
+		// Output:
+		//    %2 = xor i32 %1, -1
+		expr := m.exprAs(f, n.X, m.typeOf(n))
+		allOnes := constant.NewInt(expr.Type().(*irtypes.IntType), -1)
+		return f.curBlock.NewXor(expr, allOnes)
+	// &expr
+	case token.And:
+		// Input:
+		//    void f() {
+		//       int x;
+		//       &x;              // <-- relevant line
+		//    }
+		// Output:
+		//    %x = alloca i32
+		return m.lvalue(f, n.X)
+	// *expr
+	case token.Mul:
+		// Input:
+		//    void f() {
+		//       int *p;
+		//       *p;              // <-- relevant line
+		//    }
+		// Output:
+		//    %1 = load i32*, i32** %p
+		//    %2 = load i32, i32* %1
+		addr := m.expr(f, n.X)
+		if m.isArray(n) {
+			// Arrays decay to pointers to their first element.
+			return m.decay(f, addr)
+		}
+		return m.load(f, addr)
 	default:
 		panic(fmt.Sprintf("support for unary operator %v not yet implemented", n.Op))
 	}
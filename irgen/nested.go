@@ -0,0 +1,222 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	irtypes "github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+)
+
+// Nested functions are lowered to module-level functions. The local variables
+// of enclosing functions which are captured by a nested function are passed by
+// address through an environment structure, which is given as an explicit
+// static chain parameter; i.e.
+//
+// For the following C code
+//
+//    int f(int x) {
+//       int g(int y) {
+//          return x + y;
+//       }
+//       return g(1);
+//    }
+//
+// with the following LLVM IR code
+//
+//    define i32 @f.g({ i32* }* nest %env, i32 %y) {
+//    0:
+//       ...
+//       %2 = getelementptr { i32* }, { i32* }* %env, i32 0, i32 0
+//       %x = load i32*, i32** %2
+//       ...
+//    }
+//
+//    define i32 @f(i32 %x) {
+//    0:
+//       %1 = alloca i32
+//       %2 = alloca { i32* }
+//       ...
+//       %3 = getelementptr { i32* }, { i32* }* %2, i32 0, i32 0
+//       store i32* %1, i32** %3
+//       %4 = call i32 @f.g({ i32* }* %2, i32 1)
+//       ...
+//    }
+
+// findCaptures returns a map from nested function definitions of the given
+// file to the local variables of enclosing functions captured by them, either
+// directly, by their own nested functions, or by the nested functions they
+// call.
+func findCaptures(file *ast.File) map[*ast.FuncDecl][]*ast.VarDecl {
+	// Maps from function definitions to their enclosing function definitions;
+	// or nil if declared at file scope.
+	parent := make(map[*ast.FuncDecl]*ast.FuncDecl)
+	// Maps from local variables to the function definitions declaring them.
+	owner := make(map[*ast.VarDecl]*ast.FuncDecl)
+	// Function definitions, in the order of appearance.
+	var funcs []*ast.FuncDecl
+	// Maps from function definitions to the functions called by them or by
+	// their nested functions.
+	calls := make(map[*ast.FuncDecl][]*ast.FuncDecl)
+	// Maps from function definitions to their captured variables, and the set
+	// thereof.
+	captures := make(map[*ast.FuncDecl][]*ast.VarDecl)
+	captured := make(map[*ast.FuncDecl]map[*ast.VarDecl]bool)
+	capture := func(fn *ast.FuncDecl, decl *ast.VarDecl) bool {
+		if captured[fn][decl] {
+			return false
+		}
+		if captured[fn] == nil {
+			captured[fn] = make(map[*ast.VarDecl]bool)
+		}
+		captured[fn][decl] = true
+		captures[fn] = append(captures[fn], decl)
+		return true
+	}
+	// encloses reports whether fn is a proper ancestor of the given function.
+	encloses := func(fn, nested *ast.FuncDecl) bool {
+		for p := parent[nested]; p != nil; p = parent[p] {
+			if p == fn {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Locate the captured variables of each function definition.
+	var stack []*ast.FuncDecl
+	before := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if astutil.IsDef(n) {
+				if len(stack) > 0 {
+					parent[n] = stack[len(stack)-1]
+				}
+				stack = append(stack, n)
+				funcs = append(funcs, n)
+			}
+		case *ast.VarDecl:
+			if len(stack) > 0 {
+				owner[n] = stack[len(stack)-1]
+			}
+		case *ast.Ident:
+			decl, ok := n.Decl.(*ast.VarDecl)
+			if !ok || owner[decl] == nil {
+				// Ignore global variables.
+				return nil
+			}
+			// The variable is captured by every function between the use and
+			// the function declaring the variable.
+			for i := len(stack) - 1; i >= 0 && stack[i] != owner[decl]; i-- {
+				capture(stack[i], decl)
+			}
+		case *ast.CallExpr:
+			if callee, ok := n.Name.Decl.(*ast.FuncDecl); ok {
+				for _, fn := range stack {
+					calls[fn] = append(calls[fn], callee)
+				}
+			}
+		}
+		return nil
+	}
+	after := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			stack = stack[:len(stack)-1]
+		}
+		return nil
+	}
+	if err := astutil.WalkBeforeAfter(file, before, after); err != nil {
+		panic(fmt.Sprintf("unable to locate captured variables; %v", err))
+	}
+
+	// Propagate the captured variables of called nested functions to the
+	// caller, as the caller provides their environment.
+	for changed := true; changed; {
+		changed = false
+		for _, fn := range funcs {
+			for _, callee := range calls[fn] {
+				for _, decl := range captures[callee] {
+					if encloses(owner[decl], fn) && capture(fn, decl) {
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	return captures
+}
+
+// nestedFuncDecl lowers the given nested function declaration within f to
+// LLVM IR, emitting code to m.
+func (m *Module) nestedFuncDecl(f *Func, n *ast.FuncDecl) {
+	if !astutil.IsDef(n) {
+		panic(fmt.Sprintf("support for local function declarations not yet implemented: %v", n))
+	}
+	// Name nested functions after their enclosing function; e.g. "main.f".
+	name := m.genUniqueFunc(fmt.Sprintf("%s.%s", f.Name(), n.Name()))
+	m.funcDecl(name, n)
+}
+
+// genUniqueFunc generates a unique function name based on the given name.
+func (m *Module) genUniqueFunc(name string) string {
+	if !m.funcNames[name] {
+		m.funcNames[name] = true
+		return name
+	}
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s.%d", name, i)
+		if !m.funcNames[name] {
+			m.funcNames[name] = true
+			return name
+		}
+	}
+}
+
+// envType returns the environment structure type of a nested function with the
+// given captured variables, holding the address of each variable.
+func (m *Module) envType(captures []*ast.VarDecl) *irtypes.StructType {
+	var fields []irtypes.Type
+	for _, decl := range captures {
+		fields = append(fields, irtypes.NewPointer(m.toIrType(decl.Type())))
+	}
+	return irtypes.NewStruct(fields...)
+}
+
+// loadEnv lowers the loading of the captured variables of f from its
+// environment to LLVM IR, emitting code to f.
+func (m *Module) loadEnv(f *Func) {
+	envType := f.env.Type().(*irtypes.PointerType).ElemType
+	zero := constZero(irtypes.I32)
+	for i, decl := range f.captures {
+		index := constant.NewInt(irtypes.I32, int64(i))
+		field := f.curBlock.NewGetElementPtr(envType, f.env, zero, index)
+		fieldType := field.Type().(*irtypes.PointerType).ElemType
+		f.emitLocal(decl.Name(), f.curBlock.NewLoad(fieldType, field))
+	}
+}
+
+// env lowers the environment of a call from f to a nested function with the
+// given captured variables to LLVM IR, emitting code to f.
+func (m *Module) env(f *Func, captures []*ast.VarDecl) value.Value {
+	envType := m.envType(captures)
+	env := f.entry.NewAlloca(envType)
+	zero := constZero(irtypes.I32)
+	for i, decl := range captures {
+		index := constant.NewInt(irtypes.I32, int64(i))
+		field := f.curBlock.NewGetElementPtr(envType, env, zero, index)
+		f.curBlock.NewStore(m.valueFromIdent(f, decl.Name()), field)
+	}
+	return env
+}
+
+// newEnvParam returns a new static chain parameter of a nested function with
+// the given captured variables.
+func (m *Module) newEnvParam(captures []*ast.VarDecl) *ir.Param {
+	param := ir.NewParam("", irtypes.NewPointer(m.envType(captures)))
+	param.Attrs = append(param.Attrs, enum.ParamAttrNest)
+	return param
+}
@@ -2,12 +2,16 @@ package irgen
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
 	irtypes "github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/util"
+	"github.com/mewmew/uc/token"
 	uctypes "github.com/mewmew/uc/types"
 )
 
@@ -17,6 +21,11 @@ import (
 func (m *Module) implicitConversion(f *Func, x, y value.Value) (value.Value, value.Value) {
 	// Implicit conversion.
 	switch {
+	case isPointer(x.Type()) && !isPointer(y.Type()):
+		// Null pointer constant.
+		y = m.convert(f, y, x.Type())
+	case isPointer(y.Type()) && !isPointer(x.Type()):
+		x = m.convert(f, x, y.Type())
 	case isLarger(x.Type(), y.Type()):
 		y = m.convert(f, y, x.Type())
 	case isLarger(y.Type(), x.Type()):
@@ -25,39 +34,84 @@ func (m *Module) implicitConversion(f *Func, x, y value.Value) (value.Value, val
 	return x, y
 }
 
+// exprAs lowers the given expression to LLVM IR and converts the result to the
+// specified type, emitting code to f.
+func (m *Module) exprAs(f *Func, expr ast.Expr, to irtypes.Type) value.Value {
+	return m.convertExpr(f, expr, m.expr(f, expr), to)
+}
+
+// convertExpr converts the value v of the given lowered expression to the
+// specified type, emitting code to f.
+func (m *Module) convertExpr(f *Func, expr ast.Expr, v value.Value, to irtypes.Type) value.Value {
+	if c, ok := m.info.Values[expr]; ok {
+		// Convert the folded value of integer constant expressions at compile
+		// time.
+		return constConvert(constant.NewInt(irtypes.I64, int64(c)), to)
+	}
+	return m.convertFrom(f, v, m.info.Types[expr], to)
+}
+
+// convertFrom converts the given value of type from to the specified type,
+// emitting code to f. Values of unsigned integer type are zero extended, and
+// other values are converted by convert.
+func (m *Module) convertFrom(f *Func, v value.Value, from uctypes.Type, to irtypes.Type) value.Value {
+	if isPointer(to) && uctypes.IsInteger(from) {
+		// In accordance with Clang, extend integer values to the width of
+		// pointers before converting them to pointers.
+		ptrSize := uctypes.DefaultSizes.Sizeof(&uctypes.Pointer{Elem: from})
+		v = m.convertFrom(f, v, from, irtypes.NewInt(uint64(8*ptrSize)))
+	}
+	if fromType, ok := v.Type().(*irtypes.IntType); ok && uctypes.IsUnsigned(from) {
+		if toType, ok := to.(*irtypes.IntType); ok && toType.BitSize > fromType.BitSize {
+			return f.curBlock.NewZExt(v, toType)
+		}
+	}
+	return m.convert(f, v, to)
+}
+
 // convert converts the given value to the specified type, emitting code to f.
-// No conversion is made, if v is already of the correct type.
+// No conversion is made, if v is already of the correct type. Integer values
+// are sign extended, except for boolean values; use convertFrom to convert
+// values of unsigned integer type. Pointers and integers are converted by
+// inttoptr and ptrtoint.
 func (m *Module) convert(f *Func, v value.Value, to irtypes.Type) value.Value {
 	// Early return if v is already of the correct type.
 	from := v.Type()
 	if irtypes.Equal(from, to) {
 		return v
 	}
-	fromType, ok := from.(*irtypes.IntType)
-	if !ok {
-		panic(fmt.Sprintf("support for converting from type %T not yet implemented", from))
+	if toType, ok := to.(*irtypes.PointerType); ok {
+		if isPointer(from) {
+			return f.curBlock.NewBitCast(v, toType)
+		}
+		if v, ok := v.(*constant.Int); ok && v.X.Sign() == 0 {
+			// "An integer constant expression with the value 0 [...] is called a
+			// null pointer constant." [C99 draft 6.3.2.3.3]
+			return constant.NewNull(toType)
+		}
+		return f.curBlock.NewIntToPtr(v, toType)
 	}
 	toType, ok := to.(*irtypes.IntType)
 	if !ok {
 		panic(fmt.Sprintf("support for converting to type %T not yet implemented", to))
 	}
+	if isPointer(from) {
+		return f.curBlock.NewPtrToInt(v, toType)
+	}
+	fromType, ok := from.(*irtypes.IntType)
+	if !ok {
+		panic(fmt.Sprintf("support for converting from type %T not yet implemented", from))
+	}
 
 	// Convert constant values.
 	switch v := v.(type) {
 	case *constant.Int:
-		c, err := constant.NewIntFromString(toType, v.Ident())
-		if err != nil {
-			panic(fmt.Errorf("unable to parse integer literal %q; %v", v.Ident(), err))
-		}
-		return c
+		return constConvert(v, toType)
 	case *constant.Float:
 		panic(fmt.Sprintf("support for converting type %T not yet implemented", v))
 	}
 
-	// TODO: Add proper support for converting signed and unsigned values, using
-	// sext and zext, respectively.
-
-	// Convert unsigned values.
+	// Convert boolean values.
 	if fromType.Equal(irtypes.I1) {
 		// Zero extend boolean values.
 		return f.curBlock.NewZExt(v, toType)
@@ -74,29 +128,201 @@ func (m *Module) convert(f *Func, v value.Value, to irtypes.Type) value.Value {
 
 // isLarger reports whether t has higher precision than u.
 func isLarger(t, u irtypes.Type) bool {
-	// A Sizer is a type with a size in number of bits.
-	type Sizer interface {
-		// Size returns the size of t in number of bits.
-		Size() int
-	}
-	if t, ok := t.(Sizer); ok {
-		if u, ok := u.(Sizer); ok {
-			return t.Size() > u.Size()
+	if t, ok := t.(*irtypes.IntType); ok {
+		if u, ok := u.(*irtypes.IntType); ok {
+			return t.BitSize > u.BitSize
 		}
 	}
 	return false
 }
 
-// isRef reports whether the given type is a reference type; e.g. pointer or
-// array.
-func isRef(typ irtypes.Type) bool {
-	switch typ.(type) {
-	case *irtypes.ArrayType:
-		return true
+// isPointer reports whether the given type is a pointer type.
+func isPointer(typ irtypes.Type) bool {
+	_, ok := typ.(*irtypes.PointerType)
+	return ok
+}
+
+// isPointer reports whether the given expression is of pointer type, after
+// array decay.
+func (m *Module) isPointer(expr ast.Expr) bool {
+	return uctypes.IsPointer(uctypes.Decay(m.info.Types[expr]))
+}
+
+// operands lowers the operands x and y of a binary expression to LLVM IR and
+// converts them to the specified type, emitting code to f.
+func (m *Module) operands(f *Func, x, y ast.Expr, to irtypes.Type) (value.Value, value.Value) {
+	xv, yv := m.expr(f, x), m.expr(f, y)
+	return m.convertExpr(f, x, xv, to), m.convertExpr(f, y, yv, to)
+}
+
+// ptrOperands lowers the operands x and y of pointer arithmetic to LLVM IR,
+// emitting code to f. Integer operands are extended to 64-bit integers, to be
+// used as indices.
+func (m *Module) ptrOperands(f *Func, x, y ast.Expr) (value.Value, value.Value) {
+	xv, yv := m.expr(f, x), m.expr(f, y)
+	if uctypes.IsInteger(m.info.Types[x]) {
+		xv = m.convertExpr(f, x, xv, irtypes.I64)
+	}
+	if uctypes.IsInteger(m.info.Types[y]) {
+		yv = m.convertExpr(f, y, yv, irtypes.I64)
+	}
+	return xv, yv
+}
+
+// relPred returns the integer comparison predicate of the given relational or
+// equality operator, comparing unsigned values if unsigned is true.
+func relPred(op token.Kind, unsigned bool) enum.IPred {
+	switch op {
+	case token.Lt:
+		if unsigned {
+			return enum.IPredULT
+		}
+		return enum.IPredSLT
+	case token.Gt:
+		if unsigned {
+			return enum.IPredUGT
+		}
+		return enum.IPredSGT
+	case token.Le:
+		if unsigned {
+			return enum.IPredULE
+		}
+		return enum.IPredSLE
+	case token.Ge:
+		if unsigned {
+			return enum.IPredUGE
+		}
+		return enum.IPredSGE
+	case token.Eq:
+		return enum.IPredEQ
+	case token.Ne:
+		return enum.IPredNE
+	default:
+		panic(fmt.Sprintf("support for comparison operator %v not yet implemented", op))
+	}
+}
+
+// isArray reports whether the given expression is of array type. Array
+// expressions are lowered to pointers to their first element.
+func (m *Module) isArray(expr ast.Expr) bool {
+	typ, ok := m.info.Types[expr]
+	if !ok {
+		panic(fmt.Sprintf("unable to locate type for expression %v", expr))
+	}
+	_, ok = typ.(*uctypes.Array)
+	return ok
+}
+
+// isSizedArray reports whether the given expression is of array type with a
+// known length. Unsized arrays (e.g. array parameters) are lowered to pointers.
+func (m *Module) isSizedArray(expr ast.Expr) bool {
+	typ, ok := m.info.Types[expr]
+	if !ok {
+		panic(fmt.Sprintf("unable to locate type for expression %v", expr))
+	}
+	array, ok := typ.(*uctypes.Array)
+	return ok && array.Len > 0
+}
+
+// zeroValue returns the zero value of the given type.
+func zeroValue(typ irtypes.Type) constant.Constant {
+	switch typ := typ.(type) {
+	case *irtypes.IntType:
+		return constant.NewInt(typ, 0)
+	case *irtypes.PointerType:
+		return constant.NewNull(typ)
+	default:
+		return constant.NewZeroInitializer(typ)
+	}
+}
+
+// constConvert converts the given constant to the specified type.
+func constConvert(c constant.Constant, to irtypes.Type) constant.Constant {
+	from := c.Type()
+	if irtypes.Equal(from, to) {
+		return c
+	}
+	switch to := to.(type) {
 	case *irtypes.PointerType:
+		if isPointer(from) {
+			return constant.NewBitCast(c, to)
+		}
+		if c, ok := c.(*constant.Int); ok && c.X.Sign() == 0 {
+			// "An integer constant expression with the value 0 [...] is called a
+			// null pointer constant." [C99 draft 6.3.2.3.3]
+			return constant.NewNull(to)
+		}
+		return constant.NewIntToPtr(c, to)
+	case *irtypes.IntType:
+		if c, ok := c.(*constant.Int); ok {
+			// Truncate or sign extend the value to the bit size of the integer
+			// type.
+			x := new(big.Int).Set(c.X)
+			if to.BitSize < 64 {
+				shift := 64 - to.BitSize
+				x.SetInt64(x.Int64() << shift >> shift)
+			}
+			return &constant.Int{Typ: to, X: x}
+		}
+	}
+	panic(fmt.Sprintf("support for converting constant %v to type %v not yet implemented", c, to))
+}
+
+// sizeOf returns the size in bytes of the given type, as computed by the
+// constant expression
+//
+//    ptrtoint (i32* getelementptr (i32, i32* null, i64 1) to i64)
+func sizeOf(typ irtypes.Type) constant.Constant {
+	one := constOne(irtypes.I64)
+	end := constant.NewGetElementPtr(typ, constant.NewNull(irtypes.NewPointer(typ)), one)
+	return constant.NewPtrToInt(end, irtypes.I64)
+}
+
+// stringValue returns the unquoted value of the given string literal.
+func stringValue(n ast.Expr) []byte {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.StringLit {
+		panic(fmt.Sprintf("invalid string literal; expected *ast.BasicLit of kind StringLit, got %v", n))
+	}
+	s, err := util.StringValue([]byte(lit.Val))
+	if err != nil {
+		panic(fmt.Sprintf("unable to unquote string literal; %v", err))
+	}
+	return s
+}
+
+// isCompleteInit reports whether the given initializer provides an explicit
+// value for every element of the initialized object of type typ.
+func isCompleteInit(typ uctypes.Type, val ast.Expr) bool {
+	list, isList := val.(*ast.InitList)
+	if !isList {
+		// Scalars, structures initialized by expression and character arrays
+		// initialized by string literal.
+		return true
+	}
+	switch typ := typ.(type) {
+	case *uctypes.Array:
+		if len(list.Elems) < typ.Len {
+			return false
+		}
+		for _, elem := range list.Elems {
+			if !isCompleteInit(typ.Elem, elem) {
+				return false
+			}
+		}
+		return true
+	case *uctypes.Struct:
+		if len(list.Elems) < len(typ.Fields) {
+			return false
+		}
+		for i, elem := range list.Elems {
+			if !isCompleteInit(typ.Fields[i].Type, elem) {
+				return false
+			}
+		}
 		return true
 	default:
-		return false
+		return isCompleteInit(typ, list.Elems[0])
 	}
 }
 
@@ -153,6 +379,10 @@ func (m *Module) isGlobal(ident *ast.Ident) bool {
 // valueFromIdent returns the LLVM IR value associated with the given
 // identifier. Only search for global values if f is nil.
 func (m *Module) valueFromIdent(f *Func, ident *ast.Ident) value.Value {
+	// Enumeration constants are folded to their values.
+	if c, ok := ident.Decl.(*ast.EnumConst); ok {
+		return constant.NewInt(irtypes.I32, int64(c.Val))
+	}
 	pos := ident.Decl.Name().Start()
 	if v, ok := m.idents[pos]; ok {
 		return v
@@ -186,49 +416,63 @@ func (f *Func) setIdentValue(ident *ast.Ident, v value.Value) {
 // typeOf returns the LLVM IR type of the given expression.
 func (m *Module) typeOf(expr ast.Expr) irtypes.Type {
 	if typ, ok := m.info.Types[expr]; ok {
-		return toIrType(typ)
+		return m.toIrType(typ)
 	}
 	panic(fmt.Sprintf("unable to locate type for expression %v", expr))
 }
 
 // toIrType converts the given uC type to the corresponding LLVM IR type.
-func toIrType(n uctypes.Type) irtypes.Type {
+func (m *Module) toIrType(n uctypes.Type) irtypes.Type {
 	//TODO: implement, placeholder implementation
 	var t irtypes.Type
 	var err error
 	switch ucType := n.(type) {
 	case *uctypes.Basic:
-		switch ucType.Kind {
-		case uctypes.Int:
-			//TODO: Get int width from compile env
-			t = irtypes.NewInt(32)
-		case uctypes.Char:
-			t = irtypes.NewInt(8)
-		case uctypes.Void:
+		switch {
+		case uctypes.IsInteger(ucType):
+			// Integer widths are given by the memory layout of the target.
+			t = irtypes.NewInt(uint64(8 * uctypes.DefaultSizes.Sizeof(ucType)))
+		case uctypes.IsVoid(ucType):
 			t = irtypes.Void
 		}
 	case *uctypes.Array:
-		elem := toIrType(ucType.Elem)
+		elem := m.toIrType(ucType.Elem)
 		if ucType.Len == 0 {
 			t = irtypes.NewPointer(elem)
 		} else {
 			t = irtypes.NewArray(uint64(ucType.Len), elem)
 		}
+	case *uctypes.Pointer:
+		elem := m.toIrType(ucType.Elem)
+		if elem.Equal(irtypes.Void) {
+			// In accordance with Clang, lower void pointers to i8*.
+			elem = irtypes.I8
+		}
+		t = irtypes.NewPointer(elem)
+	case *uctypes.Struct:
+		if len(ucType.Tag) > 0 {
+			t = m.structType(ucType)
+			break
+		}
+		// Anonymous structure types are lowered to literal structure types.
+		var fields []irtypes.Type
+		for _, field := range ucType.Fields {
+			fields = append(fields, m.toIrType(field.Type))
+		}
+		t = irtypes.NewStruct(fields...)
 	case *uctypes.Func:
 		var params []irtypes.Type
-		variadic := false
 		for _, p := range ucType.Params {
-			//TODO: Add support for variadic
 			if uctypes.IsVoid(p.Type) {
 				break
 			}
-			pt := toIrType(p.Type)
+			pt := m.toIrType(p.Type)
 			dbg.Printf("converting type %#v to %#v", p.Type, pt)
 			params = append(params, pt)
 		}
-		result := toIrType(ucType.Result)
+		result := m.toIrType(ucType.Result)
 		typ := irtypes.NewFunc(result, params...)
-		typ.Variadic = variadic
+		typ.Variadic = ucType.Variadic
 		t = typ
 	default:
 		panic(fmt.Sprintf("support for translating type %T not yet implemented.", ucType))
@@ -241,3 +485,31 @@ func toIrType(n uctypes.Type) irtypes.Type {
 	}
 	return t
 }
+
+// structType returns the named LLVM IR structure type of the given tagged
+// structure type, emitting its type definition to m on first use.
+func (m *Module) structType(ucType *uctypes.Struct) *irtypes.StructType {
+	if t, ok := m.structs[ucType]; ok {
+		return t
+	}
+	// In accordance with Clang, name structure types struct.foo, struct.foo.0,
+	// struct.foo.1, ...
+	name := "struct." + ucType.Tag
+	for i := 0; m.structNames[name]; i++ {
+		name = fmt.Sprintf("struct.%s.%d", ucType.Tag, i)
+	}
+	m.structNames[name] = true
+	t := irtypes.NewStruct()
+	t.SetName(name)
+	// Register the structure type before lowering its fields, as the fields may
+	// refer to the structure type itself.
+	m.structs[ucType] = t
+	// Structure types which are never defined are lowered to opaque structure
+	// types.
+	t.Opaque = ucType.Incomplete
+	for _, field := range ucType.Fields {
+		t.Fields = append(t.Fields, m.toIrType(field.Type))
+	}
+	m.TypeDefs = append(m.TypeDefs, t)
+	return t
+}
@@ -0,0 +1,318 @@
+package preproc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mewmew/uc/token"
+)
+
+// A macro represents a macro definition.
+type macro struct {
+	// Macro name.
+	name string
+	// Specifies whether the macro is function-like.
+	funcLike bool
+	// Parameter names of function-like macros.
+	params []string
+	// Replacement list.
+	body []ppToken
+}
+
+// define handles the #define directive with the given arguments.
+func (p *preprocessor) define(f *srcFile, pos token.Pos, args []ppToken) {
+	if len(args) == 0 || args[0].kind != tokIdent {
+		p.errorf(f, pos, "macro name missing in #define directive")
+		return
+	}
+	m := &macro{name: args[0].text}
+	rest := args[1:]
+	// A function-like macro is defined by a left parenthesis immediately
+	// following the macro name.
+	if len(rest) > 0 && rest[0].text == "(" {
+		m.funcLike = true
+		i := skipSpace(rest, 1)
+		if i < len(rest) && rest[i].text == ")" {
+			rest = rest[i+1:]
+		} else {
+			for {
+				if i >= len(rest) || rest[i].kind != tokIdent {
+					p.errorf(f, pos, "expected parameter name in macro parameter list")
+					return
+				}
+				m.params = append(m.params, rest[i].text)
+				i = skipSpace(rest, i+1)
+				if i >= len(rest) {
+					p.errorf(f, pos, "missing ')' in macro parameter list")
+					return
+				}
+				if rest[i].text == ")" {
+					rest = rest[i+1:]
+					break
+				}
+				if rest[i].text != "," {
+					p.errorf(f, pos, "expected ',' or ')' in macro parameter list")
+					return
+				}
+				i = skipSpace(rest, i+1)
+			}
+		}
+	}
+	// Comments are replaced by one space character.
+	for _, tok := range trimSpace(rest) {
+		if tok.kind == tokSpace || tok.kind == tokComment {
+			tok = ppToken{kind: tokSpace, text: " "}
+		}
+		m.body = append(m.body, tok)
+	}
+	if old, ok := p.macros[m.name]; ok && !old.equal(m) {
+		p.warningf(f, pos, "%q redefined", m.name)
+	}
+	p.macros[m.name] = m
+}
+
+// equal reports whether the macro definitions are identical.
+func (m *macro) equal(n *macro) bool {
+	if m.funcLike != n.funcLike || len(m.params) != len(n.params) || len(m.body) != len(n.body) {
+		return false
+	}
+	for i := range m.params {
+		if m.params[i] != n.params[i] {
+			return false
+		}
+	}
+	for i := range m.body {
+		if m.body[i].text != n.body[i].text {
+			return false
+		}
+	}
+	return true
+}
+
+// expandLines returns the given line (0-based) of the file with macros
+// replaced, and the index of the line following the replaced lines. The
+// following lines are joined with the line while the argument list of a
+// function-like macro invocation continues past the end of the line. The
+// inComment argument specifies whether the line starts within a block comment,
+// and the returned bool whether the following line does.
+func (p *preprocessor) expandLines(f *srcFile, line int, inComment bool) (string, int, bool) {
+	next := line
+	// more returns the tokens of the next line of the file, or nil if the next
+	// line is a directive or past the end of the file.
+	more := func() []ppToken {
+		if next >= len(f.lines) || (!inComment && isDirective(f.lines[next])) {
+			return nil
+		}
+		var toks []ppToken
+		if next > line {
+			// The new line of the joined lines is replaced by one space
+			// character.
+			toks = append(toks, ppToken{kind: tokSpace, text: " ", line: next})
+		}
+		for _, tok := range tokenize(f.lines[next], inComment) {
+			tok.line = next
+			toks = append(toks, tok)
+		}
+		inComment = endsInComment(f.lines[next], inComment)
+		next++
+		return toks
+	}
+	expanded, err := p.expand(more(), more)
+	if err != nil {
+		p.errorf(f, f.pos(err.line, err.col), "%s", err.msg)
+		return strings.Join(f.lines[line:next], " "), next, inComment
+	}
+	return joinTokens(expanded), next, inComment
+}
+
+// An expandError represents an error encountered during macro replacement.
+type expandError struct {
+	// Line (0-based) and column of the macro invocation.
+	line, col int
+	// Error message.
+	msg string
+}
+
+// expand returns the given tokens with macros replaced. The more function, if
+// non-nil, returns the tokens following the given tokens, or nil if none; it
+// is invoked while the argument list of a function-like macro invocation
+// continues past the end of the tokens. The macros of the hide set of a token
+// are not replaced, to prevent recursive replacement (§6.10.3.4).
+func (p *preprocessor) expand(toks []ppToken, more func() []ppToken) ([]ppToken, *expandError) {
+	var out []ppToken
+	for len(toks) > 0 {
+		tok := toks[0]
+		toks = toks[1:]
+		m, ok := p.macros[tok.text]
+		if tok.kind != tokIdent || !ok || tok.hide[tok.text] {
+			if tok.sep {
+				out = appendSep(out, tok)
+			} else {
+				out = append(out, tok)
+			}
+			continue
+		}
+		body := m.body
+		hide := tok.hide
+		if m.funcLike {
+			// The argument list of the invocation may continue on the following
+			// lines.
+			j := skipSpace(toks, 0)
+			for j >= len(toks) && more != nil {
+				rest := more()
+				if rest == nil {
+					break
+				}
+				toks = append(toks, rest...)
+				j = skipSpace(toks, j)
+			}
+			if j >= len(toks) || toks[j].text != "(" {
+				// The name of a function-like macro not followed by a left
+				// parenthesis is not replaced.
+				out = append(out, tok)
+				continue
+			}
+			args, end, ok := splitArgs(toks, j)
+			for !ok && more != nil {
+				rest := more()
+				if rest == nil {
+					break
+				}
+				toks = append(toks, rest...)
+				args, end, ok = splitArgs(toks, j)
+			}
+			if !ok {
+				return nil, &expandError{line: tok.line, col: tok.col, msg: fmt.Sprintf("unterminated argument list invoking macro %q", m.name)}
+			}
+			if len(m.params) == 0 && len(args) == 1 && len(trimSpace(args[0])) == 0 {
+				args = nil
+			}
+			if len(args) != len(m.params) {
+				return nil, &expandError{line: tok.line, col: tok.col, msg: fmt.Sprintf("macro %q requires %d arguments, but %d given", m.name, len(m.params), len(args))}
+			}
+			// Arguments are fully macro-replaced before substitution.
+			expandedArgs := make(map[string][]ppToken)
+			for k, arg := range args {
+				expandedArg, err := p.expand(trimSpace(arg), nil)
+				if err != nil {
+					return nil, err
+				}
+				expandedArgs[m.params[k]] = expandedArg
+			}
+			body = nil
+			for _, t := range m.body {
+				if arg, ok := expandedArgs[t.text]; ok && t.kind == tokIdent {
+					body = append(body, arg...)
+					continue
+				}
+				body = append(body, t)
+			}
+			// The macro invocation ends with the right parenthesis, the hide set
+			// of which also applies to the replacement list.
+			hide = intersect(hide, toks[end].hide)
+			toks = toks[end+1:]
+		}
+		// Rescan the replacement list together with the following tokens, with
+		// the macro added to the hide set of each token of the replacement list.
+		h := map[string]bool{m.name: true}
+		for name := range hide {
+			h[name] = true
+		}
+		var rescan []ppToken
+		for k, t := range body {
+			t.hide = union(t.hide, h)
+			// Report errors at the macro invocation.
+			t.line, t.col = tok.line, tok.col
+			t.sep = k == 0
+			rescan = append(rescan, t)
+		}
+		if len(toks) > 0 {
+			rest := make([]ppToken, len(toks))
+			copy(rest, toks)
+			rest[0].sep = true
+			toks = rest
+		}
+		toks = append(rescan, toks...)
+	}
+	return out, nil
+}
+
+// union returns the union of the given hide sets.
+func union(a, b map[string]bool) map[string]bool {
+	if len(a) == 0 {
+		return b
+	}
+	u := make(map[string]bool)
+	for name := range a {
+		u[name] = true
+	}
+	for name := range b {
+		u[name] = true
+	}
+	return u
+}
+
+// intersect returns the intersection of the given hide sets.
+func intersect(a, b map[string]bool) map[string]bool {
+	x := make(map[string]bool)
+	for name := range a {
+		if b[name] {
+			x[name] = true
+		}
+	}
+	return x
+}
+
+// splitArgs splits the arguments of the macro invocation with a left
+// parenthesis at the given index, and returns the arguments and the index of
+// the right parenthesis.
+func splitArgs(toks []ppToken, lparen int) (args [][]ppToken, end int, ok bool) {
+	depth := 0
+	var arg []ppToken
+	for i := lparen + 1; i < len(toks); i++ {
+		tok := toks[i]
+		switch tok.text {
+		case "(":
+			depth++
+		case ")":
+			if depth == 0 {
+				args = append(args, arg)
+				return args, i, true
+			}
+			depth--
+		case ",":
+			if depth == 0 {
+				args = append(args, arg)
+				arg = nil
+				continue
+			}
+		}
+		arg = append(arg, tok)
+	}
+	return nil, 0, false
+}
+
+// appendSep appends the given token to the list of tokens, and inserts a space
+// before the token if it would otherwise be joined with the last token of the
+// list; e.g. '-' followed by '-'.
+func appendSep(toks []ppToken, tok ppToken) []ppToken {
+	if n := len(toks); n > 0 {
+		prev := toks[n-1]
+		word := func(kind tokenKind) bool {
+			return kind == tokIdent || kind == tokNumber
+		}
+		if (word(prev.kind) && word(tok.kind)) || (prev.kind == tokPunct && tok.kind == tokPunct) {
+			toks = append(toks, ppToken{kind: tokSpace, text: " ", col: tok.col})
+		}
+	}
+	return append(toks, tok)
+}
+
+// joinTokens returns the text of the given tokens.
+func joinTokens(toks []ppToken) string {
+	var sb strings.Builder
+	for _, tok := range toks {
+		sb.WriteString(tok.text)
+	}
+	return sb.String()
+}
@@ -0,0 +1,275 @@
+package preproc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mewmew/uc/gocc/util"
+	"github.com/mewmew/uc/token"
+)
+
+// eval evaluates the controlling constant expression of an #if or #elif
+// directive (§6.10.1), and reports whether it is non-zero.
+func (p *preprocessor) eval(f *srcFile, pos token.Pos, args []ppToken) bool {
+	// Replace defined operators, before macro replacement.
+	var toks []ppToken
+	for i := 0; i < len(args); i++ {
+		tok := args[i]
+		if tok.kind != tokIdent || tok.text != "defined" {
+			toks = append(toks, tok)
+			continue
+		}
+		j := skipSpace(args, i+1)
+		paren := j < len(args) && args[j].text == "("
+		if paren {
+			j = skipSpace(args, j+1)
+		}
+		if j >= len(args) || args[j].kind != tokIdent {
+			p.errorf(f, pos, "operator \"defined\" requires an identifier")
+			return false
+		}
+		_, defined := p.macros[args[j].text]
+		if paren {
+			j = skipSpace(args, j+1)
+			if j >= len(args) || args[j].text != ")" {
+				p.errorf(f, pos, "missing ')' after \"defined\"")
+				return false
+			}
+		}
+		val := "0"
+		if defined {
+			val = "1"
+		}
+		toks = append(toks, ppToken{kind: tokNumber, text: val, col: tok.col})
+		i = j
+	}
+	expanded, err := p.expand(toks, nil)
+	if err != nil {
+		p.errorf(f, pos, "%s", err.msg)
+		return false
+	}
+	// Identifiers remaining after macro replacement are replaced by 0.
+	e := &exprParser{}
+	for _, tok := range expanded {
+		switch tok.kind {
+		case tokSpace, tokComment:
+			continue
+		case tokIdent:
+			tok = ppToken{kind: tokNumber, text: "0", col: tok.col}
+		}
+		e.toks = append(e.toks, tok)
+	}
+	if len(e.toks) == 0 {
+		p.errorf(f, pos, "#if with no expression")
+		return false
+	}
+	x, ok := e.cond()
+	if ok && e.i < len(e.toks) {
+		e.errorf("missing binary operator before token %q", e.toks[e.i].text)
+		ok = false
+	}
+	if !ok {
+		p.errorf(f, pos, "%s", e.err)
+		return false
+	}
+	return x != 0
+}
+
+// An exprParser parses and evaluates preprocessor constant expressions.
+type exprParser struct {
+	// Tokens of the expression, without white space.
+	toks []ppToken
+	// Index of the current token.
+	i int
+	// First error encountered.
+	err string
+}
+
+// errorf records the given error.
+func (e *exprParser) errorf(format string, a ...interface{}) {
+	e.err = fmt.Sprintf(format, a...)
+}
+
+// peek returns the text of the current token; or the empty string at the end
+// of the expression.
+func (e *exprParser) peek() string {
+	if e.i < len(e.toks) {
+		return e.toks[e.i].text
+	}
+	return ""
+}
+
+// binaryPrec maps from binary operators to their precedence; higher values bind
+// tighter.
+var binaryPrec = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"|":  3,
+	"^":  4,
+	"&":  5,
+	"==": 6, "!=": 6,
+	"<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8,
+	"+": 9, "-": 9,
+	"*": 10, "/": 10, "%": 10,
+}
+
+// cond parses a conditional expression.
+//
+//    CondExpr = BinaryExpr [ "?" CondExpr ":" CondExpr ] .
+func (e *exprParser) cond() (int64, bool) {
+	x, ok := e.binary(1)
+	if !ok || e.peek() != "?" {
+		return x, ok
+	}
+	e.i++
+	y, ok := e.cond()
+	if !ok {
+		return 0, false
+	}
+	if e.peek() != ":" {
+		e.errorf("expected ':' in conditional expression")
+		return 0, false
+	}
+	e.i++
+	z, ok := e.cond()
+	if !ok {
+		return 0, false
+	}
+	if x != 0 {
+		return y, true
+	}
+	return z, true
+}
+
+// binary parses a binary expression with operators of at least the given
+// precedence.
+func (e *exprParser) binary(prec int) (int64, bool) {
+	x, ok := e.unary()
+	if !ok {
+		return 0, false
+	}
+	for {
+		op := e.peek()
+		opPrec, isOp := binaryPrec[op]
+		if !isOp || opPrec < prec {
+			return x, true
+		}
+		e.i++
+		y, ok := e.binary(opPrec + 1)
+		if !ok {
+			return 0, false
+		}
+		switch op {
+		case "||":
+			x = bool2int(x != 0 || y != 0)
+		case "&&":
+			x = bool2int(x != 0 && y != 0)
+		case "|":
+			x |= y
+		case "^":
+			x ^= y
+		case "&":
+			x &= y
+		case "==":
+			x = bool2int(x == y)
+		case "!=":
+			x = bool2int(x != y)
+		case "<":
+			x = bool2int(x < y)
+		case ">":
+			x = bool2int(x > y)
+		case "<=":
+			x = bool2int(x <= y)
+		case ">=":
+			x = bool2int(x >= y)
+		case "<<":
+			x <<= uint64(y)
+		case ">>":
+			x >>= uint64(y)
+		case "+":
+			x += y
+		case "-":
+			x -= y
+		case "*":
+			x *= y
+		case "/", "%":
+			if y == 0 {
+				e.errorf("division by zero in #if")
+				return 0, false
+			}
+			if op == "/" {
+				x /= y
+			} else {
+				x %= y
+			}
+		}
+	}
+}
+
+// unary parses a unary or primary expression.
+func (e *exprParser) unary() (int64, bool) {
+	if e.i >= len(e.toks) {
+		e.errorf("missing expression in #if")
+		return 0, false
+	}
+	tok := e.toks[e.i]
+	e.i++
+	switch tok.text {
+	case "!", "-", "+", "~":
+		x, ok := e.unary()
+		if !ok {
+			return 0, false
+		}
+		switch tok.text {
+		case "!":
+			return bool2int(x == 0), true
+		case "-":
+			return -x, true
+		case "~":
+			return ^x, true
+		}
+		return x, true
+	case "(":
+		x, ok := e.cond()
+		if !ok {
+			return 0, false
+		}
+		if e.peek() != ")" {
+			e.errorf("missing ')' in expression")
+			return 0, false
+		}
+		e.i++
+		return x, true
+	}
+	switch tok.kind {
+	case tokNumber:
+		// Integer suffixes are ignored.
+		lit := strings.TrimRight(tok.text, "uUlL")
+		x, err := util.UintValue([]byte(lit))
+		if err != nil {
+			e.errorf("invalid integer constant %q in #if", tok.text)
+			return 0, false
+		}
+		return int64(x), true
+	case tokLit:
+		if tok.text[0] == '\'' {
+			r, err := util.CharValue([]byte(tok.text))
+			if err != nil {
+				e.errorf("invalid character constant %s in #if", tok.text)
+				return 0, false
+			}
+			return int64(r), true
+		}
+	}
+	e.errorf("token %q is not valid in preprocessor expressions", tok.text)
+	return 0, false
+}
+
+// bool2int returns 1 if b is true and 0 otherwise.
+func bool2int(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,394 @@
+// Package preproc implements a preprocessor for the µC programming language.
+//
+// The preprocessor supports a subset of the preprocessing directives of C
+// (§6.10); source file inclusion (#include), macro replacement of object-like
+// and function-like macros (#define and #undef) and conditional inclusion (#if,
+// #ifdef, #ifndef, #elif, #else and #endif).
+//
+// Directive lines and lines of skipped groups are replaced by empty lines in the
+// preprocessed output, so that the line numbers of the input source are
+// maintained. Line information is recorded for the contents of included files,
+// which maps offsets of the preprocessed output back to the original file and
+// line.
+package preproc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// maxIncludeDepth specifies the maximum nesting depth of included files.
+const maxIncludeDepth = 200
+
+// Result is the result of preprocessing an input source.
+type Result struct {
+	// Preprocessed output.
+	Output []byte
+	// Line information which maps offsets of the preprocessed output back to the
+	// original files and lines.
+	lines []lineInfo
+}
+
+// A lineInfo specifies the original file and line of a line in the
+// preprocessed output.
+type lineInfo struct {
+	// Offset of the line in the preprocessed output.
+	offset int
+	// Original file name and line number.
+	filename string
+	line     int
+}
+
+// AddFile adds the preprocessed output to the given file set, and returns the
+// file. The file tracks the original file and line of each position within the
+// preprocessed output.
+func (res *Result) AddFile(fset *token.FileSet, filename string) *token.File {
+	file := fset.AddFile(filename, len(res.Output))
+	file.SetLinesForContent(res.Output)
+	for _, info := range res.lines {
+		file.AddLineInfo(info.offset, info.filename, info.line)
+	}
+	return file
+}
+
+// Preprocess preprocesses the given input source, read from path. Files
+// included using #include "FILE" are searched for in the directory of the
+// including file, followed by the given include paths; files included using
+// #include <FILE> are only searched for in the include paths.
+//
+// The returned error is a semerrors.List of the diagnostics reported while
+// preprocessing; the preprocessed output is valid if the list contains only
+// warnings.
+func Preprocess(path string, input []byte, includePaths []string) (*Result, error) {
+	p := &preprocessor{
+		includePaths: includePaths,
+		macros:       make(map[string]*macro),
+	}
+	p.file(path, input)
+	res := &Result{
+		Output: p.out.Bytes(),
+		lines:  p.lines,
+	}
+	return res, p.errs.Err()
+}
+
+// A preprocessor tracks the state of the preprocessor.
+type preprocessor struct {
+	// Include paths.
+	includePaths []string
+	// Macro definitions.
+	macros map[string]*macro
+	// Preprocessed output.
+	out bytes.Buffer
+	// Line information of the preprocessed output.
+	lines []lineInfo
+	// Original file name and line number of the next line of the preprocessed
+	// output, if not altered by line information.
+	nextFilename string
+	nextLine     int
+	// Nesting depth of included files.
+	depth int
+	// Diagnostics reported while preprocessing.
+	errs semerrors.List
+}
+
+// A srcFile represents an input source file being preprocessed.
+type srcFile struct {
+	// Input source of the file.
+	src *semerrors.Source
+	// Lines of the file, without trailing new lines.
+	lines []string
+	// Stack of conditional inclusion groups.
+	conds []*cond
+}
+
+// A cond represents a group of conditional inclusion directives; i.e. the
+// #if, #elif and #else groups of an #if directive.
+type cond struct {
+	// Position of the #if directive.
+	pos token.Pos
+	// Specifies whether the enclosing group is included.
+	parentActive bool
+	// Specifies whether the current group is included.
+	active bool
+	// Specifies whether any group has been included.
+	taken bool
+	// Specifies whether the #else group has been reached.
+	sawElse bool
+}
+
+// file preprocesses the given file.
+func (p *preprocessor) file(path string, input []byte) {
+	f := &srcFile{
+		src:   semerrors.NewSource(path, string(input)),
+		lines: strings.Split(string(input), "\n"),
+	}
+	if n := len(f.lines); n > 0 && len(f.lines[n-1]) == 0 {
+		// Drop the empty line after the trailing new line.
+		f.lines = f.lines[:n-1]
+	}
+	inComment := false
+	for i := 0; i < len(f.lines); {
+		line := f.lines[i]
+		if !inComment && isDirective(line) {
+			// Join lines ending with a backslash.
+			start := i
+			for strings.HasSuffix(line, `\`) && i+1 < len(f.lines) {
+				i++
+				line = line[:len(line)-1] + f.lines[i]
+			}
+			i++
+			p.directive(f, start, line)
+			for j := start; j < i; j++ {
+				p.emit(f, j, "")
+			}
+			continue
+		}
+		if !f.active() {
+			p.emit(f, i, "")
+			inComment = endsInComment(line, inComment)
+			i++
+			continue
+		}
+		// The lines joined by the argument list of a function-like macro
+		// invocation are replaced by empty lines.
+		start := i
+		var text string
+		text, i, inComment = p.expandLines(f, i, inComment)
+		p.emit(f, start, text)
+		for j := start + 1; j < i; j++ {
+			p.emit(f, j, "")
+		}
+	}
+	for _, c := range f.conds {
+		p.errorf(f, c.pos, "unterminated conditional directive")
+	}
+}
+
+// emit appends the given text as the preprocessed contents of the given line
+// (0-based) of the file.
+func (p *preprocessor) emit(f *srcFile, line int, text string) {
+	filename := f.src.File.Name()
+	if filename != p.nextFilename || line+1 != p.nextLine {
+		info := lineInfo{offset: p.out.Len(), filename: filename, line: line + 1}
+		p.lines = append(p.lines, info)
+	}
+	p.out.WriteString(text)
+	p.out.WriteString("\n")
+	p.nextFilename = filename
+	p.nextLine = line + 2
+}
+
+// active reports whether the current group of the file is included.
+func (f *srcFile) active() bool {
+	if n := len(f.conds); n > 0 {
+		return f.conds[n-1].active
+	}
+	return true
+}
+
+// pos returns the position of the given column of the given line (0-based) of
+// the file.
+func (f *srcFile) pos(line, col int) token.Pos {
+	return f.src.File.Pos(f.src.File.LineStart(line+1) + col)
+}
+
+// errorf reports an error at the given position of the file.
+func (p *preprocessor) errorf(f *srcFile, pos token.Pos, format string, a ...interface{}) {
+	err := semerrors.Newf(pos, format, a...)
+	err.Src = f.src
+	p.errs.Add(err)
+}
+
+// warningf reports a warning at the given position of the file.
+func (p *preprocessor) warningf(f *srcFile, pos token.Pos, format string, a ...interface{}) {
+	err := semerrors.Warningf(pos, format, a...)
+	err.Src = f.src
+	p.errs.Add(err)
+}
+
+// isDirective reports whether the given line is a preprocessing directive;
+// i.e. whether its first non-white space character is '#'.
+func isDirective(line string) bool {
+	return strings.HasPrefix(strings.TrimLeft(line, " \t\v\f\r"), "#")
+}
+
+// directive handles the given directive, starting at the given line (0-based)
+// of the file.
+func (p *preprocessor) directive(f *srcFile, line int, text string) {
+	toks := tokenize(text, false)
+	// Skip the '#' token.
+	hash := skipSpace(toks, 0)
+	pos := f.pos(line, toks[hash].col)
+	i := skipSpace(toks, hash+1)
+	if i >= len(toks) {
+		// Null directive.
+		return
+	}
+	name := toks[i].text
+	args := trimSpace(toks[i+1:])
+	// Conditional inclusion directives are handled within skipped groups, to
+	// track their nesting.
+	switch name {
+	case "if", "ifdef", "ifndef":
+		c := &cond{pos: pos, parentActive: f.active()}
+		if c.parentActive {
+			switch name {
+			case "if":
+				c.active = p.eval(f, pos, args)
+			case "ifdef", "ifndef":
+				if macroName, ok := p.macroName(f, pos, name, args); ok {
+					_, defined := p.macros[macroName]
+					c.active = defined == (name == "ifdef")
+				}
+			}
+		}
+		c.taken = c.active
+		f.conds = append(f.conds, c)
+		return
+	case "elif", "else", "endif":
+		n := len(f.conds)
+		if n == 0 {
+			p.errorf(f, pos, "#%s without #if", name)
+			return
+		}
+		c := f.conds[n-1]
+		switch name {
+		case "elif":
+			if c.sawElse {
+				p.errorf(f, pos, "#elif after #else")
+			}
+			c.active = false
+			if c.parentActive && !c.taken {
+				c.active = p.eval(f, pos, args)
+				c.taken = c.active
+			}
+		case "else":
+			if c.sawElse {
+				p.errorf(f, pos, "#else after #else")
+			}
+			c.sawElse = true
+			c.active = c.parentActive && !c.taken
+			c.taken = true
+		case "endif":
+			f.conds = f.conds[:n-1]
+		}
+		return
+	}
+	if !f.active() {
+		return
+	}
+	switch name {
+	case "define":
+		p.define(f, pos, args)
+	case "undef":
+		if macroName, ok := p.macroName(f, pos, name, args); ok {
+			delete(p.macros, macroName)
+		}
+	case "include":
+		p.include(f, pos, args)
+	case "pragma":
+		// Pragmas are ignored.
+	default:
+		p.errorf(f, pos, "invalid preprocessing directive #%s", name)
+	}
+}
+
+// macroName returns the macro name argument of the given directive.
+func (p *preprocessor) macroName(f *srcFile, pos token.Pos, directive string, args []ppToken) (string, bool) {
+	if len(args) == 0 || args[0].kind != tokIdent {
+		p.errorf(f, pos, "macro name missing in #%s directive", directive)
+		return "", false
+	}
+	if len(args) > 1 {
+		p.warningf(f, pos, "extra tokens at end of #%s directive", directive)
+	}
+	return args[0].text, true
+}
+
+// include handles the #include directive with the given arguments.
+func (p *preprocessor) include(f *srcFile, pos token.Pos, args []ppToken) {
+	arg := joinTokens(args)
+	var name string
+	var dirs []string
+	switch {
+	case len(arg) > 2 && arg[0] == '"' && arg[len(arg)-1] == '"':
+		name = arg[1 : len(arg)-1]
+		dirs = append(dirs, filepath.Dir(f.src.File.Name()))
+	case len(arg) > 2 && arg[0] == '<' && arg[len(arg)-1] == '>':
+		name = arg[1 : len(arg)-1]
+	default:
+		p.errorf(f, pos, `#include expects "FILENAME" or <FILENAME>`)
+		return
+	}
+	dirs = append(dirs, p.includePaths...)
+	path, ok := findFile(name, dirs)
+	if !ok {
+		if arg[0] == '<' {
+			// System headers are not provided by µC; e.g. <stdio.h> is included
+			// by test cases to compile with other C compilers.
+			p.warningf(f, pos, "system header %s not found; ignoring #include", arg)
+			return
+		}
+		p.errorf(f, pos, "unable to locate include file %s", arg)
+		return
+	}
+	if p.depth >= maxIncludeDepth {
+		p.errorf(f, pos, "#include nested too deeply")
+		return
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		p.errorf(f, pos, "unable to read include file %s: %v", arg, err)
+		return
+	}
+	p.depth++
+	p.file(path, buf)
+	p.depth--
+}
+
+// findFile locates the given file in the given directories, and returns its
+// path.
+func findFile(name string, dirs []string) (string, bool) {
+	if filepath.IsAbs(name) {
+		_, err := os.Stat(name)
+		return name, err == nil
+	}
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// endsInComment reports whether the given line ends within a block comment,
+// where inComment specifies whether the line starts within a block comment.
+func endsInComment(line string, inComment bool) bool {
+	toks := tokenize(line, inComment)
+	if len(toks) == 0 {
+		return inComment
+	}
+	last := toks[len(toks)-1]
+	return last.kind == tokComment && isOpenComment(last.text, inComment && len(toks) == 1)
+}
+
+// isOpenComment reports whether the given comment token ends within an
+// unterminated block comment, where cont specifies whether the token continues
+// a block comment of a previous line.
+func isOpenComment(text string, cont bool) bool {
+	if !cont {
+		if !strings.HasPrefix(text, "/*") {
+			return false
+		}
+		text = text[2:]
+	}
+	return !strings.HasSuffix(text, "*/")
+}
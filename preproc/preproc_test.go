@@ -0,0 +1,114 @@
+package preproc_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/preproc"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+)
+
+// includePaths specifies the include paths of the test cases.
+var includePaths = []string{"../testdata/extra/preproc/include"}
+
+func TestPreprocess(t *testing.T) {
+	const path = "../testdata/extra/preproc/main.c"
+	const want = `
+int defs;
+int release;
+int big[10];
+int sq = ((2) * (2)) ;
+int multi = ((3) * (3)) ;
+int after;
+int main(void) {
+	/* Not a directive:
+#define N 1
+	*/
+	return ((10 + 1) * (10 + 1)) - -10; // N
+}`
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := preproc.Preprocess(path, buf, includePaths)
+	if err != nil {
+		t.Fatalf("%q: unexpected error; %v", path, err)
+	}
+	// Skip empty lines of directives.
+	var lines []string
+	for _, line := range strings.Split(string(res.Output), "\n") {
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	if got := strings.Join(lines, "\n"); got != want[1:] {
+		t.Errorf("%q: output mismatch; expected %q, got %q", path, want[1:], got)
+	}
+
+	// Verify that positions are mapped back to the original file and line.
+	fset := token.NewFileSet()
+	file := res.AddFile(fset, path)
+	golden := []struct {
+		text string
+		want string
+	}{
+		{text: "int defs;", want: "../testdata/extra/preproc/defs.h:8:1"},
+		{text: "int release;", want: "../testdata/extra/preproc/main.c:7:1"},
+		{text: "int big", want: "../testdata/extra/preproc/main.c:11:1"},
+		{text: "int multi", want: "../testdata/extra/preproc/main.c:18:1"},
+		{text: "int after;", want: "../testdata/extra/preproc/main.c:20:1"},
+		{text: "return", want: "../testdata/extra/preproc/main.c:26:2"},
+	}
+	for _, g := range golden {
+		offset := strings.Index(string(res.Output), g.text)
+		if offset == -1 {
+			t.Errorf("%q: unable to locate %q in output", path, g.text)
+			continue
+		}
+		if got := fset.Position(file.Pos(offset)).String(); got != g.want {
+			t.Errorf("%q: position mismatch of %q; expected %q, got %q", path, g.text, g.want, got)
+		}
+	}
+}
+
+func TestPreprocessError(t *testing.T) {
+	const path = "../testdata/extra/preproc/errors.c"
+	const want = `(../testdata/extra/preproc/errors.c:1:1) error: unable to locate include file "missing.h"
+#include "missing.h"
+^
+(../testdata/extra/preproc/errors.c:3:9) error: macro "ADD" requires 2 arguments, but 1 given
+int x = ADD(1);
+        ^
+(../testdata/extra/preproc/errors.c:5:9) error: macro "ADD" requires 2 arguments, but 1 given
+int y = ADD_OF 1);
+        ^
+(../testdata/extra/preproc/errors.c:6:9) error: macro "ADD" requires 2 arguments, but 3 given
+int z = ADD(1,
+        ^
+(../testdata/extra/preproc/errors.c:8:1) error: invalid preprocessing directive #foo
+#foo
+^
+(../testdata/extra/preproc/errors.c:9:1) error: missing expression in #if
+#if 1 +
+^
+(../testdata/extra/preproc/errors.c:11:1) error: #else without #if
+#else
+^
+(../testdata/extra/preproc/errors.c:12:1) error: unterminated conditional directive
+#ifdef X
+^`
+	errors.UseColor = false
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = preproc.Preprocess(path, buf, includePaths)
+	if err == nil {
+		t.Fatalf("%q: expected error, got nil", path)
+	}
+	if got := err.Error(); got != want {
+		t.Errorf("%q: error mismatch; expected %q, got %q", path, want, got)
+	}
+}
@@ -0,0 +1,160 @@
+package preproc
+
+import "strings"
+
+// tokenKind specifies the kind of a preprocessing token.
+type tokenKind int
+
+// Preprocessing token kinds.
+const (
+	// White space characters.
+	tokSpace tokenKind = iota
+	// Block and line comments.
+	tokComment
+	// Identifiers and keywords.
+	tokIdent
+	// Preprocessing numbers; e.g. 42, 0x2A.
+	tokNumber
+	// Character and string literals.
+	tokLit
+	// Punctuators, and any other character.
+	tokPunct
+)
+
+// A ppToken represents a preprocessing token (§6.4).
+type ppToken struct {
+	// Token kind.
+	kind tokenKind
+	// Token text.
+	text string
+	// Line (0-based) of the token in the file.
+	line int
+	// Column (0-based) of the token in the line.
+	col int
+	// Hide set of the token; i.e. the names of the macros which are not
+	// replaced when rescanning the token, as the token originates from their
+	// replacement lists.
+	hide map[string]bool
+	// Specifies whether the token begins or follows a macro replacement, and is
+	// separated from the preceding token if they would otherwise be joined.
+	sep bool
+}
+
+// puncts specifies the multi-character punctuators recognized by the
+// preprocessor.
+var puncts = []string{"##", "&&", "||", "==", "!=", "<=", ">=", "<<", ">>"}
+
+// tokenize splits the given line into preprocessing tokens. The inComment
+// argument specifies whether the line starts within a block comment.
+func tokenize(line string, inComment bool) []ppToken {
+	var toks []ppToken
+	for i := 0; i < len(line); {
+		start := i
+		var kind tokenKind
+		switch c := line[i]; {
+		case inComment && i == 0:
+			kind = tokComment
+			i = commentEnd(line, i)
+		case strings.HasPrefix(line[i:], "/*"):
+			kind = tokComment
+			i = commentEnd(line, i+2)
+		case strings.HasPrefix(line[i:], "//"):
+			kind = tokComment
+			i = len(line)
+		case isSpace(c):
+			kind = tokSpace
+			for i < len(line) && isSpace(line[i]) {
+				i++
+			}
+		case isLetter(c):
+			kind = tokIdent
+			for i < len(line) && (isLetter(line[i]) || isDigit(line[i])) {
+				i++
+			}
+		case isDigit(c) || (c == '.' && i+1 < len(line) && isDigit(line[i+1])):
+			kind = tokNumber
+			for i < len(line) && (isLetter(line[i]) || isDigit(line[i]) || line[i] == '.') {
+				i++
+			}
+		case c == '"' || c == '\'':
+			kind = tokLit
+			for i++; i < len(line) && line[i] != c; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+			if i < len(line) {
+				// Skip terminating quote.
+				i++
+			}
+		default:
+			kind = tokPunct
+			i++
+			for _, punct := range puncts {
+				if strings.HasPrefix(line[start:], punct) {
+					i = start + len(punct)
+					break
+				}
+			}
+		}
+		if i > len(line) {
+			i = len(line)
+		}
+		toks = append(toks, ppToken{kind: kind, text: line[start:i], col: start})
+	}
+	return toks
+}
+
+// commentEnd returns the offset after the end of the block comment with
+// contents starting at the given offset of the line; or the length of the line
+// if the comment is not terminated on the line.
+func commentEnd(line string, start int) int {
+	if end := strings.Index(line[start:], "*/"); end != -1 {
+		return start + end + len("*/")
+	}
+	return len(line)
+}
+
+// skipSpace returns the index of the first non-white space token, starting at
+// the given index.
+func skipSpace(toks []ppToken, i int) int {
+	for i < len(toks) && isBlank(toks[i]) {
+		i++
+	}
+	return i
+}
+
+// trimSpace returns the given tokens without leading and trailing white space
+// tokens.
+func trimSpace(toks []ppToken) []ppToken {
+	start := skipSpace(toks, 0)
+	end := len(toks)
+	for end > start && isBlank(toks[end-1]) {
+		end--
+	}
+	return toks[start:end]
+}
+
+// isBlank reports whether the given token is a white space or comment token.
+func isBlank(tok ppToken) bool {
+	return tok.kind == tokSpace || tok.kind == tokComment
+}
+
+// isSpace reports whether the given character is a white space character.
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
+
+// isLetter reports whether the given character is a letter or underscore.
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+// isDigit reports whether the given character is a decimal digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -1,30 +1,85 @@
-// Package errors provides pretty-printing of semantic analysis errors.
+// Package errors provides pretty-printing of semantic analysis errors,
+// warnings and notes.
 package errors
 
 import (
+	"bytes"
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/mewkiz/pkg/term"
+	"github.com/mewmew/uc/token"
 )
 
 // UseColor indicates if error messages should use colors.
 var UseColor = true
 
-// An Error represents a semantic analysis error.
+// NoWarnings indicates if warnings should be suppressed; warnings are dropped
+// when added to a list of errors.
+var NoWarnings = false
+
+// WarningsAsErrors indicates if warnings should be treated as errors; warnings
+// are promoted to errors when added to a list of errors.
+var WarningsAsErrors = false
+
+// Severity specifies the severity of a diagnostic.
+type Severity int
+
+// Diagnostic severities.
+const (
+	// An error prevents the compilation of the input source.
+	SeverityError Severity = iota
+	// A warning reports questionable constructs of the input source.
+	SeverityWarning
+	// A note provides additional information about a related error or warning.
+	SeverityNote
+)
+
+// String returns the string representation of the severity, as used in
+// diagnostic messages.
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityNote:
+		return "note"
+	default:
+		return fmt.Sprintf("unknown severity (%d)", int(severity))
+	}
+}
+
+// color returns the given text colored based on the severity.
+func (severity Severity) color(text string) string {
+	switch severity {
+	case SeverityWarning:
+		return term.MagentaBold(text)
+	case SeverityNote:
+		return term.CyanBold(text)
+	default:
+		return term.RedBold(text)
+	}
+}
+
+// An Error represents a semantic analysis diagnostic; i.e. an error, a warning
+// or a note.
 type Error struct {
-	// Input source position (in bytes).
-	Pos int
+	// Input source position.
+	Pos token.Pos
+	// Severity of the diagnostic.
+	Severity Severity
 	// Error message.
 	Text string
 	// Input source.
 	Src *Source
+	// Related notes of the diagnostic; e.g. the location of a previous
+	// definition.
+	Notes []*Error
 }
 
-// New returns a new error based on the given positional information (offset in
-// bytes).
-func New(pos int, text string) *Error {
+// New returns a new error based on the given positional information.
+func New(pos token.Pos, text string) *Error {
 	err := &Error{
 		Pos:  pos,
 		Text: text,
@@ -32,9 +87,9 @@ func New(pos int, text string) *Error {
 	return err
 }
 
-// Newf returns a new formatted error based on the given positional information
-// (offset in bytes).
-func Newf(pos int, format string, a ...interface{}) *Error {
+// Newf returns a new formatted error based on the given positional
+// information.
+func Newf(pos token.Pos, format string, a ...interface{}) *Error {
 	err := &Error{
 		Pos:  pos,
 		Text: fmt.Sprintf(format, a...),
@@ -42,43 +97,85 @@ func Newf(pos int, format string, a ...interface{}) *Error {
 	return err
 }
 
-// Error returns an error string with position information.
+// Warningf returns a new formatted warning based on the given positional
+// information.
+func Warningf(pos token.Pos, format string, a ...interface{}) *Error {
+	err := &Error{
+		Pos:      pos,
+		Severity: SeverityWarning,
+		Text:     fmt.Sprintf(format, a...),
+	}
+	return err
+}
+
+// Notef returns a new formatted note based on the given positional
+// information.
+func Notef(pos token.Pos, format string, a ...interface{}) *Error {
+	err := &Error{
+		Pos:      pos,
+		Severity: SeverityNote,
+		Text:     fmt.Sprintf(format, a...),
+	}
+	return err
+}
+
+// AddNote attaches the given note to the diagnostic.
+func (e *Error) AddNote(note *Error) {
+	e.Notes = append(e.Notes, note)
+}
+
+// Error returns an error string with position information, followed by the
+// related notes of the diagnostic.
 //
 // The error format is as follows.
 //
-//    (file:line:column): error: text
+//    (file:line:column) error: text
 func (e *Error) Error() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString(e.format(e.Src))
+	for _, note := range e.Notes {
+		src := note.Src
+		if src == nil {
+			// Notes share the input source of their diagnostic by default.
+			src = e.Src
+		}
+		buf.WriteString("\n")
+		buf.WriteString(note.format(src))
+	}
+	return buf.String()
+}
+
+// format returns a string representation of the diagnostic, excluding notes,
+// with position information based on the given input source.
+func (e *Error) format(src *Source) string {
 	// Use colors.
 	pos := fmt.Sprintf("(byte offset %d)", e.Pos)
-	prefix := "error:"
+	prefix := e.Severity.String() + ":"
 	text := e.Text
 	if UseColor {
 		pos = term.Color(pos, term.Bold)
-		prefix = term.RedBold(prefix)
+		prefix = e.Severity.color(prefix)
 		text = term.Color(text, term.Bold)
 	}
-	src := e.Src
-	if src == nil {
-		// If Src is nil, the error format is as follows.
+	if src == nil || !e.Pos.IsValid() {
+		// If Src is nil or the position is invalid, the error format is as
+		// follows.
 		//
 		//    (byte offset %d) error: text
 		return fmt.Sprintf("%s %s %s", pos, prefix, text)
 	}
 	// The error format is as follows.
 	//
-	//    (file:line) error: text
+	//    (file:line:column) error: text
 	//       1 = y
 	//         ^
-	line, col := src.Position(e.Pos)
-	end := len(src.Input)
-	if len(src.Lines) > line {
-		end = src.Lines[line]
-	}
-	srcLine := src.Input[src.Lines[line-1]:end]
-	srcLine = strings.Replace(srcLine, "\t", " ", -1)
-	srcLine = strings.TrimRight(srcLine, "\n\r")
-	arrow := fmt.Sprintf("%*s", col, "^")
-	pos = fmt.Sprintf("(%s:%d)", src.Path, line)
+	//
+	// The position is adjusted to the original source of preprocessed input,
+	// while the source line is located in the preprocessed input.
+	position := src.Position(e.Pos)
+	srcLine := src.Line(src.File.PositionFor(e.Pos, false).Line)
+	arrow := fmt.Sprintf("%*s", position.Column, "^")
+	pos = fmt.Sprintf("(%v)", position)
 	if UseColor {
 		pos = term.Color(pos, term.Bold)
 		arrow = term.Color(arrow, term.Bold)
@@ -88,39 +185,47 @@ func (e *Error) Error() string {
 
 // A Source represents an input source.
 type Source struct {
-	// Input source path (file path or <stdin>).
-	Path string
+	// Input source file; tracks the input source path (file path or <stdin>)
+	// and the start positions of lines within the input stream.
+	File *token.File
 	// Input source text.
 	Input string
-	// Lines tracks the start positions of lines within the input stream.
-	Lines []int
 }
 
-// Position returns the corresponding line:column pair of the given position in
-// the input stream.
-func (src *Source) Position(pos int) (line, column int) {
-	// Implemented using binary search, lines should be sorted in ascending
-	// order.
-	index := sort.SearchInts(src.Lines, pos+1) - 1
-	line = index + 1
-	column = pos - src.Lines[index] + 1
-	return line, column
+// Position returns the corresponding file:line:column position of the given
+// position in the input stream.
+func (src *Source) Position(pos token.Pos) token.Position {
+	return src.File.Position(pos)
+}
+
+// Line returns the contents of the given line (1-based) of the input source,
+// with tabs replaced by spaces and without the trailing new line.
+func (src *Source) Line(line int) string {
+	start := src.File.LineStart(line)
+	end := len(src.Input)
+	if line < src.File.LineCount() {
+		end = src.File.LineStart(line + 1)
+	}
+	srcLine := src.Input[start:end]
+	srcLine = strings.Replace(srcLine, "\t", " ", -1)
+	return strings.TrimRight(srcLine, "\n\r")
 }
 
 // NewSource returns a new source based on the given input. The path is only
 // used in error messages, and "<stdin>" is conventionally used for the standard
 // input stream.
 func NewSource(path, input string) *Source {
+	file := token.NewFile(path, len(input))
+	return NewFileSource(file, input)
+}
+
+// NewFileSource returns a new source based on the given input, which holds the
+// contents of the given file of a file set.
+func NewFileSource(file *token.File, input string) *Source {
+	file.SetLinesForContent([]byte(input))
 	src := &Source{
-		Path:  path,
+		File:  file,
 		Input: input,
 	}
-	for i := 0; i < len(input); {
-		src.Lines = append(src.Lines, i)
-		pos := strings.IndexRune(input[i:], '\n')
-		if pos != -1 {
-			i += pos + 1
-		}
-	}
 	return src
 }
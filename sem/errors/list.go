@@ -0,0 +1,115 @@
+package errors
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/token"
+)
+
+// MaxErrors specifies the maximum number of errors printed by List.Error; a
+// value of 0 prints every error.
+var MaxErrors = 20
+
+// A List represents a list of semantic analysis errors.
+type List []*Error
+
+// Add appends the given error to the list. Lists of errors are flattened, and
+// errors wrapped by errutil are unwrapped to locate the underlying semantic
+// analysis error. Errors of other types are added without positional
+// information.
+//
+// Warnings are dropped if NoWarnings is set, and promoted to errors if
+// WarningsAsErrors is set.
+func (list *List) Add(err error) {
+	if e, ok := err.(*errutil.ErrInfo); ok {
+		// Unwrap errutil error.
+		err = e.Err
+	}
+	switch err := err.(type) {
+	case *Error:
+		if err.Severity == SeverityWarning {
+			if NoWarnings {
+				return
+			}
+			if WarningsAsErrors {
+				err.Severity = SeverityError
+			}
+		}
+		*list = append(*list, err)
+	case List:
+		for _, e := range err {
+			list.Add(e)
+		}
+	default:
+		*list = append(*list, New(token.NoPos, err.Error()))
+	}
+}
+
+// Len returns the number of errors in the list.
+func (list List) Len() int {
+	return len(list)
+}
+
+// Less reports whether the error at index i precedes the error at index j in
+// the input source.
+func (list List) Less(i, j int) bool {
+	return list[i].Pos < list[j].Pos
+}
+
+// Swap swaps the errors at index i and j.
+func (list List) Swap(i, j int) {
+	list[i], list[j] = list[j], list[i]
+}
+
+// Sort sorts the list of errors by position, while maintaining the relative
+// order of errors at the same position.
+func (list List) Sort() {
+	sort.Stable(list)
+}
+
+// SetSource sets the input source of every error in the list.
+func (list List) SetSource(src *Source) {
+	for _, err := range list {
+		err.Src = src
+	}
+}
+
+// HasErrors reports whether the list contains any diagnostic of error
+// severity.
+func (list List) HasErrors() bool {
+	for _, err := range list {
+		if err.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error equivalent to the list of errors; or nil if the list is
+// empty.
+func (list List) Err() error {
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+// Error returns an error string of the first MaxErrors errors in the list,
+// separated by new lines.
+func (list List) Error() string {
+	buf := new(bytes.Buffer)
+	for i, err := range list {
+		if MaxErrors > 0 && i == MaxErrors {
+			fmt.Fprintf(buf, "\ntoo many diagnostics (%d more)", len(list)-MaxErrors)
+			break
+		}
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(err.Error())
+	}
+	return buf.String()
+}
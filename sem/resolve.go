@@ -1,44 +1,51 @@
 package sem
 
 import (
+	"fmt"
+
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // universePos specifies a pseudo-position used for identifiers declared in the
 // universe scope.
-const universePos = -1
+const universePos = token.NoPos
 
 // resolve performs identifier resolution, mapping identifiers to corresponding
-// declarations.
+// declarations. Resolution continues after errors, and the returned error is
+// an errors.List of every error encountered.
 func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
+	// errs records the errors encountered during identifier resolution.
+	var errs errors.List
+
 	// TODO: Verify that type keywords cannot be redeclared.
 
 	// Pre-pass, add keyword types and universe scope.
+	//
+	// Type keywords consisting of several keywords (e.g. "unsigned long") are
+	// parsed into a single identifier of the canonical type name.
 	universe := NewScope(nil)
-	charIdent := &ast.Ident{NamePos: universePos, Name: "char"}
-	charDecl := &ast.TypeDef{DeclType: charIdent, TypeName: charIdent, Val: &types.Basic{Kind: types.Char}}
-	charIdent.Decl = charDecl
-	intIdent := &ast.Ident{NamePos: universePos, Name: "int"}
-	intDecl := &ast.TypeDef{DeclType: intIdent, TypeName: intIdent, Val: &types.Basic{Kind: types.Int}}
-	intIdent.Decl = intDecl
-	voidIdent := &ast.Ident{NamePos: universePos, Name: "void"}
-	voidDecl := &ast.TypeDef{DeclType: voidIdent, TypeName: voidIdent, Val: &types.Basic{Kind: types.Void}}
-	voidIdent.Decl = voidDecl
-	universeDecls := []*ast.TypeDef{
-		charDecl,
-		intDecl,
-		voidDecl,
-	}
-	for _, decl := range universeDecls {
+	for _, kind := range types.BasicKinds {
+		typ := &types.Basic{Kind: kind}
+		ident := &ast.Ident{NamePos: universePos, Name: typ.String()}
+		decl := &ast.TypeDef{DeclType: ident, TypeName: ident, Val: typ}
+		ident.Decl = decl
 		if err := universe.Insert(decl); err != nil {
 			return errutil.Err(err)
 		}
 	}
 
+	// Undeclared identifiers are declared with an invalid type, so that
+	// subsequent uses of the identifier in the same scope are not reported
+	// again, and later passes may continue past the error.
+	invalidIdent := &ast.Ident{NamePos: universePos, Name: "invalid type"}
+	invalidDecl := &ast.TypeDef{DeclType: invalidIdent, TypeName: invalidIdent, Val: &types.Basic{Kind: types.Invalid}}
+	invalidIdent.Decl = invalidDecl
+
 	// First pass, add global declarations to file scope.
 	fileScope := NewScope(universe)
 	scopes[file] = fileScope
@@ -48,8 +55,15 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 		return decl.Value() != nil
 	}
 	for _, decl := range file.Decls {
+		// Enumeration constants declared within the type of a global
+		// declaration precede the declaration itself, in source order.
+		for _, c := range enumConsts(decl) {
+			if err := fileScope.Insert(c); err != nil {
+				errs.Add(err)
+			}
+		}
 		if err := fileScope.Insert(decl); err != nil {
-			return errutil.Err(err)
+			errs.Add(err)
 		}
 	}
 
@@ -62,25 +76,63 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 	// scope specifies the current lexical scope.
 	scope := fileScope
 
+	// fieldScopes records the scopes of structure fields. Structure and
+	// enumeration tags and enumeration constants declared within a structure
+	// type are declared in the enclosing scope.
+	fieldScopes := make(map[*Scope]bool)
+	// tagScope returns the innermost scope enclosing the current scope which is
+	// not the scope of structure fields.
+	tagScope := func() *Scope {
+		s := scope
+		for fieldScopes[s] {
+			s = s.Outer
+		}
+		return s
+	}
+
+	// forward specifies the structure type of the current structure declaration
+	// without declarator (e.g. `struct node;`), if any.
+	var forward *ast.StructType
+
+	// funcScopes specifies the scopes of the enclosing function definitions,
+	// which hold the labels of each function; and gotos the goto statements of
+	// each enclosing function definition, which are resolved at the end of the
+	// function body to allow for forward references to labels.
+	var funcScopes []*Scope
+	var gotos [][]*ast.GotoStmt
+
 	// resolve performs identifier resolution, mapping identifiers to the
 	// corresponding declarations of the closest lexical scope.
 	resolve := func(n ast.Node) error {
 		switch n := n.(type) {
+		case *ast.EnumConst:
+			// Enumeration constants are inserted into the scope after their
+			// value expressions have been resolved, as the scope of an
+			// enumeration constant begins after its enumerator.
 		case ast.Decl:
+			// Structure declarations without declarator declare incomplete
+			// structure types.
+			if decl, ok := n.(*ast.VarDecl); ok && decl.VarName == nil {
+				if typ, ok := decl.VarType.(*ast.StructType); ok && !typ.Lbrace.IsValid() {
+					forward = typ
+				}
+			}
 			// Insert declaration into the scope if not already added by the
 			// file scope pre-pass.
 			if scope != fileScope {
 				if err := scope.Insert(n); err != nil {
-					return errutil.Err(err)
+					errs.Add(err)
 				}
 			}
 			// Create nested scope for function definitions.
 			if fn, ok := n.(*ast.FuncDecl); ok {
+				scope = NewScope(scope)
+				scopes[fn] = scope
 				if astutil.IsDef(fn) {
 					skip = true
+					funcScopes = append(funcScopes, scope)
+					gotos = append(gotos, nil)
 				}
-				scope = NewScope(scope)
-				scopes[fn] = scope
 			}
 		case *ast.BlockStmt:
 			if !skip {
@@ -88,22 +140,116 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 				scopes[n] = scope
 			}
 			skip = false
+		case *ast.StructType:
+			if n.Tag != nil {
+				s := tagScope()
+				var def *ast.TypeDef
+				switch {
+				case n.Lbrace.IsValid():
+					// Structure definition, which completes the incomplete
+					// structure type of a previous declaration in the same scope.
+					def = &ast.TypeDef{DeclType: n, TypeName: n.Tag}
+					if prev, ok := s.Tags[n.Tag.Name]; ok && isIncompleteStruct(prev) {
+						prev.DeclType = n
+						def = prev
+					} else if err := s.InsertTag(def); err != nil {
+						errs.Add(err)
+					}
+				case n == forward:
+					// Structure declaration without declarator, which declares an
+					// incomplete structure type in the current scope, unless
+					// already declared in the same scope.
+					var ok bool
+					if def, ok = s.Tags[n.Tag.Name]; !ok {
+						def = &ast.TypeDef{DeclType: n, TypeName: n.Tag}
+						s.Tags[n.Tag.Name] = def
+					}
+				default:
+					// Use of a structure type, which declares an incomplete
+					// structure type if not yet declared; e.g. `struct node *next;`.
+					var ok bool
+					if def, ok = s.LookupTag(n.Tag.Name); !ok {
+						def = &ast.TypeDef{DeclType: n, TypeName: n.Tag}
+						s.Tags[n.Tag.Name] = def
+					}
+				}
+				if _, ok := def.DeclType.(*ast.StructType); !ok {
+					errs.Add(errors.Newf(n.Tag.Start(), "%q defined as wrong kind of tag", "struct "+n.Tag.Name))
+					def = invalidDecl
+				}
+				n.Tag.Decl = def
+			}
+			// Create nested scope for structure fields.
+			scope = NewScope(scope)
+			fieldScopes[scope] = true
+		case *ast.EnumType:
+			if n.Tag != nil {
+				if n.Lbrace.IsValid() {
+					// Enumeration definition.
+					def := &ast.TypeDef{DeclType: n, TypeName: n.Tag}
+					if err := tagScope().InsertTag(def); err != nil {
+						errs.Add(err)
+					}
+					n.Tag.Decl = def
+				} else {
+					def, ok := tagScope().LookupTag(n.Tag.Name)
+					if !ok {
+						errs.Add(errors.Newf(n.Tag.Start(), "undeclared enumeration %q", "enum "+n.Tag.Name))
+						def = invalidDecl
+					} else if _, ok := def.DeclType.(*ast.EnumType); !ok {
+						errs.Add(errors.Newf(n.Tag.Start(), "%q defined as wrong kind of tag", "enum "+n.Tag.Name))
+						def = invalidDecl
+					}
+					n.Tag.Decl = def
+				}
+			}
+		case *ast.LabeledStmt:
+			if err := funcScopes[len(funcScopes)-1].InsertLabel(n); err != nil {
+				errs.Add(err)
+			}
+		case *ast.GotoStmt:
+			gotos[len(gotos)-1] = append(gotos[len(gotos)-1], n)
 		case *ast.Ident:
 			decl, ok := scope.Lookup(n.Name)
 			if !ok {
-				return errors.Newf(n.Start(), "undeclared identifier %q", n)
+				errs.Add(errors.Newf(n.Start(), "undeclared identifier %q", n))
+				name := &ast.Ident{NamePos: n.NamePos, Name: n.Name}
+				decl = &ast.VarDecl{VarType: invalidIdent, VarName: name}
+				name.Decl = decl
+				scope.Decls[n.Name] = decl
 			}
 			n.Decl = decl
 		}
 		return nil
 	}
 
-	// after reverts to the outer scope after traversing block statements.
+	// after reverts to the outer scope after traversing block statements, and
+	// inserts enumeration constants into the current scope, unless already added
+	// by the file scope pre-pass.
 	after := func(n ast.Node) error {
-		if _, ok := n.(*ast.BlockStmt); ok {
+		if c, ok := n.(*ast.EnumConst); ok {
+			if s := tagScope(); s != fileScope {
+				if err := s.Insert(c); err != nil {
+					errs.Add(err)
+				}
+			}
+		} else if _, ok := n.(*ast.BlockStmt); ok {
+			scope = scope.Outer
+		} else if _, ok := n.(*ast.StructType); ok {
 			scope = scope.Outer
 		} else if fn, ok := n.(*ast.FuncDecl); ok && !astutil.IsDef(fn) {
 			scope = scope.Outer
+		} else if ok {
+			// Resolve the labels of goto statements within the function
+			// definition.
+			labels := funcScopes[len(funcScopes)-1].Labels
+			for _, stmt := range gotos[len(gotos)-1] {
+				if _, ok := labels[stmt.Label.Name]; !ok {
+					errs.Add(errors.Newf(stmt.Label.Start(), "use of undeclared label %q", stmt.Label))
+				}
+			}
+			funcScopes = funcScopes[:len(funcScopes)-1]
+			gotos = gotos[:len(gotos)-1]
 		}
 		return nil
 	}
@@ -113,5 +259,37 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 		return errutil.Err(err)
 	}
 
-	return nil
+	return errs.Err()
+}
+
+// enumConsts returns the enumeration constants declared within the type of the
+// given variable declaration or type definition, in order of occurrence.
+func enumConsts(decl ast.Decl) []*ast.EnumConst {
+	var typ ast.Type
+	switch decl := decl.(type) {
+	case *ast.VarDecl:
+		typ = decl.VarType
+	case *ast.TypeDef:
+		typ = decl.DeclType
+	default:
+		return nil
+	}
+	var consts []*ast.EnumConst
+	f := func(n ast.Node) error {
+		if c, ok := n.(*ast.EnumConst); ok {
+			consts = append(consts, c)
+		}
+		return nil
+	}
+	if err := astutil.Walk(typ, f); err != nil {
+		panic(fmt.Sprintf("unable to walk type; %v", err))
+	}
+	return consts
+}
+
+// isIncompleteStruct reports whether the given tag definition declares an
+// incomplete structure type; i.e. a structure type without definition.
+func isIncompleteStruct(def *ast.TypeDef) bool {
+	typ, ok := def.DeclType.(*ast.StructType)
+	return ok && !typ.Lbrace.IsValid()
 }
@@ -14,6 +14,13 @@ type Scope struct {
 	Outer *Scope
 	// Identifiers declared within the current scope.
 	Decls map[string]ast.Decl
+	// Structure tags declared within the current scope, mapped to the type
+	// definitions of the tagged structure types.
+	Tags map[string]*ast.TypeDef
+	// Labels declared within the current scope, mapped to their labeled
+	// statements. Labels have function scope, and are only declared within the
+	// scopes of function definitions.
+	Labels map[string]*ast.LabeledStmt
 	// IsDef reports whether the given declaration is a definition.
 	IsDef func(ast.Decl) bool
 }
@@ -22,9 +29,11 @@ type Scope struct {
 // scope.
 func NewScope(outer *Scope) *Scope {
 	return &Scope{
-		Outer: outer,
-		Decls: make(map[string]ast.Decl),
-		IsDef: astutil.IsDef,
+		Outer:  outer,
+		Decls:  make(map[string]ast.Decl),
+		Tags:   make(map[string]*ast.TypeDef),
+		Labels: make(map[string]*ast.LabeledStmt),
+		IsDef:  astutil.IsDef,
 	}
 }
 
@@ -33,7 +42,8 @@ func (s *Scope) Insert(decl ast.Decl) error {
 	// Early return for first-time declarations.
 	ident := decl.Name()
 	if ident == nil {
-		// Anonymous function parameter declaration.
+		// Anonymous function parameter declaration, or enumeration type
+		// declaration.
 		return nil
 	}
 	name := ident.String()
@@ -49,9 +59,21 @@ func (s *Scope) Insert(decl ast.Decl) error {
 		return nil
 	}
 
+	// Enumeration constants may not be redeclared, and may not share the name
+	// of other identifiers in the same scope.
+	if isEnumConst(prev) || isEnumConst(decl) {
+		err := errors.Newf(ident.Start(), "redefinition of %q", name)
+		err.AddNote(errors.Notef(prevIdent.Start(), "previous definition of %q", name))
+		return err
+	}
+
 	// Previously declared.
 	if !types.Equal(prev.Type(), decl.Type()) {
-		return errors.Newf(ident.Start(), "redefinition of %q with type %q instead of %q", name, decl.Type(), prev.Type())
+		err := errors.Newf(ident.Start(), "redefinition of %q with type %q instead of %q", name, decl.Type(), prev.Type())
+		if prevIdent.Start().IsValid() {
+			err.AddNote(errors.Notef(prevIdent.Start(), "previous declaration of %q", name))
+		}
+		return err
 	}
 
 	// The last tentative definition becomes the definition, unless defined
@@ -63,12 +85,11 @@ func (s *Scope) Insert(decl ast.Decl) error {
 
 	// Definition already present in scope.
 	if s.IsDef(decl) {
-		// TODO: Consider adding support for multiple errors (and potentially
-		// warnings and notifications).
-		//
-		// If support for notifications are added, add a note of the previous declaration.
-		//    errors.Notef(prevIdent.Start(), "previous definition of %q", name)
-		return errors.Newf(ident.Start(), "redefinition of %q", name)
+		err := errors.Newf(ident.Start(), "redefinition of %q", name)
+		if prevIdent.Start().IsValid() {
+			err.AddNote(errors.Notef(prevIdent.Start(), "previous definition of %q", name))
+		}
+		return err
 	}
 
 	// Declaration of previously declared identifier.
@@ -86,3 +107,59 @@ func (s *Scope) Lookup(name string) (ast.Decl, bool) {
 	}
 	return s.Outer.Lookup(name)
 }
+
+// InsertTag inserts the given structure or enumeration tag definition into the
+// current scope.
+func (s *Scope) InsertTag(def *ast.TypeDef) error {
+	tag := def.TypeName
+	name := tag.String()
+	if prev, ok := s.Tags[name]; ok {
+		err := errors.Newf(tag.Start(), "redefinition of %q", TagName(def))
+		err.AddNote(errors.Notef(prev.TypeName.Start(), "previous definition of %q", TagName(prev)))
+		return err
+	}
+	s.Tags[name] = def
+	return nil
+}
+
+// LookupTag returns the type definition of the structure or enumeration tag in
+// the innermost scope of s. The returned boolean variable reports whether a
+// definition of the tag was located.
+func (s *Scope) LookupTag(tag string) (*ast.TypeDef, bool) {
+	if def, ok := s.Tags[tag]; ok {
+		return def, true
+	}
+	if s.Outer == nil {
+		return nil, false
+	}
+	return s.Outer.LookupTag(tag)
+}
+
+// TagName returns the tag of the given structure or enumeration tag definition,
+// prefixed by its keyword; e.g. "struct point" or "enum color".
+func TagName(def *ast.TypeDef) string {
+	if _, ok := def.DeclType.(*ast.EnumType); ok {
+		return "enum " + def.TypeName.Name
+	}
+	return "struct " + def.TypeName.Name
+}
+
+// isEnumConst reports whether the given declaration is an enumeration constant.
+func isEnumConst(decl ast.Decl) bool {
+	_, ok := decl.(*ast.EnumConst)
+	return ok
+}
+
+// InsertLabel inserts the label of the given labeled statement into the current
+// scope.
+func (s *Scope) InsertLabel(stmt *ast.LabeledStmt) error {
+	label := stmt.Label
+	name := label.String()
+	if prev, ok := s.Labels[name]; ok {
+		err := errors.Newf(label.Start(), "redefinition of label %q", name)
+		err.AddNote(errors.Notef(prev.Label.Start(), "previous definition of label %q", name))
+		return err
+	}
+	s.Labels[name] = stmt
+	return nil
+}
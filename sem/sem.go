@@ -4,12 +4,17 @@ package sem
 import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/constant"
+	"github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
 	"github.com/mewmew/uc/sem/typecheck"
 	"github.com/mewmew/uc/types"
 )
 
-// Check performs a static semantic analysis check on the given file.
+// Check performs a static semantic analysis check on the given file. The
+// returned error is an errors.List of the diagnostics reported by the semantic
+// analysis passes. If the file contains warnings but no errors, both the
+// semantic information and the list of warnings are returned.
 func Check(file *ast.File) (*Info, error) {
 	// Semantic analysis is done in two passes to allow for forward references.
 	// Firstly, the global declarations are added to the file-scope. Secondly,
@@ -19,20 +24,32 @@ func Check(file *ast.File) (*Info, error) {
 	// Identifier resolution.
 	info := &Info{
 		Types:  make(map[ast.Expr]types.Type),
+		Values: make(map[ast.Expr]constant.Value),
 		Scopes: make(map[ast.Node]*Scope),
 	}
+	// Each pass continues after errors, so that all errors of the file are
+	// reported in one run.
+	var errs errors.List
 	if err := resolve(file, info.Scopes); err != nil {
-		return nil, errutil.Err(err)
+		errs.Add(err)
 	}
 
 	// Type-checking.
-	if err := typecheck.Check(file, info.Types); err != nil {
-		return nil, errutil.Err(err)
+	if err := typecheck.Check(file, info.Types, info.Values); err != nil {
+		errs.Add(err)
 	}
 
 	// Semantic analysis.
 	if err := semcheck.Check(file); err != nil {
-		return nil, errutil.Err(err)
+		errs.Add(err)
+	}
+	if len(errs) > 0 {
+		errs.Sort()
+		if errs.HasErrors() {
+			return nil, errutil.Err(errs)
+		}
+		// Return the semantic information alongside the list of warnings.
+		return info, errutil.Err(errs)
 	}
 
 	return info, nil
@@ -44,6 +61,8 @@ func Check(file *ast.File) (*Info, error) {
 type Info struct {
 	// Types maps expression nodes to types.
 	Types map[ast.Expr]types.Type
+	// Values maps integer constant expression nodes to their values.
+	Values map[ast.Expr]constant.Value
 	// Scopes maps nodes to the scope they define.
 	//
 	// The following nodes define scopes.
@@ -40,7 +40,7 @@ func TestCheckValid(t *testing.T) {
 		src := errors.NewSource(g.path, input)
 
 		p := parser.NewParser()
-		file, err := p.Parse(s)
+		file, err := p.ParseAll(s)
 		if err != nil {
 			t.Error(err)
 			continue
@@ -51,9 +51,9 @@ func TestCheckValid(t *testing.T) {
 			if e, ok := err.(*errutil.ErrInfo); ok {
 				// Unwrap errutil error.
 				err = e.Err
-				if e, ok := err.(*errors.Error); ok {
-					// Unwrap semantic error.
-					e.Src = src
+				if e, ok := err.(errors.List); ok {
+					// Unwrap semantic errors.
+					e.SetSource(src)
 				}
 			}
 			t.Errorf("%q: unexpected error: `%v`", g.path, err.Error())
@@ -68,211 +68,224 @@ func TestCheckError(t *testing.T) {
 	}{
 		{
 			path: "../testdata/quiet/semantic/s02.c",
-			want: `(../testdata/quiet/semantic/s02.c:3) error: missing return at end of non-void function "foo"
+			want: `(../testdata/quiet/semantic/s02.c:3:5) warning: missing return at end of non-void function "foo"
   ; }
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se01.c",
-			want: `(../testdata/incorrect/semantic/se01.c:5) error: undeclared identifier "b"
+			want: `(../testdata/incorrect/semantic/se01.c:5:10) error: undeclared identifier "b"
  a = a + b; // Variable 'b' not defined
          ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se02.c",
-			want: `(../testdata/incorrect/semantic/se02.c:5) error: undeclared identifier "foo"
+			want: `(../testdata/incorrect/semantic/se02.c:5:7) error: undeclared identifier "foo"
   a = foo(a); // Function 'foo' not defined
       ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se03.c",
-			want: `(../testdata/incorrect/semantic/se03.c:3) error: undeclared identifier "output"
+			want: `(../testdata/incorrect/semantic/se03.c:3:3) error: undeclared identifier "output"
   output(0); // Procedure 'output' not defined
   ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se04.c",
-			want: `(../testdata/incorrect/semantic/se04.c:5) error: redefinition of "a" with type "char" instead of "int"
+			want: `(../testdata/incorrect/semantic/se04.c:5:6) error: redefinition of "a" with type "char" instead of "int"
 char a;  // Redeclaration of 'a'
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se04.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se05.c",
-			want: `(../testdata/incorrect/semantic/se05.c:5) error: redefinition of "a" with type "void(void)" instead of "int"
+			want: `(../testdata/incorrect/semantic/se05.c:5:6) error: redefinition of "a" with type "void(void)" instead of "int"
 void a(void) {  // Attempt to redefine variable 'a'
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se05.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se06.c",
-			want: `(../testdata/incorrect/semantic/se06.c:7) error: redefinition of "a"
+			want: `(../testdata/incorrect/semantic/se06.c:7:5) error: redefinition of "a"
 int a(int i) {   // Redeclaration of 'a'
+    ^
+(../testdata/incorrect/semantic/se06.c:3:5) note: previous definition of "a"
+int a(int n) {
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se07.c",
-			want: `(../testdata/incorrect/semantic/se07.c:4) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se07.c:4:10) error: returning "int" from a function with incompatible result type "void"
   return 2 * n; // Attempt to return value from procedure
          ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se08.c",
-			want: `(../testdata/incorrect/semantic/se08.c:4) error: returning "void" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se08.c:4:3) error: returning "void" from a function with incompatible result type "int"
   return;  // Void return from function
   ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se09.c",
-			want: `(../testdata/incorrect/semantic/se09.c:6) error: returning "char[1]" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se09.c:6:15) error: returning "char[1]" from a function with incompatible result type "int"
   else return x;    // Return from function with erroneous type
               ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se10.c",
-			want: `(../testdata/incorrect/semantic/se10.c:6) error: invalid operation: n[2] (type "int" does not support indexing)
+			want: `(../testdata/incorrect/semantic/se10.c:6:4) error: invalid operation: n[2] (type "int" does not support indexing)
   n[2]; // Index an integer
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se11.c",
-			want: `(../testdata/incorrect/semantic/se11.c:4) error: cannot assign to "a" of type "int(void)"
+			want: `(../testdata/incorrect/semantic/se11.c:4:5) error: cannot assign to "a" of type "int(void)"
   a = 1; // 'a' is not an lval
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se12.c",
-			want: `(../testdata/incorrect/semantic/se12.c:6) error: cannot call non-function "a" of type "int"
+			want: `(../testdata/incorrect/semantic/se12.c:6:4) error: cannot call non-function "a" of type "int"
   a(2); // 'a' is not a function
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se13.c",
-			want: `(../testdata/incorrect/semantic/se13.c:8) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
+			want: `(../testdata/incorrect/semantic/se13.c:8:5) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
   1 + foo(0); // 'foo' does not return a value
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se14.c",
-			want: `(../testdata/incorrect/semantic/se14.c:12) error: cannot call non-function "f" of type "int"
+			want: `(../testdata/incorrect/semantic/se14.c:12:4) error: cannot call non-function "f" of type "int"
   f(n);  // 'f' refers only to the local variable
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se15.c",
-			want: `(../testdata/incorrect/semantic/se15.c:8) error: calling "q" with too few arguments; expected 3, got 2
+			want: `(../testdata/incorrect/semantic/se15.c:8:8) error: calling "q" with too few arguments; expected 3, got 2
   1 + q(1, 3); // Too few arguments to function 'q'
        ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se16.c",
-			want: `(../testdata/incorrect/semantic/se16.c:9) error: calling "d" with too many arguments; expected 2, got 3
+			want: `(../testdata/incorrect/semantic/se16.c:9:4) error: calling "d" with too many arguments; expected 2, got 3
   d(1, 2, 3); // Too many arguments to function 'd'
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se17.c",
-			want: `(../testdata/incorrect/semantic/se17.c:6) error: invalid operation: hello + 1 (type mismatch between "char[5]" and "int")
-  hello+1; //  Attempt to use char array in arithmetic. (legal in C)
-       ^`,
+			// Valid since the array decays to a pointer to its first element.
+			want: "",
 		},
 		{
 			path: "../testdata/incorrect/semantic/se18.c",
-			want: `(../testdata/incorrect/semantic/se18.c:6) error: cannot assign to "a" of type "char[10]"
+			want: `(../testdata/incorrect/semantic/se18.c:6:5) error: cannot assign to "a" of type "char[10]"
   a = 42;   // assign int to array of char
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se19.c",
-			want: `(../testdata/incorrect/semantic/se19.c:5) error: invalid operation: a == 42 (type mismatch between "char[10]" and "int")
+			want: `(../testdata/incorrect/semantic/se19.c:5:8) error: invalid operands to binary expression: a == 42 ("char*" and "int")
   if (a==42) ;
        ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se20.c",
-			want: `(../testdata/incorrect/semantic/se20.c:7) error: cannot assign to "a" of type "int[10]"
+			want: `(../testdata/incorrect/semantic/se20.c:7:4) error: cannot assign to "a" of type "int[10]"
   a=b;
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se21.c",
-			want: `(../testdata/incorrect/semantic/se21.c:5) error: returning "char[10]" from a function with incompatible result type "int"
+			want: `(../testdata/incorrect/semantic/se21.c:5:12) error: returning "char[10]" from a function with incompatible result type "int"
     return bv;  //  Return from function with erroneous type
            ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se22.c",
-			want: `(../testdata/incorrect/semantic/se22.c:6) error: invalid operation: a + 1 (type mismatch between "char[10]" and "int")
-  a+1; // Attempt to apply arithmetic to array reference
-   ^`,
+			// Valid since the array decays to a pointer to its first element.
+			want: "",
 		},
 		{
 			path: "../testdata/incorrect/semantic/se23.c",
-			want: `(../testdata/incorrect/semantic/se23.c:6) error: invalid operation: b[0] (type "int" does not support indexing)
+			want: `(../testdata/incorrect/semantic/se23.c:6:11) error: invalid operation: b[0] (type "int" does not support indexing)
   return b[0]; //not an array!
           ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se24.c",
-			want: `(../testdata/incorrect/semantic/se24.c:6) error: cannot assign to "b" of type "int[10]"
+			want: `(../testdata/incorrect/semantic/se24.c:6:5) error: cannot assign to "b" of type "int[10]"
   b = a;  // b cannot be assigned
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se25.c",
-			want: `(../testdata/incorrect/semantic/se25.c:4) error: cannot assign to "(1 + 2)" of type "int"
+			want: `(../testdata/incorrect/semantic/se25.c:4:11) error: cannot assign to "(1 + 2)" of type "int"
   (1 + 2) = 3; //No assignment here!
           ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se26.c",
-			want: `(../testdata/incorrect/semantic/se26.c:9) error: calling "f" with incompatible argument type "char[10]" to parameter of type "int[]"
+			want: `(../testdata/incorrect/semantic/se26.c:9:5) error: calling "f" with incompatible argument type "char[10]" to parameter of type "int[]"
   f(a);
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se27.c",
-			want: `(../testdata/incorrect/semantic/se27.c:4) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se27.c:4:19) error: returning "int" from a function with incompatible result type "void"
   if (1<2) return 2 * n; // Attempt to return value from procedure
                   ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se28.c",
-			want: `(../testdata/incorrect/semantic/se28.c:5) error: returning "int" from a function with incompatible result type "void"
+			want: `(../testdata/incorrect/semantic/se28.c:5:16) error: returning "int" from a function with incompatible result type "void"
   else  return 2 * n; // Attempt to return value from procedure
                ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se29.c",
-			want: `(../testdata/incorrect/semantic/se29.c:4) error: redefinition of "n" with type "char" instead of "int"
+			want: `(../testdata/incorrect/semantic/se29.c:4:8) error: redefinition of "n" with type "char" instead of "int"
   char n;
-       ^`,
+       ^
+(../testdata/incorrect/semantic/se29.c:3:13) note: previous declaration of "n"
+void a (int n) {
+            ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se30.c",
-			want: `(../testdata/incorrect/semantic/se30.c:6) error: cannot assign to "a" (type mismatch between "int" and "int[10]")
+			want: `(../testdata/incorrect/semantic/se30.c:6:4) error: cannot assign to "a" (type mismatch between "int" and "int[10]")
   a=b;
    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se31.c",
-			want: `(../testdata/incorrect/semantic/se31.c:5) error: redefinition of "a" with type "void(void)" instead of "int"
+			want: `(../testdata/incorrect/semantic/se31.c:5:6) error: redefinition of "a" with type "void(void)" instead of "int"
 void a(void);   // Attempt to redefine  'a' as extern
-     ^`,
+     ^
+(../testdata/incorrect/semantic/se31.c:3:5) note: previous declaration of "a"
+int a;
+    ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se32.c",
-			want: `(../testdata/incorrect/semantic/se32.c:6) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
+			want: `(../testdata/incorrect/semantic/se32.c:6:5) error: invalid operands to binary expression: 1 + foo(0) ("int" and "void")
   1 + foo(0); // 'foo' does not return a value
     ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se33.c",
-			want: `(../testdata/incorrect/semantic/se33.c:6) error: calling "q" with too few arguments; expected 3, got 2
+			want: `(../testdata/incorrect/semantic/se33.c:6:8) error: calling "q" with too few arguments; expected 3, got 2
   1 + q(1, 3); // Too few arguments to function 'q'
        ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se34.c",
-			want: `(../testdata/incorrect/semantic/se34.c:6) error: calling "d" with too many arguments; expected 2, got 3
+			want: `(../testdata/incorrect/semantic/se34.c:6:4) error: calling "d" with too many arguments; expected 2, got 3
   d(1, 2, 3); // Too many arguments to function 'd'
    ^`,
 		},
@@ -280,82 +293,571 @@ void a(void);   // Attempt to redefine  'a' as extern
 		// Extra test cases.
 		{
 			path: "../testdata/extra/semantic/extra-void-arg.c",
-			want: `(../testdata/extra/semantic/extra-void-arg.c:4) error: "void" must be the only parameter
+			want: `(../testdata/extra/semantic/extra-void-arg.c:4:7) error: "void" must be the only parameter
 void f(int a, void) {
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/incompatible-arg-type.c",
-			want: `(../testdata/extra/semantic/incompatible-arg-type.c:10) error: calling "a" with incompatible argument type "int" to parameter of type "int[]"
+			want: `(../testdata/extra/semantic/incompatible-arg-type.c:10:11) error: calling "a" with incompatible argument type "int" to parameter of type "int[]"
  return a(b);
           ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/index-array.c",
-			want: `(../testdata/extra/semantic/index-array.c:7) error: invalid array index; expected integer, got "int[20]"
+			want: `(../testdata/extra/semantic/index-array.c:7:4) error: invalid array index; expected integer, got "int[20]"
  x[y];
    ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/local-var-redef.c",
-			want: `(../testdata/extra/semantic/local-var-redef.c:6) error: redefinition of "x"
+			want: `(../testdata/extra/semantic/local-var-redef.c:6:6) error: redefinition of "x"
+ int x;
+     ^
+(../testdata/extra/semantic/local-var-redef.c:5:6) note: previous definition of "x"
  int x;
      ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/missing-return.c",
-			want: `(../testdata/extra/semantic/missing-return.c:10) error: missing return at end of non-void function "f"
+			want: `(../testdata/extra/semantic/missing-return.c:10:1) warning: missing return at end of non-void function "f"
+}
+^`,
+		},
+		{
+			path: "../testdata/extra/semantic/missing-return-loop.c",
+			want: `(../testdata/extra/semantic/missing-return-loop.c:32:1) warning: missing return at end of non-void function "f3"
+}
+^
+(../testdata/extra/semantic/missing-return-loop.c:38:1) warning: missing return at end of non-void function "f4"
+}
+^
+(../testdata/extra/semantic/missing-return-loop.c:47:1) warning: missing return at end of non-void function "f5"
+}
+^
+(../testdata/extra/semantic/missing-return-loop.c:53:1) warning: missing return at end of non-void function "f6"
+}
+^`,
+		},
+		{
+			path: "../testdata/extra/semantic/missing-return-switch.c",
+			want: `(../testdata/extra/semantic/missing-return-switch.c:29:1) warning: missing return at end of non-void function "f2"
+}
+^
+(../testdata/extra/semantic/missing-return-switch.c:38:1) warning: missing return at end of non-void function "f3"
 }
 ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/param-redef.c",
-			want: `(../testdata/extra/semantic/param-redef.c:5) error: redefinition of "x"
+			want: `(../testdata/extra/semantic/param-redef.c:5:6) error: redefinition of "x"
  int x;
-     ^`,
+     ^
+(../testdata/extra/semantic/param-redef.c:4:12) note: previous definition of "x"
+void f(int x) {
+           ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/unnamed-arg.c",
-			want: `(../testdata/extra/semantic/unnamed-arg.c:4) error: parameter name obmitted
+			want: `(../testdata/extra/semantic/unnamed-arg.c:4:8) error: parameter name obmitted
 void f(int) {
        ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/variable-sized-array.c",
-			want: `(../testdata/extra/semantic/variable-sized-array.c:5) error: array size or initializer missing for "y"
+			want: `(../testdata/extra/semantic/variable-sized-array.c:5:7) error: array size or initializer missing for "y"
  char y[];
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-array.c",
-			want: `(../testdata/extra/semantic/void-array.c:5) error: invalid element type "void" of array "x"
+			want: `(../testdata/extra/semantic/void-array.c:5:7) error: invalid element type "void" of array "x"
  void x[10];
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-array-arg.c",
-			want: `(../testdata/extra/semantic/void-array-arg.c:4) error: invalid element type "void" of array "x"
+			want: `(../testdata/extra/semantic/void-array-arg.c:4:13) error: invalid element type "void" of array "x"
 void f(void x[]) {
             ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-param.c",
-			want: `(../testdata/extra/semantic/void-param.c:4) error: "x" has invalid type "void"
+			want: `(../testdata/extra/semantic/void-param.c:4:13) error: "x" has invalid type "void"
 void f(void x) {
             ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-params.c",
-			want: `(../testdata/extra/semantic/void-params.c:4) error: "void" must be the only parameter
+			want: `(../testdata/extra/semantic/void-params.c:4:7) error: "void" must be the only parameter
 void f(void, void) {
       ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/void-var.c",
-			want: `(../testdata/extra/semantic/void-var.c:5) error: "x" has invalid type "void"
+			want: `(../testdata/extra/semantic/void-var.c:5:7) error: "x" has invalid type "void"
  void x;
       ^`,
 		},
+		{
+			path: "../testdata/extra/semantic/multiple-errors.c",
+			want: `(../testdata/extra/semantic/multiple-errors.c:7:6) error: undeclared identifier "y"
+ x = y + 1;    // Variable 'y' not defined
+     ^
+(../testdata/extra/semantic/multiple-errors.c:9:3) error: calling "f" with too many arguments; expected 1, got 2
+ f(1, 2);      // Too many arguments to function 'f'
+  ^
+(../testdata/extra/semantic/multiple-errors.c:10:4) error: cannot assign to "x" (type mismatch between "int" and "int(int a)")
+ x = f;        // Type mismatch
+   ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/int-lit-overflow.c",
+			want: `(../testdata/extra/semantic/int-lit-overflow.c:8:6) error: integer constant 99999999999999999999 is too large for type "long long"
+ x = 99999999999999999999;  // Integer constant too large
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/int-lit-suffix.c",
+			want: `(../testdata/extra/semantic/int-lit-suffix.c:8:6) error: invalid digit '8' in octal constant
+ l = 08;                    // Invalid digit in octal constant
+     ^
+(../testdata/extra/semantic/int-lit-suffix.c:9:6) error: invalid suffix "lul" on integer constant
+ l = 1lul;                  // Invalid integer suffix
+     ^
+(../testdata/extra/semantic/int-lit-suffix.c:10:6) error: invalid suffix "lL" on integer constant
+ l = 1lL;                   // Invalid integer suffix
+     ^
+(../testdata/extra/semantic/int-lit-suffix.c:11:6) error: integer constant 18446744073709551616u is too large for type "unsigned long long"
+ u = 18446744073709551616u; // Integer constant too large
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/break-outside-loop.c",
+			want: `(../testdata/extra/semantic/break-outside-loop.c:8:2) error: break statement not within loop or switch
+ break;
+ ^
+(../testdata/extra/semantic/break-outside-loop.c:10:3) error: continue statement not within loop
+  continue;
+  ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/inc-dec-operand.c",
+			want: `(../testdata/extra/semantic/inc-dec-operand.c:8:4) error: cannot assign to "1" of type "int"
+ 1 += x;
+   ^
+(../testdata/extra/semantic/inc-dec-operand.c:9:3) error: cannot increment "a" of type "int[10]"
+ a++;
+  ^
+(../testdata/extra/semantic/inc-dec-operand.c:10:2) error: cannot decrement "f()" of type "int"
+ --f();
+ ^
+(../testdata/extra/semantic/inc-dec-operand.c:11:4) error: invalid operands to binary expression: x <<= a ("int" and "int[10]")
+ x <<= a;
+   ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/bitwise-array-operand.c",
+			want: `(../testdata/extra/semantic/bitwise-array-operand.c:4:8) error: invalid operands to binary expression: a % 2 ("int[10]" and "int")
+ b = a % 2;
+       ^
+(../testdata/extra/semantic/bitwise-array-operand.c:5:8) error: invalid operands to binary expression: 1 << a ("int" and "int[10]")
+ b = 1 << a;
+       ^
+(../testdata/extra/semantic/bitwise-array-operand.c:6:6) error: invalid argument type "int[10]" to unary expression: ~a
+ b = ~a;
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/pointer-operand.c",
+			want: `(../testdata/extra/semantic/pointer-operand.c:10:4) error: cannot assign to "q" (type mismatch between "char*" and "int*")
+ q = p;
+   ^
+(../testdata/extra/semantic/pointer-operand.c:11:6) error: invalid indirection: *x (type "int" is not a pointer)
+ x = *x;
+     ^
+(../testdata/extra/semantic/pointer-operand.c:12:6) error: cannot take the address of "1"
+ p = &1;
+     ^
+(../testdata/extra/semantic/pointer-operand.c:13:8) error: invalid operands to binary expression: p + p ("int*" and "int*")
+ p = p + p;
+       ^
+(../testdata/extra/semantic/pointer-operand.c:14:8) error: invalid operands to binary expression: p * 2 ("int*" and "int")
+ p = p * 2;
+       ^
+(../testdata/extra/semantic/pointer-operand.c:15:8) error: invalid operands to binary expression: p < q ("int*" and "char*")
+ x = p < q;
+       ^
+(../testdata/extra/semantic/pointer-operand.c:16:8) error: invalid operands to binary expression: p - q ("int*" and "char*")
+ x = p - q;
+       ^
+(../testdata/extra/semantic/pointer-operand.c:17:4) error: cannot assign to "p" (type mismatch between "int*" and "int")
+ p = 42;
+   ^
+(../testdata/extra/semantic/pointer-operand.c:18:4) error: invalid operands to binary expression: p -= p ("int*" and "int*")
+ p -= p;
+   ^
+(../testdata/extra/semantic/pointer-operand.c:19:9) error: returning "void" from a function with incompatible result type "int"
+ return *v;
+        ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/struct-field.c",
+			want: `(../testdata/extra/semantic/struct-field.c:6:8) error: redefinition of "struct point"
+struct point {
+       ^
+(../testdata/extra/semantic/struct-field.c:1:8) note: previous definition of "struct point"
+struct point {
+       ^
+(../testdata/extra/semantic/struct-field.c:12:7) error: redefinition of "a" with type "char" instead of "int"
+ char a;
+      ^
+(../testdata/extra/semantic/struct-field.c:11:6) note: previous declaration of "a"
+ int a;
+     ^
+(../testdata/extra/semantic/struct-field.c:18:14) error: "l" has incomplete type "struct line"
+ struct line l;
+             ^
+(../testdata/extra/semantic/struct-field.c:26:8) error: invalid member access: p.z (type "struct point" has no field "z")
+ i = p.z;
+       ^
+(../testdata/extra/semantic/struct-field.c:27:7) error: invalid member access: q.x (type "struct point*" is not a structure)
+ i = q.x;
+      ^
+(../testdata/extra/semantic/struct-field.c:28:7) error: invalid member access: p->x (type "struct point" is not a pointer to structure)
+ i = p->x;
+      ^
+(../testdata/extra/semantic/struct-field.c:29:7) error: invalid member access: i.x (type "int" is not a structure)
+ i = i.x;
+      ^
+(../testdata/extra/semantic/struct-field.c:30:4) error: cannot assign to "p" (type mismatch between "struct point" and "struct {int x;}")
+ p = anon;
+   ^
+(../testdata/extra/semantic/struct-field.c:32:8) error: invalid operands to binary expression: p + 1 ("struct point" and "int")
+ i = p + 1;
+       ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/struct-cond.c",
+			want: `(../testdata/extra/semantic/struct-cond.c:8:6) error: used type "struct S" where arithmetic or pointer type is required
+ if (s) {
+     ^
+(../testdata/extra/semantic/struct-cond.c:11:9) error: used type "struct S" where arithmetic or pointer type is required
+ while (s) {
+        ^
+(../testdata/extra/semantic/struct-cond.c:14:11) error: used type "struct S" where arithmetic or pointer type is required
+ } while (s);
+          ^
+(../testdata/extra/semantic/struct-cond.c:15:9) error: used type "struct S" where arithmetic or pointer type is required
+ for (; s;) {
+        ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/struct-incomplete.c",
+			want: `(../testdata/extra/semantic/struct-incomplete.c:17:14) error: "u" has incomplete type "struct tree"
+ struct tree u;
+             ^
+(../testdata/extra/semantic/struct-incomplete.c:19:6) error: invalid application of sizeof to incomplete type "struct tree"
+ n = sizeof(struct tree);
+     ^
+(../testdata/extra/semantic/struct-incomplete.c:20:7) error: invalid member access: t->v (incomplete definition of type "struct tree")
+ n = t->v;
+      ^
+(../testdata/extra/semantic/struct-incomplete.c:25:11) error: "b" has incomplete type "struct b"
+ struct b b;
+          ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/array-assign.c",
+			want: `(../testdata/extra/semantic/array-assign.c:9:8) error: cannot assign to "s.arr" of type "int[2]"
+ s.arr = 1;
+       ^
+(../testdata/extra/semantic/array-assign.c:10:8) error: cannot assign to "s.arr" of type "int[2]"
+ s.arr += 1;
+       ^
+(../testdata/extra/semantic/array-assign.c:11:7) error: cannot assign to "m[0]" of type "int[3]"
+ m[0] = 1;
+      ^
+(../testdata/extra/semantic/array-assign.c:12:7) error: cannot assign to "m[0]" of type "int[3]"
+ m[0] += 1;
+      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/compound-assign-operand.c",
+			want: `(../testdata/extra/semantic/compound-assign-operand.c:13:4) error: invalid operands to binary expression: s += 1 ("struct S" and "int")
+ s += 1;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:14:4) error: invalid operands to binary expression: i += s ("int" and "struct S")
+ i += s;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:15:4) error: invalid operands to binary expression: s += t ("struct S" and "struct S")
+ s += t;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:16:4) error: invalid operands to binary expression: p += p ("int*" and "int*")
+ p += p;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:17:4) error: invalid operands to binary expression: i -= p ("int" and "int*")
+ i -= p;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:18:4) error: invalid operands to binary expression: i <<= s ("int" and "struct S")
+ i <<= s;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:19:4) error: invalid operands to binary expression: s *= 2 ("struct S" and "int")
+ s *= 2;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:20:4) error: invalid operands to binary expression: p *= 2 ("int*" and "int")
+ p *= 2;
+   ^
+(../testdata/extra/semantic/compound-assign-operand.c:21:4) error: invalid operands to binary expression: i += a ("int" and "int*")
+ i += a;
+   ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/array-multi.c",
+			want: `(../testdata/extra/semantic/array-multi.c:1:6) error: invalid element type "void" of array "v"
+void v[2][3];
+     ^
+(../testdata/extra/semantic/array-multi.c:12:6) error: invalid operation: x[1][2] (type "int" does not support indexing)
+ x[1][2] = 1;
+     ^
+(../testdata/extra/semantic/array-multi.c:14:7) error: cannot assign to "m[1]" of type "int[3]"
+ m[1] = p;
+      ^
+(../testdata/extra/semantic/array-multi.c:16:6) error: calling "sum" with incompatible argument type "int[2][3]" to parameter of type "int[][4]"
+ sum(m);
+     ^
+(../testdata/extra/semantic/array-multi.c:17:16) error: invalid operation: m[0][1][2] (type "int" does not support indexing)
+ return m[0][1][2];
+               ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/initializer.c",
+			want: `(../testdata/extra/semantic/initializer.c:7:9) error: initializer element g is not constant
+int h = g;
+        ^
+(../testdata/extra/semantic/initializer.c:8:19) error: excess elements in initializer of array of type "int[2]"
+int a[2] = {1, 2, 3};
+                  ^
+(../testdata/extra/semantic/initializer.c:9:13) error: initializer-string for array of type "char[2]" is too long
+char s[2] = "abc";
+            ^
+(../testdata/extra/semantic/initializer.c:10:12) error: invalid initializer for array of type "int[3]"; expected initializer list
+int b[3] = 4;
+           ^
+(../testdata/extra/semantic/initializer.c:11:25) error: excess elements in initializer of structure of type "struct point"
+struct point p = {1, 2, 3};
+                        ^
+(../testdata/extra/semantic/initializer.c:12:10) error: initializing "int*" with an expression of incompatible type "int"
+int *q = 1;
+         ^
+(../testdata/extra/semantic/initializer.c:13:13) error: excess elements in initializer of scalar of type "int"
+int x = {1, 2};
+            ^
+(../testdata/extra/semantic/initializer.c:14:16) error: initializing "int" with an expression of incompatible type "char[4]"
+int c[2] = {1, "foo"};
+               ^
+(../testdata/extra/semantic/initializer.c:18:12) error: invalid initializer for array of type "int[]"; expected initializer list
+ int e[] = 5;
+           ^
+(../testdata/extra/semantic/initializer.c:21:10) error: initializing "int" with an expression of incompatible type "struct point"
+ int z = r;
+         ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-expr.c",
+			want: `(../testdata/extra/semantic/const-expr.c:2:7) error: array size 2 - 2 is not positive
+int a[2-2];
+      ^
+(../testdata/extra/semantic/const-expr.c:3:7) error: array size n is not an integer constant expression
+int b[n];
+      ^
+(../testdata/extra/semantic/const-expr.c:4:8) error: division by zero in constant expression 1 / 0
+int c[1/0];
+       ^
+(../testdata/extra/semantic/const-expr.c:5:11) error: division by zero in constant expression 1 % (2 - 2)
+int d = 1 % (2 - 2);
+          ^
+(../testdata/extra/semantic/const-expr.c:6:11) error: shift count 32 out of range in constant expression 1 << 32
+int e = 1 << 32;
+          ^
+(../testdata/extra/semantic/const-expr.c:7:9) error: initializer element n + 1 is not constant
+int f = n + 1;
+        ^
+(../testdata/extra/semantic/const-expr.c:10:11) error: array size 3 * -1 is not positive
+ char buf[3*-1];
+          ^
+(../testdata/extra/semantic/const-expr.c:12:9) warning: division by zero in 10 / 0 is undefined
+ x = 10 / 0;
+        ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-expr-undefined.c",
+			want: `(../testdata/extra/semantic/const-expr-undefined.c:6:14) error: shift count 40 out of range in constant expression 1 << 40
+enum { E = 1 << 40 };
+             ^
+(../testdata/extra/semantic/const-expr-undefined.c:12:9) warning: division by zero in 1 / 0 is undefined
+ k = (1 / 0) + 1;
+        ^
+(../testdata/extra/semantic/const-expr-undefined.c:14:9) error: division by zero in constant expression 2 / 0
+ case 2 / 0:
+        ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/variadic.c",
+			want: `(../testdata/extra/semantic/variadic.c:2:7) error: "void" must be the only parameter
+void g(void, ...);
+      ^
+(../testdata/extra/semantic/variadic.c:7:8) error: calling "printf" with too few arguments; expected at least 1, got 0
+ printf();
+       ^
+(../testdata/extra/semantic/variadic.c:8:17) error: calling "printf" with invalid argument type "void" to variable arguments
+ printf("%d\n", h());
+                ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/int-types.c",
+			want: `(../testdata/extra/semantic/int-types.c:6:4) error: cannot assign to "u" (type mismatch between "unsigned int" and "char[4]")
+ u = "foo";                 // Type mismatch
+   ^
+(../testdata/extra/semantic/int-types.c:7:4) error: cannot assign to "p" (type mismatch between "short*" and "long long*")
+ p = &ll;                   // Type mismatch
+   ^
+(../testdata/extra/semantic/int-types.c:9:7) error: integer constant 18446744073709551616 is too large for type "long long"
+ ll = 18446744073709551616; // Integer constant too large
+      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/cast.c",
+			want: `(../testdata/extra/semantic/cast.c:14:6) error: invalid cast: (int)v (cannot convert "struct s" to "int")
+ n = (int)v;                // Invalid cast of structure
+     ^
+(../testdata/extra/semantic/cast.c:15:6) error: invalid cast: (struct s)n (cannot convert "int" to "struct s")
+ v = (struct s)n;           // Invalid cast to structure
+     ^
+(../testdata/extra/semantic/cast.c:16:9) error: cannot assign to "(int)n" of type "int"
+ (int)n = 3;                // Cast is not an lvalue
+        ^
+(../testdata/extra/semantic/cast.c:17:6) warning: cast from pointer type "char*" to smaller integer type "int"
+ n = (int)p;                // Cast to smaller integer type
+     ^
+(../testdata/extra/semantic/cast.c:18:6) warning: cast from "int" to "char" changes value from 300 to 44
+ c = (char)300;             // Cast changes value
+     ^
+(../testdata/extra/semantic/cast.c:22:6) error: invalid application of sizeof to incomplete type "void"
+ n = sizeof(void);          // Sizeof incomplete type
+     ^
+(../testdata/extra/semantic/cast.c:23:6) error: invalid application of sizeof to function type "int(void)"
+ n = sizeof f;              // Sizeof function type
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/switch.c",
+			want: `(../testdata/extra/semantic/switch.c:10:7) error: duplicate case value 1
+ case 0 + 1:                // Duplicate case value
+      ^
+(../testdata/extra/semantic/switch.c:7:7) note: previous case label 1
+ case 1:
+      ^
+(../testdata/extra/semantic/switch.c:12:7) error: case label y is not an integer constant expression
+ case y:                    // Case label not constant
+      ^
+(../testdata/extra/semantic/switch.c:16:2) error: multiple default labels in one switch
+ default:                   // Multiple default labels
+ ^
+(../testdata/extra/semantic/switch.c:14:2) note: previous default label
+ default:
+ ^
+(../testdata/extra/semantic/switch.c:19:10) error: invalid switch controlling expression p of type "char*"; expected integer
+ switch (p) {               // Non-integer controlling expression
+         ^
+(../testdata/extra/semantic/switch.c:23:7) warning: cast from "int" to "char" changes value from 257 to 1
+ case (char)257:
+      ^
+(../testdata/extra/semantic/switch.c:24:3) error: continue statement not within loop
+  continue;              // Continue not within loop
+  ^
+(../testdata/extra/semantic/switch.c:26:2) error: case label not within a switch statement
+ case 2:                    // Case label outside switch
+ ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/cond-expr.c",
+			want: `(../testdata/extra/semantic/cond-expr.c:18:8) error: invalid condition a of type "struct s" in conditional expression; expected scalar
+ x = a ? 1 : 2;             // Condition of structure type
+       ^
+(../testdata/extra/semantic/cond-expr.c:19:12) error: invalid operands to conditional expression: x ? p : q (type mismatch between "int*" and "char*")
+ x = x ? p : q;             // Pointer type mismatch
+           ^
+(../testdata/extra/semantic/cond-expr.c:20:12) error: invalid operands to conditional expression: x ? a : 2 (type mismatch between "struct s" and "int")
+ x = x ? a : 2;             // Structure and integer operands
+           ^
+(../testdata/extra/semantic/cond-expr.c:21:14) error: cannot assign to "(x ? x : x)" of type "int"
+ (x ? x : x) = 3;           // Not an lvalue
+             ^
+(../testdata/extra/semantic/cond-expr.c:24:7) error: duplicate case value 2
+ case 2:                    // Duplicate case value
+      ^
+(../testdata/extra/semantic/cond-expr.c:23:7) note: previous case label 1 ? 2 : 3
+ case 1 ? 2 : 3:
+      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/goto.c",
+			want: `(../testdata/extra/semantic/goto.c:7:7) error: use of undeclared label "missing"
+ goto missing;              // Undeclared label
+      ^
+(../testdata/extra/semantic/goto.c:8:1) error: redefinition of label "out"
+out:                           // Duplicate label
+^
+(../testdata/extra/semantic/goto.c:4:1) note: previous definition of label "out"
+out:
+^
+(../testdata/extra/semantic/goto.c:15:7) error: use of undeclared label "done"
+ goto done;                 // Labels have function scope
+      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum.c",
+			want: `(../testdata/extra/semantic/enum.c:4:12) error: enumerator value x is not an integer constant expression
+enum { A = x };                // Enumerator value not constant
+           ^
+(../testdata/extra/semantic/enum.c:5:11) error: redefinition of "RED"
+enum { B, RED };               // Redefinition of enumerator
+          ^
+(../testdata/extra/semantic/enum.c:1:14) note: previous definition of "RED"
+enum color { RED, GREEN = 5, BLUE };
+             ^
+(../testdata/extra/semantic/enum.c:6:24) error: enumerator value of "D" is not representable as int
+enum { C = 2147483647, D };    // Enumerator value not representable as int
+                       ^
+(../testdata/extra/semantic/enum.c:7:5) error: redefinition of "BLUE"
+int BLUE;                      // Redefinition of enumerator as variable
+    ^
+(../testdata/extra/semantic/enum.c:1:30) note: previous definition of "BLUE"
+enum color { RED, GREEN = 5, BLUE };
+                             ^
+(../testdata/extra/semantic/enum.c:9:8) error: "struct color" defined as wrong kind of tag
+struct color;                  // Wrong kind of tag
+       ^
+(../testdata/extra/semantic/enum.c:11:12) error: undeclared enumeration "enum shape"
+int f(enum shape s) {          // Undeclared enumeration tag
+           ^
+(../testdata/extra/semantic/enum.c:13:6) error: cannot assign to "RED" of type "int"
+ RED = 1;                   // Assignment to enumerator
+     ^
+(../testdata/extra/semantic/enum.c:16:7) error: duplicate case value 5
+ case 5:                    // Duplicate case value
+      ^
+(../testdata/extra/semantic/enum.c:15:7) note: previous case label GREEN
+ case GREEN:
+      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum-field.c",
+			want: `(../testdata/extra/semantic/enum-field.c:17:9) error: undeclared identifier "X"
+ return X;
+        ^`,
+		},
 	}
 
 	errors.UseColor = false
@@ -371,7 +873,7 @@ void f(void, void) {
 		src := errors.NewSource(g.path, input)
 
 		p := parser.NewParser()
-		file, err := p.Parse(s)
+		file, err := p.ParseAll(s)
 		if err != nil {
 			t.Error(err)
 			continue
@@ -383,9 +885,9 @@ void f(void, void) {
 			if e, ok := err.(*errutil.ErrInfo); ok {
 				// Unwrap errutil error.
 				err = e.Err
-				if e, ok := err.(*errors.Error); ok {
-					// Unwrap semantic error.
-					e.Src = src
+				if e, ok := err.(errors.List); ok {
+					// Unwrap semantic errors.
+					e.SetSource(src)
 				}
 			}
 			got = err.Error()
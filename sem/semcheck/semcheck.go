@@ -11,31 +11,39 @@ import (
 // NoNestedFunctions disables the checking for nested functions
 var NoNestedFunctions = false
 
-// Check performs static semantic analysis on the given file.
+// Check performs static semantic analysis on the given file. The returned error
+// is an errors.List of every error encountered.
 func Check(file *ast.File) error {
+	var errs errors.List
 	for _, decl := range file.Decls {
 		switch decl := decl.(type) {
 		case *ast.FuncDecl:
 			// Check for nested functions.
 			if NoNestedFunctions {
 				if err := checkNestedFunctions(decl); err != nil {
-					return errutil.Err(err)
+					errs.Add(err)
 				}
 			}
+			// Check for break and continue statements outside of loops, and case
+			// labels outside of switch statements.
+			if err := checkBranchStmts(decl); err != nil {
+				errs.Add(err)
+			}
 		}
 	}
-	return nil
+	return errs.Err()
 }
 
-// checkNestedFunctions reports an error if the given function contains any
-// nested function definitions.
+// checkNestedFunctions reports an error for each nested function definition
+// contained within the given function.
 func checkNestedFunctions(fn *ast.FuncDecl) error {
 	if !astutil.IsDef(fn) {
 		return nil
 	}
+	var errs errors.List
 	check := func(n ast.Node) error {
 		if n, ok := n.(*ast.FuncDecl); ok {
-			return errors.Newf(n.FuncName.Start(), "nested functions not allowed")
+			errs.Add(errors.Newf(n.FuncName.Start(), "nested functions not allowed"))
 		}
 		return nil
 	}
@@ -43,7 +51,66 @@ func checkNestedFunctions(fn *ast.FuncDecl) error {
 	if err := astutil.WalkBeforeAfter(fn.Body, check, nop); err != nil {
 		return errutil.Err(err)
 	}
-	return nil
+	return errs.Err()
+}
+
+// checkBranchStmts reports an error for each break statement not contained
+// within a loop or switch statement, each continue statement not contained
+// within a loop statement, and each case label not contained within a switch
+// statement of the given function.
+func checkBranchStmts(fn *ast.FuncDecl) error {
+	if !astutil.IsDef(fn) {
+		return nil
+	}
+	var errs errors.List
+	// loops and switches track the number of enclosing loop and switch
+	// statements of each function, as nested functions may not break out of the
+	// loops of their parent function.
+	loops, switches := []int{0}, []int{0}
+	before := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.DoWhileStmt, *ast.ForStmt, *ast.WhileStmt:
+			loops[len(loops)-1]++
+		case *ast.SwitchStmt:
+			switches[len(switches)-1]++
+		case *ast.FuncDecl:
+			loops = append(loops, 0)
+			switches = append(switches, 0)
+		case *ast.BreakStmt:
+			if loops[len(loops)-1] == 0 && switches[len(switches)-1] == 0 {
+				errs.Add(errors.Newf(n.Start(), "break statement not within loop or switch"))
+			}
+		case *ast.ContinueStmt:
+			if loops[len(loops)-1] == 0 {
+				errs.Add(errors.Newf(n.Start(), "continue statement not within loop"))
+			}
+		case *ast.CaseStmt:
+			if switches[len(switches)-1] == 0 {
+				label := "case"
+				if n.Val == nil {
+					label = "default"
+				}
+				errs.Add(errors.Newf(n.Start(), "%s label not within a switch statement", label))
+			}
+		}
+		return nil
+	}
+	after := func(n ast.Node) error {
+		switch n.(type) {
+		case *ast.DoWhileStmt, *ast.ForStmt, *ast.WhileStmt:
+			loops[len(loops)-1]--
+		case *ast.SwitchStmt:
+			switches[len(switches)-1]--
+		case *ast.FuncDecl:
+			loops = loops[:len(loops)-1]
+			switches = switches[:len(switches)-1]
+		}
+		return nil
+	}
+	if err := astutil.WalkBeforeAfter(fn.Body, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	return errs.Err()
 }
 
 // TODO: Verify that all declarations occur at the beginning of the function
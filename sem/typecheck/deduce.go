@@ -2,116 +2,786 @@ package typecheck
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/constant"
+	"github.com/mewmew/uc/gocc/util"
 	"github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // deduce performs type deduction of expressions, and store the result in
-// exprTypes.
-func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+// exprTypes. The values of integer constant expressions are folded and stored
+// in values. Erroneous expressions are given an invalid type, and the returned
+// error is an errors.List of every error encountered.
+func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) error {
+	// errs records the errors encountered during type deduction.
+	var errs errors.List
+
+	// next specifies the value of the next enumeration constant without an
+	// explicit value within the current enumeration.
+	var next constant.Value
+
+	// nodes records the nodes being walked, from the root to the current node,
+	// and their evaluation contexts.
+	var nodes []ast.Node
+	var contexts []evalContext
+
+	// before resets the value of the next enumeration constant at the start of
+	// each enumeration, and records the evaluation context of the given node.
+	before := func(n ast.Node) error {
+		if _, ok := n.(*ast.EnumType); ok {
+			next = 0
+		}
+		ctx := evaluated
+		if len(nodes) > 0 {
+			ctx = contextOf(n, nodes, contexts[len(contexts)-1], values)
+		}
+		nodes = append(nodes, n)
+		contexts = append(contexts, ctx)
+		return nil
+	}
+
 	// deduce performs type deduction of the given expression.
 	deduce := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.InitList:
+			// Initializer lists have no type of their own; their elements are
+			// type-checked against the type of the initialized variable.
+			return nil
+		case *ast.ArrayType:
+			if err := evalArrayLen(n, exprTypes, values); err != nil {
+				errs.Add(err)
+			}
+			return nil
+		case *ast.EnumConst:
+			v, err := evalEnumConst(n, next, exprTypes, values)
+			if err != nil {
+				errs.Add(err)
+			}
+			n.Val = int(v)
+			next = v + 1
+			return nil
+		}
 		if expr, ok := n.(ast.Expr); ok {
-			typ, err := typeOf(expr)
+			typ, err := typeOf(expr, exprTypes)
 			if err != nil {
-				return errutil.Err(err)
+				errs.Add(err)
+				typ = &types.Basic{Kind: types.Invalid}
 			}
 			exprTypes[expr] = typ
+			v, ok, err := constant.Fold(expr, exprTypes, values)
+			if uerr, isUndef := err.(*constant.UndefinedError); isUndef {
+				// Operations of undefined behaviour are only erroneous where an
+				// integer constant expression is required, and are otherwise left
+				// unfolded.
+				switch contexts[len(contexts)-1] {
+				case required:
+					errs.Add(errors.New(uerr.Expr.OpPos, uerr.Error()))
+					exprTypes[expr] = &types.Basic{Kind: types.Invalid}
+				case evaluated:
+					errs.Add(errors.Warningf(uerr.Expr.OpPos, "%s in %v is undefined", uerr.Desc, uerr.Expr))
+				}
+			} else if err != nil {
+				errs.Add(err)
+				exprTypes[expr] = &types.Basic{Kind: types.Invalid}
+			} else if ok {
+				values[expr] = v
+			}
 		}
 		return nil
 	}
 
+	// after performs type deduction of the given node, and pops it from the
+	// walked nodes.
+	after := func(n ast.Node) error {
+		err := deduce(n)
+		nodes = nodes[:len(nodes)-1]
+		contexts = contexts[:len(contexts)-1]
+		return err
+	}
+
 	// Walk the AST of the given file to deduce the types of expression nodes.
-	if err := astutil.Walk(file, deduce); err != nil {
+	// The walk is bottom-up, so the types of subexpressions have already been
+	// deduced when deducing the type of an expression.
+	if err := astutil.WalkBeforeAfter(file, before, after); err != nil {
 		return errutil.Err(err)
 	}
 
+	return errs.Err()
+}
+
+// An evalContext specifies the evaluation context of a node.
+type evalContext int
+
+// Evaluation contexts.
+const (
+	// Evaluated node.
+	evaluated evalContext = iota
+	// Node of an integer constant expression which is required to be constant;
+	// e.g. an array size.
+	required
+	// Node which is not evaluated; e.g. the operand of a sizeof expression.
+	unevaluated
+)
+
+// contextOf returns the evaluation context of the given node, based on the
+// nodes being walked, from the root to the parent of n, and the evaluation
+// context of the parent.
+func contextOf(n ast.Node, nodes []ast.Node, parentCtx evalContext, values map[ast.Expr]constant.Value) evalContext {
+	if parentCtx == unevaluated {
+		return unevaluated
+	}
+	switch parent := nodes[len(nodes)-1].(type) {
+	case *ast.SizeofExpr:
+		// "the operand is not evaluated" [C99 draft 6.5.3.4.2]
+		if n == parent.X {
+			return unevaluated
+		}
+	case *ast.BinaryExpr:
+		if n != parent.Y {
+			break
+		}
+		// "the second operand is not evaluated if the first operand compares
+		// equal to 0." [C99 draft 6.5.13.4]
+		//
+		// "the second operand is not evaluated if the first operand compares
+		// unequal to 0." [C99 draft 6.5.14.4]
+		if x, ok := values[parent.X]; ok {
+			if (parent.Op == token.Land && x == 0) || (parent.Op == token.Lor && x != 0) {
+				return unevaluated
+			}
+		}
+	case *ast.CondExpr:
+		// "the second operand is evaluated only if the first compares unequal to
+		// 0; the third operand is evaluated only if the first compares equal to
+		// 0" [C99 draft 6.5.15.4]
+		if cond, ok := values[parent.Cond]; ok {
+			if (n == parent.X && cond == 0) || (n == parent.Y && cond != 0) {
+				return unevaluated
+			}
+		}
+	// Integer constant expressions are required in array sizes, enumerators,
+	// case labels and the initializers of global variables.
+	case *ast.ArrayType:
+		if n == parent.LenExpr {
+			return required
+		}
+	case *ast.EnumConst:
+		if n == parent.ValExpr {
+			return required
+		}
+	case *ast.CaseStmt:
+		if n == parent.Val {
+			return required
+		}
+	case *ast.VarDecl:
+		if len(nodes) >= 2 && n == parent.Val {
+			if _, ok := nodes[len(nodes)-2].(*ast.File); ok {
+				return required
+			}
+		}
+	}
+	return parentCtx
+}
+
+// evalArrayLen evaluates the length expression of the given array type, and
+// stores the result in its length.
+func evalArrayLen(n *ast.ArrayType, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) error {
+	if n.LenExpr == nil || types.IsInvalid(exprTypes[n.LenExpr]) {
+		return nil
+	}
+	v, ok := values[n.LenExpr]
+	if !ok {
+		return errors.Newf(n.LenExpr.Start(), "array size %v is not an integer constant expression", n.LenExpr)
+	}
+	// "If the expression is a constant expression, it shall have a value
+	// greater than zero." [C99 draft 6.7.5.2.1]
+	if v <= 0 {
+		return errors.Newf(n.LenExpr.Start(), "array size %v is not positive", n.LenExpr)
+	}
+	n.Len = int(v)
 	return nil
 }
 
-// typeOf returns the type of the given expression.
-func typeOf(n ast.Expr) (types.Type, error) {
+// evalEnumConst evaluates the value of the given enumeration constant, where
+// next specifies its value if no explicit value is given.
+func evalEnumConst(n *ast.EnumConst, next constant.Value, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) (constant.Value, error) {
+	// "If the first enumerator has no =, the value of its enumeration constant
+	// is 0. Each subsequent enumerator with no = defines its enumeration
+	// constant as the value of the constant expression obtained by adding 1 to
+	// the value of the previous enumeration constant." [C99 draft 6.7.2.2.3]
+	v := next
+	if n.ValExpr != nil {
+		if types.IsInvalid(exprTypes[n.ValExpr]) {
+			return 0, nil
+		}
+		var ok bool
+		v, ok = values[n.ValExpr]
+		if !ok {
+			return 0, errors.Newf(n.ValExpr.Start(), "enumerator value %v is not an integer constant expression", n.ValExpr)
+		}
+	}
+	// "The expression that defines the value of an enumeration constant shall
+	// be an integer constant expression that has a value representable as an
+	// int." [C99 draft 6.7.2.2.2]
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, errors.Newf(n.ConstName.Start(), "enumerator value of %q is not representable as int", n.ConstName)
+	}
+	return v, nil
+}
+
+// typeOf returns the type of the given expression, based on the previously
+// deduced types of its subexpressions.
+//
+// The type of an expression with an invalid subexpression is invalid, and no
+// further error is reported for it.
+func typeOf(n ast.Expr, exprTypes map[ast.Expr]types.Type) (types.Type, error) {
 	switch n := n.(type) {
 	case *ast.BasicLit:
-		// "The type of an integer constant is the first of the corresponding
-		// list in which its value can be represented." [C99 draft 6.4.4.1.5]
 		switch n.Kind {
 		case token.CharLit:
 			// "An integer character constant has type int." [C99 draft 6.4.4.4.10]
 			return &types.Basic{Kind: types.Int}, nil
 		case token.IntLit:
-			return &types.Basic{Kind: types.Int}, nil
+			return intLitType(n)
+		case token.StringLit:
+			// "The multibyte character sequence is then used to initialize an
+			// array of static storage duration and length just sufficient to
+			// contain the sequence. For character string literals, the array
+			// elements have type char" [C99 draft 6.4.5.5]
+			s, err := util.StringValue([]byte(n.Val))
+			if err != nil {
+				return nil, errors.Newf(n.ValPos, "invalid string literal %s; %v", n.Val, err)
+			}
+			// Include the terminating null character.
+			return &types.Array{Elem: &types.Basic{Kind: types.Char}, Len: len(s) + 1}, nil
 		default:
 			panic(fmt.Sprintf("support for basic literal type %v not yet implemented", n.Kind))
 		}
 	case *ast.BinaryExpr:
 		// See [C99 draft 6.3.1.8 Usual arithmetic conversions]
-		xType, err := typeOf(n.X)
-		if err != nil {
-			return nil, errutil.Err(err)
+		xType, yType := exprTypes[n.X], exprTypes[n.Y]
+		if types.IsInvalid(xType) || types.IsInvalid(yType) {
+			return &types.Basic{Kind: types.Invalid}, nil
 		}
-		yType, err := typeOf(n.Y)
-		if err != nil {
-			return nil, errutil.Err(err)
-		}
-		if n.Op == token.Assign {
-			if !isAssignable(n.X) {
+		if n.Op.IsAssign() {
+			if !isAssignable(n.X, exprTypes) {
 				return nil, errors.Newf(n.OpPos, "cannot assign to %q of type %q", n.X, xType)
 			}
-			if !isCompatible(xType, yType) {
+			if op, ok := n.Op.CompoundOp(); ok {
+				// "A compound assignment of the form E1 op = E2 differs from the
+				// simple assignment expression E1 = E1 op (E2) only in that the
+				// lvalue E1 is evaluated only once." [C99 draft 6.5.16.2.3]
+				if err := checkOperands(n, op, xType, yType); err != nil {
+					return nil, err
+				}
+				// "For the operators += and -= only, either the left operand
+				// shall be a pointer to an object type and the right shall have
+				// integer type, or the left operand shall have qualified or
+				// unqualified arithmetic type and the right shall have
+				// arithmetic type." [C99 draft 6.5.16.2.1]
+				yType = types.Decay(yType)
+				if types.IsPointer(xType) {
+					if (op == token.Add || op == token.Sub) && types.IsInteger(yType) {
+						return xType, nil
+					}
+					return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+				}
+				// "For other operators, each operand shall have arithmetic type
+				// consistent with those allowed by the corresponding binary
+				// operator." [C99 draft 6.5.16.2.2]
+				if !types.IsInteger(xType) || !types.IsInteger(yType) {
+					return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+				}
+				return xType, nil
+			}
+			if !isCompatible(xType, types.Decay(yType)) && !(types.IsPointer(xType) && isNullPointerConstant(n.Y)) {
 				return nil, errors.Newf(n.OpPos, "cannot assign to %q (type mismatch between %q and %q)", n.X, xType, yType)
 			}
-			// TODO: !types.Equal(higherPrecision(xType, yType), xType) could be
-			// used for loss of percision warning.
+			// TODO: !types.Equal(types.ArithmeticConversion(xType, yType), xType)
+			// could be used for loss of percision warning.
 			return xType, nil
 		}
-		if types.IsVoid(xType) || types.IsVoid(yType) {
-			return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+		if err := checkOperands(n, n.Op, xType, yType); err != nil {
+			return nil, err
+		}
+		if types.IsPointer(types.Decay(xType)) || types.IsPointer(types.Decay(yType)) {
+			return pointerOpType(n, types.Decay(xType), types.Decay(yType))
+		}
+		if n.Op == token.Shl || n.Op == token.Shr {
+			// "The integer promotions are performed on each of the operands. The
+			// type of the result is that of the promoted left operand." [C99
+			// draft 6.5.7.3]
+			return types.Promote(xType), nil
 		}
 		if !isCompatible(xType, yType) {
 			return nil, errors.Newf(n.OpPos, "invalid operation: %v (type mismatch between %q and %q)", n, xType, yType)
 		}
-		// TODO: Implement better implicit conversion. Future: Make sure to
-		// promote types early when implementing signed/unsigned types and
-		// types need to be promoted anyway later. Be careful of bug:
-		// https://youtu.be/Ux0YnVEaI6A?t=279
-		// Implement according to [C99 draft 6.3.1.8 Usual arithmetic conversions]
-		return higherPrecision(xType, yType), nil
+		switch n.Op {
+		case token.Lt, token.Gt, token.Le, token.Ge, token.Eq, token.Ne, token.Land, token.Lor:
+			// "The result has type int." [C99 draft 6.5.8.6, 6.5.9.3, 6.5.13.3,
+			// 6.5.14.3]
+			return &types.Basic{Kind: types.Int}, nil
+		}
+		// "If both operands have arithmetic type, the usual arithmetic
+		// conversions are performed on them." [C99 draft 6.5.6.4]
+		return types.ArithmeticConversion(xType, yType), nil
 	case *ast.CallExpr:
 		typ := n.Name.Decl.Type()
 		if typ, ok := typ.(*types.Func); ok {
 			return typ.Result, nil
 		}
+		if types.IsInvalid(typ) {
+			return typ, nil
+		}
 		return nil, errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, typ)
+	case *ast.CastExpr:
+		typ := ast.NewType(n.Type)
+		xType := types.Decay(exprTypes[n.X])
+		if types.IsInvalid(xType) {
+			return xType, nil
+		}
+		// "Unless the type name specifies a void type, the type name shall
+		// specify qualified or unqualified scalar type and the operand shall have
+		// scalar type." [C99 draft 6.5.4.2]
+		if types.IsVoid(typ) {
+			return typ, nil
+		}
+		if !types.IsScalar(typ) || !types.IsScalar(xType) {
+			return nil, errors.Newf(n.Lparen, "invalid cast: %v (cannot convert %q to %q)", n, xType, typ)
+		}
+		return typ, nil
+	case *ast.CondExpr:
+		return condExprType(n, exprTypes)
 	case *ast.Ident:
 		return n.Decl.Type(), nil
 	case *ast.IndexExpr:
-		typ := n.Name.Decl.Type()
-		if typ, ok := typ.(*types.Array); ok {
+		typ := exprTypes[n.X]
+		switch typ := typ.(type) {
+		case *types.Array:
+			return typ.Elem, nil
+		case *types.Pointer:
+			// "The definition of the subscript operator [] is that E1[E2] is
+			// identical to (*((E1)+(E2)))." [C99 draft 6.5.2.1.2]
 			return typ.Elem, nil
 		}
+		if types.IsInvalid(typ) {
+			return typ, nil
+		}
 		return nil, errors.Newf(n.Lbracket, "invalid operation: %v (type %q does not support indexing)", n, typ)
 	case *ast.ParenExpr:
-		return typeOf(n.X)
+		return exprTypes[n.X], nil
+	case *ast.PostfixExpr:
+		xType := exprTypes[n.X]
+		if err := checkIncDec(n.X, n.OpPos, n.Op, xType, exprTypes); err != nil {
+			return nil, err
+		}
+		return xType, nil
+	case *ast.SelectorExpr:
+		xType := exprTypes[n.X]
+		if types.IsInvalid(xType) {
+			return xType, nil
+		}
+		structType, ok := xType.(*types.Struct)
+		if n.Op == token.Arrow {
+			// "The first operand of the -> operator shall have type 'pointer to
+			// qualified or unqualified structure or qualified or unqualified
+			// union'" [C99 draft 6.5.2.3.2]
+			structType = nil
+			if ptr, ok := xType.(*types.Pointer); ok {
+				structType, _ = ptr.Elem.(*types.Struct)
+			}
+			if structType == nil {
+				return nil, errors.Newf(n.OpPos, "invalid member access: %v (type %q is not a pointer to structure)", n, xType)
+			}
+		} else if !ok {
+			// "The first operand of the . operator shall have a qualified or
+			// unqualified structure or union type" [C99 draft 6.5.2.3.1]
+			return nil, errors.Newf(n.OpPos, "invalid member access: %v (type %q is not a structure)", n, xType)
+		}
+		if structType.Incomplete {
+			return nil, errors.Newf(n.OpPos, "invalid member access: %v (incomplete definition of type %q)", n, structType)
+		}
+		field, _, ok := structType.Field(n.Sel.Name)
+		if !ok {
+			return nil, errors.Newf(n.Sel.Start(), "invalid member access: %v (type %q has no field %q)", n, structType, n.Sel)
+		}
+		return field.Type, nil
+	case *ast.SizeofExpr:
+		typ := sizeofType(n, exprTypes)
+		if types.IsInvalid(typ) {
+			return typ, nil
+		}
+		// "The sizeof operator shall not be applied to an expression that has
+		// function type or an incomplete type, to the parenthesized name of such
+		// a type" [C99 draft 6.5.3.4.1]
+		if _, ok := typ.(*types.Func); ok {
+			return nil, errors.Newf(n.Sizeof, "invalid application of sizeof to function type %q", typ)
+		}
+		if types.IsIncomplete(typ) {
+			return nil, errors.Newf(n.Sizeof, "invalid application of sizeof to incomplete type %q", typ)
+		}
+		// "The value of the result is implementation-defined, and its type (an
+		// unsigned integer type) is size_t" [C99 draft 6.5.3.4.4]
+		//
+		// In accordance with Clang and GCC on x86-64, size_t is unsigned long.
+		return &types.Basic{Kind: types.UnsignedLong}, nil
 	case *ast.UnaryExpr:
-		// TODO: Add support for pointers.
-		return typeOf(n.X)
+		xType := exprTypes[n.X]
+		if types.IsInvalid(xType) {
+			return xType, nil
+		}
+		switch n.Op {
+		case token.Inc, token.Dec:
+			if err := checkIncDec(n.X, n.OpPos, n.Op, xType, exprTypes); err != nil {
+				return nil, err
+			}
+			return xType, nil
+		case token.And:
+			// "The operand of the unary & operator shall be either a function
+			// designator, the result of a [] or unary * operator, or an lvalue
+			// that designates an object that is not a bit-field and is not
+			// declared with the register storage-class specifier." [C99 draft
+			// 6.5.3.2.1]
+			if _, ok := xType.(*types.Array); !ok && !isAssignable(n.X, exprTypes) {
+				return nil, errors.Newf(n.OpPos, "cannot take the address of %q", n.X)
+			}
+			return &types.Pointer{Elem: xType}, nil
+		case token.Mul:
+			// "The operand of the unary * operator shall have pointer type."
+			// [C99 draft 6.5.3.2.2]
+			if typ, ok := types.Decay(xType).(*types.Pointer); ok {
+				return typ.Elem, nil
+			}
+			return nil, errors.Newf(n.OpPos, "invalid indirection: %v (type %q is not a pointer)", n, xType)
+		case token.Sub, token.Tilde:
+			// "The operand of the unary + or - operator shall have arithmetic
+			// type; of the ~ operator, integer type" [C99 draft 6.5.3.3.1]
+			if !types.IsInteger(xType) {
+				return nil, errors.Newf(n.OpPos, "invalid argument type %q to unary expression: %v", xType, n)
+			}
+			// "The integer promotions are performed on the operand, and the
+			// result has the promoted type." [C99 draft 6.5.3.3.3]
+			return types.Promote(xType), nil
+		case token.Not:
+			// "The operand of the unary ! operator shall have scalar type." [C99
+			// draft 6.5.3.3.1]
+			if !types.IsScalar(types.Decay(xType)) {
+				return nil, errors.Newf(n.OpPos, "invalid argument type %q to unary expression: %v", xType, n)
+			}
+			// "The result has type int." [C99 draft 6.5.3.3.5]
+			return &types.Basic{Kind: types.Int}, nil
+		}
+		return xType, nil
 	default:
 		panic(fmt.Sprintf("support for type %T not yet implemented.", n))
 	}
 }
 
+// sizeofType returns the type of the operand of the given sizeof expression.
+func sizeofType(n *ast.SizeofExpr, exprTypes map[ast.Expr]types.Type) types.Type {
+	if n.Type != nil {
+		return ast.NewType(n.Type)
+	}
+	return exprTypes[n.X]
+}
+
+// condExprType returns the type of the given conditional expression.
+func condExprType(n *ast.CondExpr, exprTypes map[ast.Expr]types.Type) (types.Type, error) {
+	condType := types.Decay(exprTypes[n.Cond])
+	xType, yType := types.Decay(exprTypes[n.X]), types.Decay(exprTypes[n.Y])
+	if types.IsInvalid(condType) || types.IsInvalid(xType) || types.IsInvalid(yType) {
+		return &types.Basic{Kind: types.Invalid}, nil
+	}
+	// "The first operand shall have scalar type." [C99 draft 6.5.15.2]
+	if !types.IsScalar(condType) {
+		return nil, errors.Newf(n.Question, "invalid condition %v of type %q in conditional expression; expected scalar", n.Cond, condType)
+	}
+	// "If both the second and third operands have arithmetic type, the result
+	// type that would be determined by the usual arithmetic conversions, were
+	// they applied to those two operands, is the type of the result. If both
+	// the operands have structure or union type, the result has that type. If
+	// both operands have void type, the result has void type." [C99 draft
+	// 6.5.15.5]
+	switch {
+	case types.IsInteger(xType) && types.IsInteger(yType):
+		return types.ArithmeticConversion(xType, yType), nil
+	case types.IsStruct(xType) && types.Equal(xType, yType):
+		return xType, nil
+	case types.IsVoid(xType) && types.IsVoid(yType):
+		return xType, nil
+	}
+	// "If both the second and third operands are pointers or one is a null
+	// pointer constant and the other is a pointer, the result type is a pointer
+	// to a type qualified with all the type qualifiers of the types pointed-to
+	// by both operands. [...] if one operand is a null pointer constant, the
+	// result has the type of the other operand; otherwise, one operand is a
+	// pointer to void or a qualified version of void, in which case the result
+	// type is a pointer to an appropriately qualified version of void." [C99
+	// draft 6.5.15.6]
+	if types.IsPointer(xType) && isNullPointerConstant(n.Y) {
+		return xType, nil
+	}
+	if isNullPointerConstant(n.X) && types.IsPointer(yType) {
+		return yType, nil
+	}
+	if types.IsPointer(xType) && types.IsPointer(yType) && isCompatible(xType, yType) {
+		if types.IsVoid(yType.(*types.Pointer).Elem) {
+			return yType, nil
+		}
+		return xType, nil
+	}
+	return nil, errors.Newf(n.Colon, "invalid operands to conditional expression: %v (type mismatch between %q and %q)", n, xType, yType)
+}
+
+// checkOperands reports an error if the operands of the given binary
+// expression, with types xType and yType, are invalid for the binary operator
+// op. For compound assignments, op is the binary operator of the compound
+// assignment operator.
+func checkOperands(n *ast.BinaryExpr, op token.Kind, xType, yType types.Type) error {
+	if types.IsVoid(xType) || types.IsVoid(yType) {
+		return errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+	}
+	if types.IsStruct(xType) || types.IsStruct(yType) {
+		// Structures may only be assigned.
+		return errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+	}
+	switch op {
+	case token.Rem, token.And, token.Or, token.Xor, token.Shl, token.Shr:
+		// "Each of the operands shall have integer type." [C99 draft 6.5.5.2,
+		// 6.5.7.2, 6.5.10.2, 6.5.11.2, 6.5.12.2]
+		if !types.IsInteger(xType) || !types.IsInteger(yType) {
+			return errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+		}
+	}
+	return nil
+}
+
+// pointerOpType returns the type of the given binary expression, where at least
+// one of the operands, with decayed types xType and yType, is a pointer.
+func pointerOpType(n *ast.BinaryExpr, xType, yType types.Type) (types.Type, error) {
+	switch n.Op {
+	case token.Add:
+		// "For addition, either both operands shall have arithmetic type, or one
+		// operand shall be a pointer to an object type and the other shall have
+		// integer type." [C99 draft 6.5.6.2]
+		if types.IsPointer(xType) && types.IsInteger(yType) {
+			return xType, nil
+		}
+		if types.IsInteger(xType) && types.IsPointer(yType) {
+			return yType, nil
+		}
+	case token.Sub:
+		// "For subtraction, one of the following shall hold: both operands are
+		// pointers to qualified or unqualified versions of compatible object
+		// types; or the left operand is a pointer to an object type and the
+		// right operand has integer type." [C99 draft 6.5.6.3]
+		if types.IsPointer(xType) && types.IsInteger(yType) {
+			return xType, nil
+		}
+		if types.IsPointer(xType) && types.IsPointer(yType) && types.Equal(xType, yType) {
+			// "The size of the result is implementation-defined, and its type (a
+			// signed integer type) is ptrdiff_t" [C99 draft 6.5.6.9]
+			//
+			// In accordance with Clang and GCC on x86-64, ptrdiff_t is long.
+			return &types.Basic{Kind: types.Long}, nil
+		}
+	case token.Lt, token.Le, token.Gt, token.Ge:
+		// "both operands are pointers to qualified or unqualified versions of
+		// compatible object types" [C99 draft 6.5.8.2]
+		if types.IsPointer(xType) && types.IsPointer(yType) && types.Equal(xType, yType) {
+			return &types.Basic{Kind: types.Int}, nil
+		}
+	case token.Eq, token.Ne:
+		// "both operands are pointers to qualified or unqualified versions of
+		// compatible types; one operand is a pointer to an object or incomplete
+		// type and the other is a pointer to a qualified or unqualified version
+		// of void; or one operand is a pointer and the other is a null pointer
+		// constant." [C99 draft 6.5.9.2]
+		if types.IsPointer(xType) && types.IsPointer(yType) && isCompatible(xType, yType) {
+			return &types.Basic{Kind: types.Int}, nil
+		}
+		if types.IsPointer(xType) && isNullPointerConstant(n.Y) || isNullPointerConstant(n.X) && types.IsPointer(yType) {
+			return &types.Basic{Kind: types.Int}, nil
+		}
+	case token.Land, token.Lor:
+		// "Each of the operands shall have scalar type." [C99 draft 6.5.13.2,
+		// 6.5.14.2]
+		if types.IsScalar(xType) && types.IsScalar(yType) {
+			return &types.Basic{Kind: types.Int}, nil
+		}
+	}
+	return nil, errors.Newf(n.OpPos, "invalid operands to binary expression: %v (%q and %q)", n, xType, yType)
+}
+
+// isNullPointerConstant reports whether the given expression is a null pointer
+// constant.
+//
+// "An integer constant expression with the value 0, or such an expression cast
+// to type void *, is called a null pointer constant." [C99 draft 6.3.2.3.3]
+func isNullPointerConstant(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		if x.Kind != token.IntLit {
+			return false
+		}
+		v, err := util.UintValue([]byte(x.Val))
+		return err == nil && v == 0
+	case *ast.ParenExpr:
+		return isNullPointerConstant(x.X)
+	case *ast.CastExpr:
+		ptr, ok := ast.NewType(x.Type).(*types.Pointer)
+		return ok && types.IsVoid(ptr.Elem) && isNullPointerConstant(x.X)
+	default:
+		return false
+	}
+}
+
+// checkIncDec reports an error if the operand x, of type xType, of the given
+// prefix or postfix increment or decrement operator is invalid.
+//
+// "The operand of the prefix increment or decrement operator shall have
+// qualified or unqualified real or pointer type and shall be a modifiable
+// lvalue." [C99 draft 6.5.3.1.1]
+func checkIncDec(x ast.Expr, opPos token.Pos, op token.Kind, xType types.Type, exprTypes map[ast.Expr]types.Type) error {
+	if types.IsInvalid(xType) {
+		return nil
+	}
+	desc := "increment"
+	if op == token.Dec {
+		desc = "decrement"
+	}
+	if !isAssignable(x, exprTypes) || !types.IsScalar(xType) {
+		return errors.Newf(opPos, "cannot %s %q of type %q", desc, x, xType)
+	}
+	return nil
+}
+
 // TODO: Verify isAssignable against the definition of lvalue in the C spec (I
 // tried and failed).
 
+// Candidate types of integer constants, in order of preference.
+var (
+	// decimalTypes specifies the candidate types of decimal integer constants.
+	decimalTypes = []types.BasicKind{types.Int, types.Long, types.LongLong}
+	// octalHexTypes specifies the candidate types of octal and hexadecimal
+	// integer constants.
+	octalHexTypes = []types.BasicKind{types.Int, types.UnsignedInt, types.Long, types.UnsignedLong, types.LongLong, types.UnsignedLongLong}
+	// unsignedTypes specifies the candidate types of integer constants with an
+	// unsigned suffix.
+	unsignedTypes = []types.BasicKind{types.UnsignedInt, types.UnsignedLong, types.UnsignedLongLong}
+)
+
+// intSuffixes maps from valid integer suffixes to whether the suffix is
+// unsigned and the minimum rank of the integer type; where 0 is int, 1 is long
+// and 2 is long long (§6.4.4.1).
+var intSuffixes = map[string]struct {
+	unsigned bool
+	rank     int
+}{
+	"":    {false, 0},
+	"u":   {true, 0},
+	"l":   {false, 1},
+	"ul":  {true, 1},
+	"lu":  {true, 1},
+	"ll":  {false, 2},
+	"ull": {true, 2},
+	"llu": {true, 2},
+}
+
+// intRank returns the integer conversion rank of the given integer constant
+// type; where 0 is int, 1 is long and 2 is long long.
+func intRank(kind types.BasicKind) int {
+	switch kind {
+	case types.Long, types.UnsignedLong:
+		return 1
+	case types.LongLong, types.UnsignedLongLong:
+		return 2
+	}
+	return 0
+}
+
+// intLitType returns the type of the given integer literal.
+//
+// "The type of an integer constant is the first of the corresponding list in
+// which its value can be represented." [C99 draft 6.4.4.1.5]
+func intLitType(n *ast.BasicLit) (types.Type, error) {
+	digits, suffix := util.SplitIntSuffix(n.Val)
+	// "ll" and "LL" are valid, but not "lL" or "Ll".
+	s := strings.ToLower(suffix)
+	if strings.Contains(suffix, "lL") || strings.Contains(suffix, "Ll") {
+		s = suffix
+	}
+	info, ok := intSuffixes[s]
+	if !ok {
+		return nil, errors.Newf(n.ValPos, "invalid suffix %q on integer constant", suffix)
+	}
+	isHex := strings.HasPrefix(strings.ToLower(digits), "0x")
+	if i := strings.IndexAny(digits, "89"); strings.HasPrefix(digits, "0") && !isHex && i != -1 {
+		return nil, errors.Newf(n.ValPos, "invalid digit %q in octal constant", digits[i])
+	}
+	x, err := util.UintValue([]byte(n.Val))
+	if err != nil && !isRangeErr(err) {
+		return nil, errors.Newf(n.ValPos, "invalid integer constant %s; %v", n.Val, err)
+	}
+	candidates := decimalTypes
+	switch {
+	case info.unsigned:
+		candidates = unsignedTypes
+	case digits != "0" && strings.HasPrefix(digits, "0"):
+		candidates = octalHexTypes
+	}
+	var valid []types.BasicKind
+	for _, kind := range candidates {
+		if intRank(kind) >= info.rank {
+			valid = append(valid, kind)
+		}
+	}
+	candidates = valid
+	if err == nil {
+		for _, kind := range candidates {
+			if x <= maxValue(kind) {
+				return &types.Basic{Kind: kind}, nil
+			}
+		}
+	}
+	largest := &types.Basic{Kind: candidates[len(candidates)-1]}
+	return nil, errors.Newf(n.ValPos, "integer constant %s is too large for type %q", n.Val, largest)
+}
+
+// maxValue returns the maximum value representable by the given integer type.
+func maxValue(kind types.BasicKind) uint64 {
+	typ := &types.Basic{Kind: kind}
+	shift := 64 - uint(8*types.DefaultSizes.Sizeof(typ))
+	if types.IsUnsigned(typ) {
+		return math.MaxUint64 >> shift
+	}
+	return math.MaxInt64 >> shift
+}
+
+// isRangeErr reports whether the given error is caused by a value out of
+// range.
+func isRangeErr(err error) bool {
+	if err, ok := err.(*strconv.NumError); ok {
+		return err.Err == strconv.ErrRange
+	}
+	return false
+}
+
 // isAssignable reports whether the given expression is assignable (i.e. a valid
-// lvalue). See [C99 draft 6.3.2.1 Lvalues, arrays, and function designators]
-func isAssignable(x ast.Expr) bool {
+// lvalue), based on the deduced types of exprTypes. See [C99 draft 6.3.2.1
+// Lvalues, arrays, and function designators]
+//
+// "A modifiable lvalue is an lvalue that does not have array type" [C99 draft
+// 6.3.2.1.1]
+func isAssignable(x ast.Expr, exprTypes map[ast.Expr]types.Type) bool {
 	switch x := x.(type) {
 	case *ast.BasicLit:
 		return false
@@ -119,7 +789,18 @@ func isAssignable(x ast.Expr) bool {
 		return false
 	case *ast.CallExpr:
 		return false
+	case *ast.CastExpr:
+		// "A cast does not yield an lvalue." [C99 draft 6.5.4, footnote 85]
+		return false
+	case *ast.CondExpr:
+		// "A conditional expression does not yield an lvalue." [C99 draft
+		// 6.5.15]
+		return false
 	case *ast.Ident:
+		// Enumeration constants are not lvalues.
+		if _, ok := x.Decl.(*ast.EnumConst); ok {
+			return false
+		}
 		switch typ := x.Decl.Type().(type) {
 		case *types.Basic:
 			return true
@@ -127,39 +808,32 @@ func isAssignable(x ast.Expr) bool {
 			return false
 		case *types.Func:
 			return false
+		case *types.Pointer:
+			return true
+		case *types.Struct:
+			return true
 		default:
 			panic(fmt.Sprintf("support for declaration type %T not yet implemented", typ))
 		}
 	case *ast.IndexExpr:
-		return true
+		// Rows of multi-dimensional arrays are not assignable.
+		_, isArray := exprTypes[x].(*types.Array)
+		return !isArray
 	case *ast.ParenExpr:
-		return isAssignable(x.X)
-	case *ast.UnaryExpr:
-		// TODO: Add support for pointer dereferences.
+		return isAssignable(x.X, exprTypes)
+	case *ast.PostfixExpr:
 		return false
+	case *ast.SelectorExpr:
+		// Fields of array type are not assignable.
+		if _, ok := exprTypes[x].(*types.Array); ok {
+			return false
+		}
+		return x.Op == token.Arrow || isAssignable(x.X, exprTypes)
+	case *ast.SizeofExpr:
+		return false
+	case *ast.UnaryExpr:
+		return x.Op == token.Mul
 	default:
 		panic(fmt.Sprintf("support for expression type %T not yet implemented", x))
 	}
 }
-
-// higherPrecision returns the type of higher precision.
-func higherPrecision(t, u types.Type) types.Type {
-	// TODO: Implement with a list of types sorted by precision when support
-	// for more types are added.
-	// Implement according to [C99 draft 6.3.1.8 Usual arithmetic conversions]
-	if t, ok := t.(*types.Basic); ok {
-		if u, ok := u.(*types.Basic); ok {
-			if t.Kind == types.Void || u.Kind == types.Void {
-				panic(fmt.Sprint(`incorrect use of higherPrecision; "void" does not have precision.`))
-			}
-			// Check for types in order of highest precision.
-			if t.Kind == types.Int || u.Kind == types.Int {
-				return &types.Basic{Kind: types.Int}
-			}
-			if t.Kind == types.Char || u.Kind == types.Char {
-				return &types.Basic{Kind: types.Char}
-			}
-		}
-	}
-	panic(fmt.Sprintf(`support for type "%v" or "%v" not yet implemented.`, t, u))
-}
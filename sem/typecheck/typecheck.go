@@ -3,36 +3,50 @@ package typecheck
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/constant"
 	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // Check type-checks the given file, and store a mapping from expression nodes
-// to types in exprTypes.
-func Check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
-	// Deduce the types of expressions.
-	if err := deduce(file, exprTypes); err != nil {
-		return errutil.Err(err)
+// to types in exprTypes, and from integer constant expressions to their values
+// in values. Type-checking continues after errors, and the returned error is an
+// errors.List of every error encountered.
+func Check(file *ast.File, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) error {
+	var errs errors.List
+
+	// Deduce the types and fold the values of expressions.
+	if err := deduce(file, exprTypes, values); err != nil {
+		errs.Add(err)
 	}
 
 	// Type-check file.
-	if err := check(file, exprTypes); err != nil {
-		return errutil.Err(err)
+	if err := check(file, exprTypes, values); err != nil {
+		errs.Add(err)
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // check type-checks the given file.
-func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+func check(file *ast.File, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) error {
+	// errs records the errors encountered during type-checking.
+	var errs errors.List
+
 	// funcs is a stack of function declarations, where the top-most entry
 	// represents the currently active function.
 	var funcs []*types.Func
 
+	// protoParams records the parameters of function declarations without body,
+	// which may have incomplete types.
+	protoParams := make(map[*ast.VarDecl]bool)
+
 	// check type-checks the given node.
 	check := func(n ast.Node) error {
 		switch n := n.(type) {
@@ -42,10 +56,10 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 			for _, item := range n.Items {
 				switch item := item.(type) {
 				case *ast.VarDecl:
-					typ := item.Type()
-					if typ, ok := typ.(*types.Array); ok {
-						if typ.Len == 0 && item.Val == nil {
-							return errors.Newf(item.VarName.NamePos, "array size or initializer missing for %q", item.VarName)
+					// Invalid array size expressions are reported by deduce.
+					if typ, ok := item.VarType.(*ast.ArrayType); ok {
+						if typ.LenExpr == nil && item.Val == nil {
+							errs.Add(errors.Newf(item.VarName.NamePos, "array size or initializer missing for %q", item.VarName))
 						}
 					}
 				}
@@ -61,14 +75,43 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 			// using *ast.Ident, which failed since "void" refers to itself as a
 			// VarDecl, whos types is "void".
 			if n.VarName != nil && types.IsVoid(typ) {
-				return errors.Newf(n.VarName.NamePos, `%q has invalid type "void"`, n.VarName)
+				errs.Add(errors.Newf(n.VarName.NamePos, `%q has invalid type "void"`, n.VarName))
+			} else if n.VarName != nil && types.IsIncomplete(typ) && !protoParams[n] {
+				// Objects, structure fields and the parameters of function
+				// definitions shall have complete types.
+				errs.Add(errors.Newf(n.VarName.NamePos, "%q has incomplete type %q", n.VarName, typ))
 			}
 			if typ, ok := typ.(*types.Array); ok {
-				if types.IsVoid(typ.Elem) {
-					return errors.Newf(n.VarName.NamePos, `invalid element type "void" of array %q`, n.VarName)
+				// Locate the element type of multi-dimensional arrays.
+				var elem types.Type = typ
+				for {
+					array, ok := elem.(*types.Array)
+					if !ok {
+						break
+					}
+					elem = array.Elem
+				}
+				if types.IsVoid(elem) {
+					errs.Add(errors.Newf(n.VarName.NamePos, `invalid element type "void" of array %q`, n.VarName))
+				} else if types.IsIncomplete(elem) {
+					errs.Add(errors.Newf(n.VarName.NamePos, "incomplete element type %q of array %q", elem, n.VarName))
+				}
+			}
+			if n.Val != nil {
+				checkInit(&errs, typ, n.Val, exprTypes)
+				// "All the expressions in an initializer for an object that has
+				// static storage duration shall be constant expressions or string
+				// literals." [C99 draft 6.7.8.4]
+				if len(funcs) == 0 {
+					checkConstInit(&errs, n.Val, exprTypes, values)
 				}
 			}
 		case *ast.FuncDecl:
+			if !astutil.IsDef(n) {
+				for _, param := range n.FuncType.Params {
+					protoParams[param] = true
+				}
+			}
 			if astutil.IsDef(n) {
 				// push function declaration.
 				funcs = append(funcs, n.Type().(*types.Func))
@@ -77,23 +120,42 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				// definitions.
 				for _, param := range n.FuncType.Params {
 					if !types.IsVoid(param.Type()) && param.VarName == nil {
-						return errors.Newf(param.VarType.Start(), "parameter name obmitted")
+						errs.Add(errors.Newf(param.VarType.Start(), "parameter name obmitted"))
 					}
 				}
 
 				// Verify that non-void functions end with return statement.
 				if !types.IsVoid(n.Type().(*types.Func).Result) {
 					// endsWithReturn reports whether the given block item ends with
-					// a return statement. The following two terminating statements
-					// are supported, recursively.
+					// a return statement. The following terminating statements are
+					// supported, recursively.
 					//
 					//    return;
 					//
+					//    goto label;
+					//
+					//    label: return;
+					//
 					//    if (cond) {
 					//       return;
 					//    } else {
 					//       return;
 					//    }
+					//
+					//    for (init; ; post) {
+					//       // no break.
+					//    }
+					//
+					//    do {
+					//       return;
+					//    } while (cond);
+					//
+					//    switch (tag) {
+					//    case 1:
+					//       // no break.
+					//    default:
+					//       return;
+					//    }
 					var endsWithReturn func(ast.Node) bool
 					endsWithReturn = func(node ast.Node) bool {
 						last := node
@@ -116,6 +178,34 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 								return false
 							}
 							return endsWithReturn(last.Body) && endsWithReturn(last.Else)
+						case *ast.GotoStmt:
+							// node ends with a jump, and control never reaches the
+							// end of node.
+							return true
+						case *ast.LabeledStmt:
+							return endsWithReturn(last.Stmt)
+						case *ast.ForStmt:
+							// A loop without controlling expression only terminates
+							// through break statements.
+							return last.Cond == nil && !breaksOut(last.Body, false)
+						case *ast.DoWhileStmt:
+							// The loop body is executed at least once, and control
+							// reaches the controlling expression only through the end
+							// of the loop body or continue statements.
+							return endsWithReturn(last.Body) && !breaksOut(last.Body, true)
+						case *ast.SwitchStmt:
+							// Control reaches the end of a switch statement without
+							// default label if no case label matches; otherwise, each
+							// case falls through to the end of the switch body.
+							hasDefault := false
+							for _, c := range astutil.SwitchCases(last) {
+								if c.Val == nil {
+									hasDefault = true
+								}
+							}
+							return hasDefault && endsWithReturn(last.Body) && !breaksOut(last.Body, false)
+						case *ast.CaseStmt:
+							return endsWithReturn(last.Body)
 						default:
 							// node may end without return statement.
 							return false
@@ -127,8 +217,15 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 					//
 					// NOTE: "reaching the } that terminates the main function
 					// returns a value of 0." (see §5.1.2.2.3 in the C11 spec)
+					//
+					// Otherwise, the missing return is reported as a warning, as
+					// the analysis of terminating statements is conservative.
+					//
+					// "If the } that terminates a function is reached, and the
+					// value of the function call is used by the caller, the
+					// behavior is undefined." [C99 draft 6.9.1.12]
 					if missing && n.FuncName.String() != "main" {
-						return errors.Newf(n.Body.Rbrace, "missing return at end of non-void function %q", n.FuncName)
+						errs.Add(errors.Warningf(n.Body.Rbrace, "missing return at end of non-void function %q", n.FuncName))
 					}
 				}
 			}
@@ -140,21 +237,19 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 			if n.Result != nil {
 				resultType = exprTypes[n.Result]
 			}
-			if !isCompatible(resultType, curFunc.Result) {
+			if !types.IsInvalid(resultType) && !isCompatible(types.Decay(resultType), curFunc.Result) && !(types.IsPointer(curFunc.Result) && isNullPointerConstant(n.Result)) {
 				resultPos := n.Start()
 				if n.Result != nil {
 					resultPos = n.Result.Start()
 				}
-				return errors.Newf(resultPos, "returning %q from a function with incompatible result type %q", resultType, curFunc.Result)
+				errs.Add(errors.Newf(resultPos, "returning %q from a function with incompatible result type %q", resultType, curFunc.Result))
 			}
 		case *ast.CallExpr:
 			funcType, ok := n.Name.Decl.Type().(*types.Func)
 			if !ok {
-				return errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, funcType)
+				// Calls to non-functions are reported by deduce.
+				return nil
 			}
-			// TODO: Implement support for functions with variable arguments (i.e.
-			// ellipsis).
-
 			// Verify call without arguments.
 			if len(n.Args) == 0 && len(funcType.Params) == 1 && types.IsVoid(funcType.Params[0].Type) {
 				return nil
@@ -162,10 +257,16 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 
 			// Check number of arguments.
 			if len(n.Args) < len(funcType.Params) {
-				return errors.Newf(n.Lparen, "calling %q with too few arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args))
+				if funcType.Variadic {
+					errs.Add(errors.Newf(n.Lparen, "calling %q with too few arguments; expected at least %d, got %d", n.Name, len(funcType.Params), len(n.Args)))
+				} else {
+					errs.Add(errors.Newf(n.Lparen, "calling %q with too few arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args)))
+				}
+				return nil
 			}
-			if len(n.Args) > len(funcType.Params) {
-				return errors.Newf(n.Lparen, "calling %q with too many arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args))
+			if len(n.Args) > len(funcType.Params) && !funcType.Variadic {
+				errs.Add(errors.Newf(n.Lparen, "calling %q with too many arguments; expected %d, got %d", n.Name, len(funcType.Params), len(n.Args)))
+				return nil
 			}
 
 			// Check that call argument types match the function parameter types.
@@ -173,24 +274,50 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				arg := n.Args[i]
 				argType := exprTypes[arg]
 				paramType := param.Type
-				if !isCompatibleArg(argType, paramType) {
-					return errors.Newf(arg.Start(), "calling %q with incompatible argument type %q to parameter of type %q", n.Name, argType, paramType)
+				if !types.IsInvalid(argType) && !isCompatibleArg(argType, paramType) && !(types.IsPointer(paramType) && isNullPointerConstant(arg)) {
+					errs.Add(errors.Newf(arg.Start(), "calling %q with incompatible argument type %q to parameter of type %q", n.Name, argType, paramType))
+				}
+			}
+
+			// "The ellipsis notation in a function prototype declarator causes
+			// argument type conversion to stop after the last declared parameter.
+			// The default argument promotions are performed on trailing
+			// arguments." [C99 draft 6.5.2.2.7]
+			for _, arg := range n.Args[len(funcType.Params):] {
+				if argType := exprTypes[arg]; types.IsVoid(argType) {
+					errs.Add(errors.Newf(arg.Start(), "calling %q with invalid argument type %q to variable arguments", n.Name, argType))
 				}
 			}
 		case *ast.FuncType:
 			for _, param := range n.Params {
 				paramType := param.Type()
-				if len(n.Params) > 1 && types.IsVoid(paramType) {
-					return errors.Newf(n.Lparen, `"void" must be the only parameter`)
+				if (len(n.Params) > 1 || n.IsVariadic()) && types.IsVoid(paramType) {
+					errs.Add(errors.Newf(n.Lparen, `"void" must be the only parameter`))
+					break
 				}
 			}
+		case *ast.IfStmt:
+			checkCond(&errs, n.Cond, exprTypes)
+		case *ast.WhileStmt:
+			checkCond(&errs, n.Cond, exprTypes)
+		case *ast.DoWhileStmt:
+			checkCond(&errs, n.Cond, exprTypes)
+		case *ast.ForStmt:
+			// The condition of a for statement may be omitted.
+			if n.Cond != nil {
+				checkCond(&errs, n.Cond, exprTypes)
+			}
+		case *ast.SwitchStmt:
+			checkSwitch(&errs, n, exprTypes, values)
+		case *ast.CastExpr:
+			checkCast(&errs, n, exprTypes, values)
 		case *ast.IndexExpr:
 			indexType, ok := exprTypes[n.Index]
 			if !ok {
 				panic(fmt.Sprintf("unable to locate type of expression %v", n.Index))
 			}
-			if !types.IsInteger(indexType) {
-				return errors.Newf(n.Index.Start(), "invalid array index; expected integer, got %q", indexType)
+			if !types.IsInvalid(indexType) && !types.IsInteger(indexType) {
+				errs.Add(errors.Newf(n.Index.Start(), "invalid array index; expected integer, got %q", indexType))
 			}
 		default:
 			// TODO: Implement type-checking for remaining node types.
@@ -216,7 +343,200 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 		return errutil.Err(err)
 	}
 
-	return nil
+	return errs.Err()
+}
+
+// checkCond reports an error to errs if the given controlling expression of a
+// selection or iteration statement is not of scalar type.
+//
+// "The controlling expression of an if statement shall have scalar type." [C99
+// draft 6.8.4.1.1]
+//
+// "The controlling expression of an iteration statement shall have scalar
+// type." [C99 draft 6.8.5.2]
+func checkCond(errs *errors.List, cond ast.Expr, exprTypes map[ast.Expr]types.Type) {
+	condType := types.Decay(exprTypes[cond])
+	if !types.IsInvalid(condType) && !types.IsScalar(condType) {
+		errs.Add(errors.Newf(cond.Start(), "used type %q where arithmetic or pointer type is required", condType))
+	}
+}
+
+// checkSwitch reports an error to errs if the controlling expression of the
+// given switch statement is not of integer type, or if any of its case labels
+// is not an integer constant expression or duplicates a previous case label.
+func checkSwitch(errs *errors.List, n *ast.SwitchStmt, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) {
+	// "The controlling expression of a switch statement shall have integer
+	// type." [C99 draft 6.8.4.2.1]
+	tagType := exprTypes[n.Tag]
+	if !types.IsInvalid(tagType) && !types.IsInteger(tagType) {
+		errs.Add(errors.Newf(n.Tag.Start(), "invalid switch controlling expression %v of type %q; expected integer", n.Tag, tagType))
+	}
+	// "The expression of each case label shall be an integer constant
+	// expression and no two of the case constant expressions in the same switch
+	// statement shall have the same value after conversion. There may be at most
+	// one default label in a switch statement." [C99 draft 6.8.4.2.3]
+	prev := make(map[constant.Value]*ast.CaseStmt)
+	var def *ast.CaseStmt
+	for _, c := range astutil.SwitchCases(n) {
+		if c.Val == nil {
+			if def != nil {
+				err := errors.Newf(c.Case, "multiple default labels in one switch")
+				err.AddNote(errors.Notef(def.Case, "previous default label"))
+				errs.Add(err)
+				continue
+			}
+			def = c
+			continue
+		}
+		if types.IsInvalid(exprTypes[c.Val]) {
+			continue
+		}
+		v, ok := values[c.Val]
+		if !ok {
+			errs.Add(errors.Newf(c.Val.Start(), "case label %v is not an integer constant expression", c.Val))
+			continue
+		}
+		// "The constant expression in each case label is converted to the
+		// promoted type of the controlling expression." [C99 draft 6.8.4.2.5]
+		valType := exprTypes[c.Val]
+		if types.IsInteger(tagType) {
+			valType = types.Promote(tagType)
+			v = constant.Convert(int64(v), valType)
+		}
+		if p, ok := prev[v]; ok {
+			err := errors.Newf(c.Val.Start(), "duplicate case value %s", formatValue(v, valType))
+			err.AddNote(errors.Notef(p.Val.Start(), "previous case label %v", p.Val))
+			errs.Add(err)
+			continue
+		}
+		prev[v] = c
+	}
+}
+
+// checkCast reports a warning to errs if the given cast expression loses
+// information of its operand; i.e. if a pointer is converted to a smaller
+// integer type, or if the value of an integer constant is truncated.
+func checkCast(errs *errors.List, n *ast.CastExpr, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) {
+	typ, xType := exprTypes[n], types.Decay(exprTypes[n.X])
+	if !types.IsInteger(typ) || !types.IsScalar(xType) {
+		return
+	}
+	sizes := types.DefaultSizes
+	if sizes.Sizeof(typ) >= sizes.Sizeof(xType) {
+		return
+	}
+	if types.IsPointer(xType) {
+		errs.Add(errors.Warningf(n.Lparen, "cast from pointer type %q to smaller integer type %q", xType, typ))
+		return
+	}
+	x, ok := values[n.X]
+	if v := values[n]; ok && v != x {
+		errs.Add(errors.Warningf(n.Lparen, "cast from %q to %q changes value from %v to %v", xType, typ, formatValue(x, xType), formatValue(v, typ)))
+	}
+}
+
+// formatValue returns a string representation of the given integer constant
+// value of type typ.
+func formatValue(v constant.Value, typ types.Type) string {
+	if types.IsUnsigned(typ) {
+		return strconv.FormatUint(uint64(v), 10)
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// checkInit reports an error to errs for every element of the initializer val
+// which is incompatible with the type typ of the initialized object.
+func checkInit(errs *errors.List, typ types.Type, val ast.Expr, exprTypes map[ast.Expr]types.Type) {
+	list, isList := val.(*ast.InitList)
+	switch typ := typ.(type) {
+	case *types.Array:
+		if !isList {
+			// "An array of character type may be initialized by a character string
+			// literal, optionally enclosed in braces. Successive characters of the
+			// character string literal (including the terminating null character
+			// if there is room or if the array is of unknown size) initialize the
+			// elements of the array." [C99 draft 6.7.8.14]
+			if lit, ok := val.(*ast.BasicLit); ok && lit.Kind == token.StringLit && types.Equal(typ.Elem, &types.Basic{Kind: types.Char}) {
+				if litType, ok := exprTypes[val].(*types.Array); ok && litType.Len-1 > typ.Len {
+					errs.Add(errors.Newf(val.Start(), "initializer-string for array of type %q is too long", typ))
+				}
+				return
+			}
+			errs.Add(errors.Newf(val.Start(), "invalid initializer for array of type %q; expected initializer list", typ))
+			return
+		}
+		// "No initializer shall attempt to provide a value for an object not
+		// contained within the entity being initialized." [C99 draft 6.7.8.2]
+		if len(list.Elems) > typ.Len {
+			errs.Add(errors.Newf(list.Elems[typ.Len].Start(), "excess elements in initializer of array of type %q", typ))
+		}
+		for _, elem := range list.Elems {
+			checkInit(errs, typ.Elem, elem, exprTypes)
+		}
+		return
+	case *types.Struct:
+		if isList {
+			if len(list.Elems) > len(typ.Fields) {
+				errs.Add(errors.Newf(list.Elems[len(typ.Fields)].Start(), "excess elements in initializer of structure of type %q", typ))
+			}
+			for i, elem := range list.Elems {
+				if i >= len(typ.Fields) {
+					break
+				}
+				checkInit(errs, typ.Fields[i].Type, elem, exprTypes)
+			}
+			return
+		}
+	default:
+		// "The initializer for a scalar shall be a single expression, optionally
+		// enclosed in braces." [C99 draft 6.7.8.11]
+		if isList {
+			if len(list.Elems) > 1 {
+				errs.Add(errors.Newf(list.Elems[1].Start(), "excess elements in initializer of scalar of type %q", typ))
+			}
+			checkInit(errs, typ, list.Elems[0], exprTypes)
+			return
+		}
+	}
+	// "the same type constraints and conversions as for simple assignment
+	// apply" [C99 draft 6.7.8.11]
+	valType := exprTypes[val]
+	if types.IsInvalid(valType) {
+		return
+	}
+	if !isCompatible(types.Decay(valType), typ) && !(types.IsPointer(typ) && isNullPointerConstant(val)) {
+		errs.Add(errors.Newf(val.Start(), "initializing %q with an expression of incompatible type %q", typ, valType))
+	}
+}
+
+// checkConstInit reports an error to errs for every element of the initializer
+// val which is not a constant expression.
+func checkConstInit(errs *errors.List, val ast.Expr, exprTypes map[ast.Expr]types.Type, values map[ast.Expr]constant.Value) {
+	if list, ok := val.(*ast.InitList); ok {
+		for _, elem := range list.Elems {
+			checkConstInit(errs, elem, exprTypes, values)
+		}
+		return
+	}
+	if !types.IsInvalid(exprTypes[val]) && !isConstant(val, values) {
+		errs.Add(errors.Newf(val.Start(), "initializer element %v is not constant", val))
+	}
+}
+
+// isConstant reports whether the given expression is an integer constant
+// expression or a string literal.
+func isConstant(x ast.Expr, values map[ast.Expr]constant.Value) bool {
+	if _, ok := values[x]; ok {
+		return true
+	}
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return x.Kind == token.StringLit
+	case *ast.ParenExpr:
+		return isConstant(x.X, values)
+	default:
+		return false
+	}
 }
 
 // isCompatibleArg reports whether the given call argument and function
@@ -225,15 +545,23 @@ func isCompatibleArg(arg, param types.Type) bool {
 	if isCompatible(arg, param) {
 		return true
 	}
-	if arg, ok := arg.(*types.Array); ok {
-		if param, ok := param.(*types.Array); ok {
-			// TODO: Check for other compatible types (e.g. pointers, array names,
-			// strings).
-			if param.Len != 0 {
-				return false
-			}
+	// "A declaration of a parameter as 'array of type' shall be adjusted to
+	// 'qualified pointer to type'" [C99 draft 6.7.5.3.7]
+	if param, ok := param.(*types.Array); ok {
+		if param.Len != 0 {
+			return false
+		}
+		switch arg := arg.(type) {
+		case *types.Array:
+			return types.Equal(arg.Elem, param.Elem)
+		case *types.Pointer:
 			return types.Equal(arg.Elem, param.Elem)
 		}
+		return false
+	}
+	// Array arguments decay to pointers to their first element.
+	if _, ok := param.(*types.Pointer); ok {
+		return isCompatible(types.Decay(arg), param)
 	}
 	return false
 }
@@ -245,10 +573,54 @@ func isCompatible(t, u types.Type) bool {
 	}
 	if t, ok := t.(types.Numerical); ok {
 		if u, ok := u.(types.Numerical); ok {
-			// TODO: Check for other compatible types (e.g. pointers, array names,
-			// strings).
 			return t.IsNumerical() && u.IsNumerical()
 		}
 	}
+	if t, ok := t.(*types.Pointer); ok {
+		if u, ok := u.(*types.Pointer); ok {
+			// "A pointer to void may be converted to or from a pointer to any
+			// incomplete or object type." [C99 draft 6.3.2.3.1]
+			return types.IsVoid(t.Elem) || types.IsVoid(u.Elem) || types.Equal(t.Elem, u.Elem)
+		}
+	}
 	return false
 }
+
+// breaksOut reports whether control may leave the given body of a loop or
+// switch statement through a break statement, or through a continue statement
+// if cont is set. Break and continue statements of nested loop and switch
+// statements refer to the nested statements.
+func breaksOut(body ast.Stmt, cont bool) bool {
+	loops, switches := 0, 0
+	found := false
+	before := func(n ast.Node) error {
+		switch n.(type) {
+		case *ast.DoWhileStmt, *ast.ForStmt, *ast.WhileStmt:
+			loops++
+		case *ast.SwitchStmt:
+			switches++
+		case *ast.BreakStmt:
+			if loops == 0 && switches == 0 {
+				found = true
+			}
+		case *ast.ContinueStmt:
+			if cont && loops == 0 {
+				found = true
+			}
+		}
+		return nil
+	}
+	after := func(n ast.Node) error {
+		switch n.(type) {
+		case *ast.DoWhileStmt, *ast.ForStmt, *ast.WhileStmt:
+			loops--
+		case *ast.SwitchStmt:
+			switches--
+		}
+		return nil
+	}
+	if err := astutil.WalkBeforeAfter(body, before, after); err != nil {
+		panic(fmt.Sprintf("unable to walk statement; %v", err))
+	}
+	return found
+}
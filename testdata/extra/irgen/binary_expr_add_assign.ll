@@ -0,0 +1,11 @@
+define i32 @f() {
+0:
+	%a = alloca i32
+	%b = alloca i32
+	%1 = load i32, i32* %b
+	%2 = load i32, i32* %a
+	%3 = add i32 %2, %1
+	store i32 %3, i32* %a
+	%4 = load i32, i32* %a
+	ret i32 %4
+}
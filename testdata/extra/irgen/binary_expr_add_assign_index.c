@@ -0,0 +1,6 @@
+int f() {
+	int a[10];
+	int i;
+	a[i + 1] += 42;
+	return a[i + 1];
+}
@@ -0,0 +1,18 @@
+define i32 @f() {
+0:
+	%a = alloca [10 x i32]
+	%i = alloca i32
+	%1 = load i32, i32* %i
+	%2 = add i32 %1, 1
+	%3 = sext i32 %2 to i64
+	%4 = getelementptr [10 x i32], [10 x i32]* %a, i64 0, i64 %3
+	%5 = load i32, i32* %4
+	%6 = add i32 %5, 42
+	store i32 %6, i32* %4
+	%7 = load i32, i32* %i
+	%8 = add i32 %7, 1
+	%9 = sext i32 %8 to i64
+	%10 = getelementptr [10 x i32], [10 x i32]* %a, i64 0, i64 %9
+	%11 = load i32, i32* %10
+	ret i32 %11
+}
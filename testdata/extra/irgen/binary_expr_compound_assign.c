@@ -0,0 +1,17 @@
+int f() {
+	int a;
+	char c;
+	a = 100;
+	c = 3;
+	a -= 1;
+	a *= c;
+	a /= 2;
+	a %= 40;
+	a <<= 2;
+	a >>= c;
+	a &= 7;
+	a |= 8;
+	a ^= c;
+	c += a;
+	return c;
+}
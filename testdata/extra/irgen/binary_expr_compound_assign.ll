@@ -0,0 +1,49 @@
+define i32 @f() {
+0:
+	%a = alloca i32
+	%c = alloca i8
+	store i32 100, i32* %a
+	store i8 3, i8* %c
+	%1 = load i32, i32* %a
+	%2 = sub i32 %1, 1
+	store i32 %2, i32* %a
+	%3 = load i8, i8* %c
+	%4 = load i32, i32* %a
+	%5 = sext i8 %3 to i32
+	%6 = mul i32 %4, %5
+	store i32 %6, i32* %a
+	%7 = load i32, i32* %a
+	%8 = sdiv i32 %7, 2
+	store i32 %8, i32* %a
+	%9 = load i32, i32* %a
+	%10 = srem i32 %9, 40
+	store i32 %10, i32* %a
+	%11 = load i32, i32* %a
+	%12 = shl i32 %11, 2
+	store i32 %12, i32* %a
+	%13 = load i8, i8* %c
+	%14 = load i32, i32* %a
+	%15 = sext i8 %13 to i32
+	%16 = ashr i32 %14, %15
+	store i32 %16, i32* %a
+	%17 = load i32, i32* %a
+	%18 = and i32 %17, 7
+	store i32 %18, i32* %a
+	%19 = load i32, i32* %a
+	%20 = or i32 %19, 8
+	store i32 %20, i32* %a
+	%21 = load i8, i8* %c
+	%22 = load i32, i32* %a
+	%23 = sext i8 %21 to i32
+	%24 = xor i32 %22, %23
+	store i32 %24, i32* %a
+	%25 = load i32, i32* %a
+	%26 = load i8, i8* %c
+	%27 = sext i8 %26 to i32
+	%28 = add i32 %27, %25
+	%29 = trunc i32 %28 to i8
+	store i8 %29, i8* %c
+	%30 = load i8, i8* %c
+	%31 = sext i8 %30 to i32
+	ret i32 %31
+}
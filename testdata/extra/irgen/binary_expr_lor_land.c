@@ -0,0 +1,6 @@
+int f() {
+	int a;
+	int b;
+	int c;
+	return a || b && c;
+}
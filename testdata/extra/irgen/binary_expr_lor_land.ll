@@ -0,0 +1,28 @@
+define i32 @f() {
+0:
+	%a = alloca i32
+	%b = alloca i32
+	%c = alloca i32
+	%1 = load i32, i32* %a
+	%2 = icmp ne i32 %1, 0
+	br i1 %2, label %11, label %3
+
+3:
+	%4 = load i32, i32* %b
+	%5 = icmp ne i32 %4, 0
+	br i1 %5, label %6, label %9
+
+6:
+	%7 = load i32, i32* %c
+	%8 = icmp ne i32 %7, 0
+	br label %9
+
+9:
+	%10 = phi i1 [ false, %3 ], [ %8, %6 ]
+	br label %11
+
+11:
+	%12 = phi i1 [ true, %0 ], [ %10, %9 ]
+	%13 = zext i1 %12 to i32
+	ret i32 %13
+}
@@ -0,0 +1,14 @@
+int f() {
+	int i;
+	i = 0;
+	while (1) {
+		i = i + 1;
+		if (i < 5) {
+			continue;
+		}
+		if (i == 8) {
+			break;
+		}
+	}
+	return i;
+}
@@ -0,0 +1,36 @@
+define i32 @f() {
+0:
+	%i = alloca i32
+	store i32 0, i32* %i
+	br label %1
+
+1:
+	%2 = icmp ne i32 1, 0
+	br i1 %2, label %3, label %14
+
+3:
+	%4 = load i32, i32* %i
+	%5 = add i32 %4, 1
+	store i32 %5, i32* %i
+	%6 = load i32, i32* %i
+	%7 = icmp slt i32 %6, 5
+	br i1 %7, label %8, label %9
+
+8:
+	br label %1
+
+9:
+	%10 = load i32, i32* %i
+	%11 = icmp eq i32 %10, 8
+	br i1 %11, label %12, label %13
+
+12:
+	br label %14
+
+13:
+	br label %1
+
+14:
+	%15 = load i32, i32* %i
+	ret i32 %15
+}
@@ -0,0 +1,27 @@
+struct point {
+	char tag;
+	int x;
+	long y;
+};
+
+struct point pts[3];
+
+int f(void) {
+	int i;
+	long addr;
+	char *p;
+	unsigned char c;
+	int sum;
+	i = 300;
+	c = (unsigned char)i;
+	sum = c;
+	sum = sum + (char)i;
+	sum = sum + (int)(char)-56;
+	p = (char *)&pts[1];
+	addr = (long)p - (long)&pts[0];
+	sum = sum + (int)addr;
+	sum = sum + sizeof(struct point) + sizeof pts + sizeof(int *);
+	sum = sum + sizeof i + sizeof(char) + sizeof -c;
+	(void)sum;
+	return sum;
+}
@@ -0,0 +1,57 @@
+%struct.point = type { i8, i32, i64 }
+
+@pts = global [3 x %struct.point] zeroinitializer
+
+define i32 @f() {
+0:
+	%i = alloca i32
+	%addr = alloca i64
+	%p = alloca i8*
+	%c = alloca i8
+	%sum = alloca i32
+	store i32 300, i32* %i
+	%1 = load i32, i32* %i
+	%2 = trunc i32 %1 to i8
+	store i8 %2, i8* %c
+	%3 = load i8, i8* %c
+	%4 = zext i8 %3 to i32
+	store i32 %4, i32* %sum
+	%5 = load i32, i32* %sum
+	%6 = load i32, i32* %i
+	%7 = trunc i32 %6 to i8
+	%8 = sext i8 %7 to i32
+	%9 = add i32 %5, %8
+	store i32 %9, i32* %sum
+	%10 = load i32, i32* %sum
+	%11 = add i32 %10, -56
+	store i32 %11, i32* %sum
+	%12 = bitcast %struct.point* getelementptr ([3 x %struct.point], [3 x %struct.point]* @pts, i64 0, i64 1) to i8*
+	store i8* %12, i8** %p
+	%13 = load i8*, i8** %p
+	%14 = ptrtoint i8* %13 to i64
+	%15 = ptrtoint %struct.point* getelementptr ([3 x %struct.point], [3 x %struct.point]* @pts, i64 0, i64 0) to i64
+	%16 = sub i64 %14, %15
+	store i64 %16, i64* %addr
+	%17 = load i32, i32* %sum
+	%18 = load i64, i64* %addr
+	%19 = trunc i64 %18 to i32
+	%20 = add i32 %17, %19
+	store i32 %20, i32* %sum
+	%21 = load i32, i32* %sum
+	%22 = sext i32 %21 to i64
+	%23 = add i64 %22, 16
+	%24 = add i64 %23, 48
+	%25 = add i64 %24, 8
+	%26 = trunc i64 %25 to i32
+	store i32 %26, i32* %sum
+	%27 = load i32, i32* %sum
+	%28 = sext i32 %27 to i64
+	%29 = add i64 %28, 4
+	%30 = add i64 %29, 1
+	%31 = add i64 %30, 4
+	%32 = trunc i64 %31 to i32
+	store i32 %32, i32* %sum
+	%33 = load i32, i32* %sum
+	%34 = load i32, i32* %sum
+	ret i32 %34
+}
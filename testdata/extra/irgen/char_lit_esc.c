@@ -0,0 +1,12 @@
+int f() {
+	char s[8];
+	s[0] = '\t';
+	s[1] = '\\';
+	s[2] = '\'';
+	s[3] = '\101';
+	s[4] = '\x41';
+	s[5] = '\xFF';
+	s[6] = '\?';
+	s[7] = '\0';
+	return s[5];
+}
@@ -0,0 +1,24 @@
+define i32 @f() {
+0:
+	%s = alloca [8 x i8]
+	%1 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 0
+	store i8 9, i8* %1
+	%2 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 1
+	store i8 92, i8* %2
+	%3 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 2
+	store i8 39, i8* %3
+	%4 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 3
+	store i8 65, i8* %4
+	%5 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 4
+	store i8 65, i8* %5
+	%6 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 5
+	store i8 -1, i8* %6
+	%7 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 6
+	store i8 63, i8* %7
+	%8 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 7
+	store i8 0, i8* %8
+	%9 = getelementptr [8 x i8], [8 x i8]* %s, i64 0, i64 5
+	%10 = load i8, i8* %9
+	%11 = sext i8 %10 to i32
+	ret i32 %11
+}
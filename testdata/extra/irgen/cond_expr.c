@@ -0,0 +1,39 @@
+struct pair {
+	int a;
+	int b;
+};
+
+int max(int x, int y) {
+	return x > y ? x : y;
+}
+
+int sign(int x) {
+	return x < 0 ? -1 : x > 0 ? 1 : 0;
+}
+
+int f(void) {
+	struct pair p;
+	struct pair q;
+	struct pair r;
+	char c;
+	unsigned char u;
+	int *ptr;
+	int n;
+	int sum;
+	n = 7;
+	c = 'a';
+	u = 200;
+	sum = max(3, n) + sign(-5) + sign(n) + sign(0);
+	sum = sum + (n && c ? c : u);
+	sum = sum + (n > 10 ? c : u);
+	ptr = n ? &n : 0;
+	sum = sum + *ptr;
+	p.a = 1;
+	p.b = 2;
+	q.a = 3;
+	q.b = 4;
+	r = sum > 100 ? p : q;
+	sum = sum + r.b;
+	sum = sum + (1 ? 10 : 20);
+	return sum;
+}
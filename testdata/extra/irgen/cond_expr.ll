@@ -0,0 +1,179 @@
+%struct.pair = type { i32, i32 }
+
+define i32 @max(i32 %x, i32 %y) {
+0:
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = alloca i32
+	store i32 %y, i32* %2
+	%3 = load i32, i32* %1
+	%4 = load i32, i32* %2
+	%5 = icmp sgt i32 %3, %4
+	br i1 %5, label %6, label %8
+
+6:
+	%7 = load i32, i32* %1
+	br label %10
+
+8:
+	%9 = load i32, i32* %2
+	br label %10
+
+10:
+	%11 = phi i32 [ %7, %6 ], [ %9, %8 ]
+	ret i32 %11
+}
+
+define i32 @sign(i32 %x) {
+0:
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = load i32, i32* %1
+	%3 = icmp slt i32 %2, 0
+	br i1 %3, label %4, label %5
+
+4:
+	br label %12
+
+5:
+	%6 = load i32, i32* %1
+	%7 = icmp sgt i32 %6, 0
+	br i1 %7, label %8, label %9
+
+8:
+	br label %10
+
+9:
+	br label %10
+
+10:
+	%11 = phi i32 [ 1, %8 ], [ 0, %9 ]
+	br label %12
+
+12:
+	%13 = phi i32 [ -1, %4 ], [ %11, %10 ]
+	ret i32 %13
+}
+
+define i32 @f() {
+0:
+	%p = alloca %struct.pair
+	%q = alloca %struct.pair
+	%r = alloca %struct.pair
+	%c = alloca i8
+	%u = alloca i8
+	%ptr = alloca i32*
+	%n = alloca i32
+	%sum = alloca i32
+	store i32 7, i32* %n
+	store i8 97, i8* %c
+	store i8 -56, i8* %u
+	%1 = load i32, i32* %n
+	%2 = call i32 @max(i32 3, i32 %1)
+	%3 = call i32 @sign(i32 -5)
+	%4 = add i32 %2, %3
+	%5 = load i32, i32* %n
+	%6 = call i32 @sign(i32 %5)
+	%7 = add i32 %4, %6
+	%8 = call i32 @sign(i32 0)
+	%9 = add i32 %7, %8
+	store i32 %9, i32* %sum
+	%10 = load i32, i32* %sum
+	%11 = load i32, i32* %n
+	%12 = icmp ne i32 %11, 0
+	br i1 %12, label %13, label %16
+
+13:
+	%14 = load i8, i8* %c
+	%15 = icmp ne i8 %14, 0
+	br label %16
+
+16:
+	%17 = phi i1 [ false, %0 ], [ %15, %13 ]
+	br i1 %17, label %18, label %21
+
+18:
+	%19 = load i8, i8* %c
+	%20 = sext i8 %19 to i32
+	br label %24
+
+21:
+	%22 = load i8, i8* %u
+	%23 = zext i8 %22 to i32
+	br label %24
+
+24:
+	%25 = phi i32 [ %20, %18 ], [ %23, %21 ]
+	%26 = add i32 %10, %25
+	store i32 %26, i32* %sum
+	%27 = load i32, i32* %sum
+	%28 = load i32, i32* %n
+	%29 = icmp sgt i32 %28, 10
+	br i1 %29, label %30, label %33
+
+30:
+	%31 = load i8, i8* %c
+	%32 = sext i8 %31 to i32
+	br label %36
+
+33:
+	%34 = load i8, i8* %u
+	%35 = zext i8 %34 to i32
+	br label %36
+
+36:
+	%37 = phi i32 [ %32, %30 ], [ %35, %33 ]
+	%38 = add i32 %27, %37
+	store i32 %38, i32* %sum
+	%39 = load i32, i32* %n
+	%40 = icmp ne i32 %39, 0
+	br i1 %40, label %41, label %42
+
+41:
+	br label %43
+
+42:
+	br label %43
+
+43:
+	%44 = phi i32* [ %n, %41 ], [ null, %42 ]
+	store i32* %44, i32** %ptr
+	%45 = load i32, i32* %sum
+	%46 = load i32*, i32** %ptr
+	%47 = load i32, i32* %46
+	%48 = add i32 %45, %47
+	store i32 %48, i32* %sum
+	%49 = getelementptr %struct.pair, %struct.pair* %p, i32 0, i32 0
+	store i32 1, i32* %49
+	%50 = getelementptr %struct.pair, %struct.pair* %p, i32 0, i32 1
+	store i32 2, i32* %50
+	%51 = getelementptr %struct.pair, %struct.pair* %q, i32 0, i32 0
+	store i32 3, i32* %51
+	%52 = getelementptr %struct.pair, %struct.pair* %q, i32 0, i32 1
+	store i32 4, i32* %52
+	%53 = load i32, i32* %sum
+	%54 = icmp sgt i32 %53, 100
+	br i1 %54, label %55, label %57
+
+55:
+	%56 = load %struct.pair, %struct.pair* %p
+	br label %59
+
+57:
+	%58 = load %struct.pair, %struct.pair* %q
+	br label %59
+
+59:
+	%60 = phi %struct.pair [ %56, %55 ], [ %58, %57 ]
+	store %struct.pair %60, %struct.pair* %r
+	%61 = load i32, i32* %sum
+	%62 = getelementptr %struct.pair, %struct.pair* %r, i32 0, i32 1
+	%63 = load i32, i32* %62
+	%64 = add i32 %61, %63
+	store i32 %64, i32* %sum
+	%65 = load i32, i32* %sum
+	%66 = add i32 %65, 10
+	store i32 %66, i32* %sum
+	%67 = load i32, i32* %sum
+	ret i32 %67
+}
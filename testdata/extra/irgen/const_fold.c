@@ -0,0 +1,13 @@
+int g[2*3];
+char c = 'a' + 1;
+int big = 2147483647 + 1;
+int mask = ~0 << 4;
+
+int f(void) {
+	char buf[4*2];
+	int n;
+	n = (10 - 4) / 3 % 5 + (1 < 2) + (3 == 4 || 1) - !0;
+	buf[7] = c;
+	g[5] = n;
+	return g[5] + buf[7] + (big < 0) + (mask & 0xFF);
+}
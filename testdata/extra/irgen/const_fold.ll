@@ -0,0 +1,29 @@
+@g = global [6 x i32] zeroinitializer
+@c = global i8 98
+@big = global i32 -2147483648
+@mask = global i32 -16
+
+define i32 @f() {
+0:
+	%buf = alloca [8 x i8]
+	%n = alloca i32
+	store i32 3, i32* %n
+	%1 = load i8, i8* @c
+	%2 = getelementptr [8 x i8], [8 x i8]* %buf, i64 0, i64 7
+	store i8 %1, i8* %2
+	%3 = load i32, i32* %n
+	store i32 %3, i32* getelementptr ([6 x i32], [6 x i32]* @g, i64 0, i64 5)
+	%4 = load i32, i32* getelementptr ([6 x i32], [6 x i32]* @g, i64 0, i64 5)
+	%5 = getelementptr [8 x i8], [8 x i8]* %buf, i64 0, i64 7
+	%6 = load i8, i8* %5
+	%7 = sext i8 %6 to i32
+	%8 = add i32 %4, %7
+	%9 = load i32, i32* @big
+	%10 = icmp slt i32 %9, 0
+	%11 = zext i1 %10 to i32
+	%12 = add i32 %8, %11
+	%13 = load i32, i32* @mask
+	%14 = and i32 %13, 255
+	%15 = add i32 %12, %14
+	ret i32 %15
+}
@@ -0,0 +1,7 @@
+void f() {
+	int x;
+	x = 0;
+	do {
+		x = x + 1;
+	} while (x < 10);
+}
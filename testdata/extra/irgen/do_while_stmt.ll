@@ -0,0 +1,20 @@
+define void @f() {
+0:
+	%x = alloca i32
+	store i32 0, i32* %x
+	br label %1
+
+1:
+	%2 = load i32, i32* %x
+	%3 = add i32 %2, 1
+	store i32 %3, i32* %x
+	br label %4
+
+4:
+	%5 = load i32, i32* %x
+	%6 = icmp slt i32 %5, 10
+	br i1 %6, label %1, label %7
+
+7:
+	ret void
+}
@@ -0,0 +1,30 @@
+enum color { RED, GREEN = 5, BLUE };
+
+enum { N = BLUE - GREEN + 2 };
+
+int weights[N];
+
+int weight(enum color c) {
+	switch (c) {
+	case RED:
+		return 1;
+	case GREEN:
+		return 2;
+	case BLUE:
+		return 3;
+	}
+	return 0;
+}
+
+int f(void) {
+	enum dir { UP = -1, DOWN = UP + 2, };
+	enum color c;
+	int i;
+	c = BLUE;
+	i = 0;
+	while (i < N) {
+		weights[i] = weight(i + GREEN);
+		i++;
+	}
+	return weights[0] + weights[1] + weights[2] * 10 + c * DOWN + UP;
+}
@@ -0,0 +1,65 @@
+@weights = global [3 x i32] zeroinitializer
+
+define i32 @weight(i32 %c) {
+0:
+	%1 = alloca i32
+	store i32 %c, i32* %1
+	%2 = load i32, i32* %1
+	switch i32 %2, label %6 [
+		i32 0, label %3
+		i32 5, label %4
+		i32 6, label %5
+	]
+
+3:
+	ret i32 1
+
+4:
+	ret i32 2
+
+5:
+	ret i32 3
+
+6:
+	ret i32 0
+}
+
+define i32 @f() {
+0:
+	%c = alloca i32
+	%i = alloca i32
+	store i32 6, i32* %c
+	store i32 0, i32* %i
+	br label %1
+
+1:
+	%2 = load i32, i32* %i
+	%3 = icmp slt i32 %2, 3
+	br i1 %3, label %4, label %13
+
+4:
+	%5 = load i32, i32* %i
+	%6 = add i32 %5, 5
+	%7 = call i32 @weight(i32 %6)
+	%8 = load i32, i32* %i
+	%9 = sext i32 %8 to i64
+	%10 = getelementptr [3 x i32], [3 x i32]* @weights, i64 0, i64 %9
+	store i32 %7, i32* %10
+	%11 = load i32, i32* %i
+	%12 = add i32 %11, 1
+	store i32 %12, i32* %i
+	br label %1
+
+13:
+	%14 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* @weights, i64 0, i64 0)
+	%15 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* @weights, i64 0, i64 1)
+	%16 = add i32 %14, %15
+	%17 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* @weights, i64 0, i64 2)
+	%18 = mul i32 %17, 10
+	%19 = add i32 %16, %18
+	%20 = load i32, i32* %c
+	%21 = mul i32 %20, 1
+	%22 = add i32 %19, %21
+	%23 = add i32 %22, -1
+	ret i32 %23
+}
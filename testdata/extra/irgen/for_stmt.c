@@ -0,0 +1,12 @@
+int f() {
+	int i;
+	int sum;
+	sum = 0;
+	for (i = 0; i < 10; i = i + 1) {
+		sum = sum + i;
+	}
+	for (;;) {
+		break;
+	}
+	return sum;
+}
@@ -0,0 +1,42 @@
+define i32 @f() {
+0:
+	%i = alloca i32
+	%sum = alloca i32
+	store i32 0, i32* %sum
+	store i32 0, i32* %i
+	br label %1
+
+1:
+	%2 = load i32, i32* %i
+	%3 = icmp slt i32 %2, 10
+	br i1 %3, label %4, label %11
+
+4:
+	%5 = load i32, i32* %sum
+	%6 = load i32, i32* %i
+	%7 = add i32 %5, %6
+	store i32 %7, i32* %sum
+	br label %8
+
+8:
+	%9 = load i32, i32* %i
+	%10 = add i32 %9, 1
+	store i32 %10, i32* %i
+	br label %1
+
+11:
+	br label %12
+
+12:
+	br label %13
+
+13:
+	br label %15
+
+14:
+	br label %12
+
+15:
+	%16 = load i32, i32* %sum
+	ret i32 %16
+}
@@ -0,0 +1,32 @@
+int sum(int n) {
+	int i;
+	int s;
+	i = 0;
+	s = 0;
+loop:
+	if (i >= n)
+		goto done;
+	s = s + i;
+	i++;
+	goto loop;
+done:
+	return s;
+}
+
+int f(void) {
+	int x;
+	x = sum(10);
+	if (x > 40)
+		goto skip;
+	x = 0;
+	{
+		int y;
+	skip:
+		y = 3;
+		x = x + y;
+	}
+	goto out;
+	x = 100;
+out:
+	return x;
+}
@@ -0,0 +1,67 @@
+define i32 @sum(i32 %n) {
+0:
+	%1 = alloca i32
+	store i32 %n, i32* %1
+	%i = alloca i32
+	%s = alloca i32
+	store i32 0, i32* %i
+	store i32 0, i32* %s
+	br label %2
+
+2:
+	%3 = load i32, i32* %i
+	%4 = load i32, i32* %1
+	%5 = icmp sge i32 %3, %4
+	br i1 %5, label %6, label %7
+
+6:
+	br label %13
+
+7:
+	%8 = load i32, i32* %s
+	%9 = load i32, i32* %i
+	%10 = add i32 %8, %9
+	store i32 %10, i32* %s
+	%11 = load i32, i32* %i
+	%12 = add i32 %11, 1
+	store i32 %12, i32* %i
+	br label %2
+
+13:
+	%14 = load i32, i32* %s
+	ret i32 %14
+}
+
+define i32 @f() {
+0:
+	%x = alloca i32
+	%1 = call i32 @sum(i32 10)
+	store i32 %1, i32* %x
+	%2 = load i32, i32* %x
+	%3 = icmp sgt i32 %2, 40
+	%y = alloca i32
+	br i1 %3, label %4, label %5
+
+4:
+	br label %6
+
+5:
+	store i32 0, i32* %x
+	br label %6
+
+6:
+	store i32 3, i32* %y
+	%7 = load i32, i32* %x
+	%8 = load i32, i32* %y
+	%9 = add i32 %7, %8
+	store i32 %9, i32* %x
+	br label %11
+
+10:
+	store i32 100, i32* %x
+	br label %11
+
+11:
+	%12 = load i32, i32* %x
+	ret i32 %12
+}
@@ -0,0 +1,19 @@
+int x = 5;
+char c = 'a';
+int neg = -3;
+int *p = 0;
+char *s = "hi";
+char buf[8] = "abc";
+char name[] = "uc";
+int a[] = {1, 2, 3};
+int m[2][3] = {{1, 2, 3}, {4}};
+struct point {
+	int x;
+	int y;
+};
+struct point origin = {7};
+int one = {1};
+
+int f(void) {
+	return x + c + neg + s[1] + buf[2] + buf[5] + name[1] + a[2] + m[0][2] + m[1][0] + m[1][2] + origin.x + origin.y + one;
+}
@@ -0,0 +1,55 @@
+%struct.point = type { i32, i32 }
+
+@x = global i32 5
+@c = global i8 97
+@neg = global i32 -3
+@p = global i32* null
+@.str = private unnamed_addr constant [3 x i8] c"hi\00"
+@s = global i8* getelementptr ([3 x i8], [3 x i8]* @.str, i64 0, i64 0)
+@buf = global [8 x i8] c"abc\00\00\00\00\00"
+@name = global [3 x i8] c"uc\00"
+@a = global [3 x i32] [i32 1, i32 2, i32 3]
+@m = global [2 x [3 x i32]] [[3 x i32] [i32 1, i32 2, i32 3], [3 x i32] [i32 4, i32 0, i32 0]]
+@origin = global %struct.point { i32 7, i32 0 }
+@one = global i32 1
+
+define i32 @f() {
+0:
+	%1 = load i32, i32* @x
+	%2 = load i8, i8* @c
+	%3 = sext i8 %2 to i32
+	%4 = add i32 %1, %3
+	%5 = load i32, i32* @neg
+	%6 = add i32 %4, %5
+	%7 = load i8*, i8** @s
+	%8 = getelementptr i8, i8* %7, i64 1
+	%9 = load i8, i8* %8
+	%10 = sext i8 %9 to i32
+	%11 = add i32 %6, %10
+	%12 = load i8, i8* getelementptr ([8 x i8], [8 x i8]* @buf, i64 0, i64 2)
+	%13 = sext i8 %12 to i32
+	%14 = add i32 %11, %13
+	%15 = load i8, i8* getelementptr ([8 x i8], [8 x i8]* @buf, i64 0, i64 5)
+	%16 = sext i8 %15 to i32
+	%17 = add i32 %14, %16
+	%18 = load i8, i8* getelementptr ([3 x i8], [3 x i8]* @name, i64 0, i64 1)
+	%19 = sext i8 %18 to i32
+	%20 = add i32 %17, %19
+	%21 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* @a, i64 0, i64 2)
+	%22 = add i32 %20, %21
+	%23 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* getelementptr ([2 x [3 x i32]], [2 x [3 x i32]]* @m, i64 0, i64 0), i64 0, i64 2)
+	%24 = add i32 %22, %23
+	%25 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* getelementptr ([2 x [3 x i32]], [2 x [3 x i32]]* @m, i64 0, i64 1), i64 0, i64 0)
+	%26 = add i32 %24, %25
+	%27 = load i32, i32* getelementptr ([3 x i32], [3 x i32]* getelementptr ([2 x [3 x i32]], [2 x [3 x i32]]* @m, i64 0, i64 1), i64 0, i64 2)
+	%28 = add i32 %26, %27
+	%29 = getelementptr %struct.point, %struct.point* @origin, i32 0, i32 0
+	%30 = load i32, i32* %29
+	%31 = add i32 %28, %30
+	%32 = getelementptr %struct.point, %struct.point* @origin, i32 0, i32 1
+	%33 = load i32, i32* %32
+	%34 = add i32 %31, %33
+	%35 = load i32, i32* @one
+	%36 = add i32 %34, %35
+	ret i32 %36
+}
@@ -0,0 +1,17 @@
+struct point {
+	int x;
+	int y;
+};
+
+int f(void) {
+	int x = 5;
+	char c = 300;
+	int *p = &x;
+	int a[] = {1, 2, 3};
+	int z[4] = {9};
+	int m[2][2] = {{1, 2}, {3, 4}};
+	char s[6] = "abc";
+	struct point pt = {3, 4};
+	struct point q = pt;
+	return x + c + *p + a[2] + z[0] + z[3] + m[1][1] + s[2] + s[4] + pt.y + q.x;
+}
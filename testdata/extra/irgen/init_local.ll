@@ -0,0 +1,81 @@
+%struct.point = type { i32, i32 }
+
+declare void @llvm.memset.p0i8.i64(i8* %0, i8 %1, i64 %2, i1 %3)
+
+define i32 @f() {
+0:
+	%x = alloca i32
+	store i32 5, i32* %x
+	%c = alloca i8
+	store i8 44, i8* %c
+	%p = alloca i32*
+	store i32* %x, i32** %p
+	%a = alloca [3 x i32]
+	%1 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 0
+	store i32 1, i32* %1
+	%2 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 1
+	store i32 2, i32* %2
+	%3 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 2
+	store i32 3, i32* %3
+	%z = alloca [4 x i32]
+	%4 = bitcast [4 x i32]* %z to i8*
+	call void @llvm.memset.p0i8.i64(i8* %4, i8 0, i64 ptrtoint ([4 x i32]* getelementptr ([4 x i32], [4 x i32]* null, i64 1) to i64), i1 false)
+	%5 = getelementptr [4 x i32], [4 x i32]* %z, i64 0, i64 0
+	store i32 9, i32* %5
+	%m = alloca [2 x [2 x i32]]
+	%6 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %m, i64 0, i64 0
+	%7 = getelementptr [2 x i32], [2 x i32]* %6, i64 0, i64 0
+	store i32 1, i32* %7
+	%8 = getelementptr [2 x i32], [2 x i32]* %6, i64 0, i64 1
+	store i32 2, i32* %8
+	%9 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %m, i64 0, i64 1
+	%10 = getelementptr [2 x i32], [2 x i32]* %9, i64 0, i64 0
+	store i32 3, i32* %10
+	%11 = getelementptr [2 x i32], [2 x i32]* %9, i64 0, i64 1
+	store i32 4, i32* %11
+	%s = alloca [6 x i8]
+	store [6 x i8] c"abc\00\00\00", [6 x i8]* %s
+	%pt = alloca %struct.point
+	%12 = getelementptr %struct.point, %struct.point* %pt, i32 0, i32 0
+	store i32 3, i32* %12
+	%13 = getelementptr %struct.point, %struct.point* %pt, i32 0, i32 1
+	store i32 4, i32* %13
+	%q = alloca %struct.point
+	%14 = load %struct.point, %struct.point* %pt
+	store %struct.point %14, %struct.point* %q
+	%15 = load i32, i32* %x
+	%16 = load i8, i8* %c
+	%17 = sext i8 %16 to i32
+	%18 = add i32 %15, %17
+	%19 = load i32*, i32** %p
+	%20 = load i32, i32* %19
+	%21 = add i32 %18, %20
+	%22 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 2
+	%23 = load i32, i32* %22
+	%24 = add i32 %21, %23
+	%25 = getelementptr [4 x i32], [4 x i32]* %z, i64 0, i64 0
+	%26 = load i32, i32* %25
+	%27 = add i32 %24, %26
+	%28 = getelementptr [4 x i32], [4 x i32]* %z, i64 0, i64 3
+	%29 = load i32, i32* %28
+	%30 = add i32 %27, %29
+	%31 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %m, i64 0, i64 1
+	%32 = getelementptr [2 x i32], [2 x i32]* %31, i64 0, i64 1
+	%33 = load i32, i32* %32
+	%34 = add i32 %30, %33
+	%35 = getelementptr [6 x i8], [6 x i8]* %s, i64 0, i64 2
+	%36 = load i8, i8* %35
+	%37 = sext i8 %36 to i32
+	%38 = add i32 %34, %37
+	%39 = getelementptr [6 x i8], [6 x i8]* %s, i64 0, i64 4
+	%40 = load i8, i8* %39
+	%41 = sext i8 %40 to i32
+	%42 = add i32 %38, %41
+	%43 = getelementptr %struct.point, %struct.point* %pt, i32 0, i32 1
+	%44 = load i32, i32* %43
+	%45 = add i32 %42, %44
+	%46 = getelementptr %struct.point, %struct.point* %q, i32 0, i32 0
+	%47 = load i32, i32* %46
+	%48 = add i32 %45, %47
+	ret i32 %48
+}
@@ -0,0 +1,3 @@
+int f() {
+	return 0x2A + 052 + 0X7fffffff;
+}
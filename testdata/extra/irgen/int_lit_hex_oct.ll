@@ -0,0 +1,4 @@
+define i32 @f() {
+0:
+	ret i32 -2147483565
+}
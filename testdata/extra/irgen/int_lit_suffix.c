@@ -0,0 +1,8 @@
+int f(void) {
+	int x;
+	x = -1;
+	// Unsigned comparison, as x is converted to unsigned int.
+	if (x < 0u)
+		return 1;
+	return sizeof(1u) + sizeof(1L) + sizeof(1ll) + sizeof(0x7FFFFFFFul);
+}
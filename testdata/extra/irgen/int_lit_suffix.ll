@@ -0,0 +1,14 @@
+define i32 @f() {
+0:
+	%x = alloca i32
+	store i32 -1, i32* %x
+	%1 = load i32, i32* %x
+	%2 = icmp ult i32 %1, 0
+	br i1 %2, label %3, label %4
+
+3:
+	ret i32 1
+
+4:
+	ret i32 28
+}
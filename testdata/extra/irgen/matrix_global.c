@@ -0,0 +1,23 @@
+int g[3][4];
+
+int sum(int a[][4], int n) {
+	int s;
+	int i;
+	int j;
+	s = 0;
+	for (i = 0; i < n; i++) {
+		for (j = 0; j < 4; j++) {
+			s += a[i][j];
+		}
+	}
+	return s;
+}
+
+int f(void) {
+	int i;
+	g[1][2] = 20;
+	g[2][3] = 30;
+	i = 2;
+	g[i][0] = i;
+	return sum(g, 3);
+}
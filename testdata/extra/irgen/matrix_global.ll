@@ -0,0 +1,79 @@
+@g = global [3 x [4 x i32]] zeroinitializer
+
+define i32 @sum([4 x i32]* %a, i32 %n) {
+0:
+	%1 = alloca [4 x i32]*
+	store [4 x i32]* %a, [4 x i32]** %1
+	%2 = alloca i32
+	store i32 %n, i32* %2
+	%s = alloca i32
+	%i = alloca i32
+	%j = alloca i32
+	store i32 0, i32* %s
+	store i32 0, i32* %i
+	br label %3
+
+3:
+	%4 = load i32, i32* %i
+	%5 = load i32, i32* %2
+	%6 = icmp slt i32 %4, %5
+	br i1 %6, label %7, label %29
+
+7:
+	store i32 0, i32* %j
+	br label %8
+
+8:
+	%9 = load i32, i32* %j
+	%10 = icmp slt i32 %9, 4
+	br i1 %10, label %11, label %25
+
+11:
+	%12 = load i32, i32* %j
+	%13 = sext i32 %12 to i64
+	%14 = load i32, i32* %i
+	%15 = sext i32 %14 to i64
+	%16 = load [4 x i32]*, [4 x i32]** %1
+	%17 = getelementptr [4 x i32], [4 x i32]* %16, i64 %15
+	%18 = getelementptr [4 x i32], [4 x i32]* %17, i64 0, i64 %13
+	%19 = load i32, i32* %18
+	%20 = load i32, i32* %s
+	%21 = add i32 %20, %19
+	store i32 %21, i32* %s
+	br label %22
+
+22:
+	%23 = load i32, i32* %j
+	%24 = add i32 %23, 1
+	store i32 %24, i32* %j
+	br label %8
+
+25:
+	br label %26
+
+26:
+	%27 = load i32, i32* %i
+	%28 = add i32 %27, 1
+	store i32 %28, i32* %i
+	br label %3
+
+29:
+	%30 = load i32, i32* %s
+	ret i32 %30
+}
+
+define i32 @f() {
+0:
+	%i = alloca i32
+	store i32 20, i32* getelementptr ([4 x i32], [4 x i32]* getelementptr ([3 x [4 x i32]], [3 x [4 x i32]]* @g, i64 0, i64 1), i64 0, i64 2)
+	store i32 30, i32* getelementptr ([4 x i32], [4 x i32]* getelementptr ([3 x [4 x i32]], [3 x [4 x i32]]* @g, i64 0, i64 2), i64 0, i64 3)
+	store i32 2, i32* %i
+	%1 = load i32, i32* %i
+	%2 = load i32, i32* %i
+	%3 = sext i32 %2 to i64
+	%4 = getelementptr [3 x [4 x i32]], [3 x [4 x i32]]* @g, i64 0, i64 %3
+	%5 = getelementptr [4 x i32], [4 x i32]* %4, i64 0, i64 0
+	store i32 %1, i32* %5
+	%6 = call i32 @sum([4 x i32]* getelementptr ([3 x [4 x i32]], [3 x [4 x i32]]* @g, i64 0, i64 0), i32 3)
+	ret i32 %6
+}
@@ -0,0 +1,15 @@
+int f(void) {
+	int m[2][3];
+	int i;
+	int j;
+	i = 0;
+	while (i < 2) {
+		j = 0;
+		while (j < 3) {
+			m[i][j] = i*10 + j;
+			j++;
+		}
+		i++;
+	}
+	return m[1][2] + m[0][1];
+}
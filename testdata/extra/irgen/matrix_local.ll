@@ -0,0 +1,55 @@
+define i32 @f() {
+0:
+	%m = alloca [2 x [3 x i32]]
+	%i = alloca i32
+	%j = alloca i32
+	store i32 0, i32* %i
+	br label %1
+
+1:
+	%2 = load i32, i32* %i
+	%3 = icmp slt i32 %2, 2
+	br i1 %3, label %4, label %24
+
+4:
+	store i32 0, i32* %j
+	br label %5
+
+5:
+	%6 = load i32, i32* %j
+	%7 = icmp slt i32 %6, 3
+	br i1 %7, label %8, label %21
+
+8:
+	%9 = load i32, i32* %i
+	%10 = mul i32 %9, 10
+	%11 = load i32, i32* %j
+	%12 = add i32 %10, %11
+	%13 = load i32, i32* %j
+	%14 = sext i32 %13 to i64
+	%15 = load i32, i32* %i
+	%16 = sext i32 %15 to i64
+	%17 = getelementptr [2 x [3 x i32]], [2 x [3 x i32]]* %m, i64 0, i64 %16
+	%18 = getelementptr [3 x i32], [3 x i32]* %17, i64 0, i64 %14
+	store i32 %12, i32* %18
+	%19 = load i32, i32* %j
+	%20 = add i32 %19, 1
+	store i32 %20, i32* %j
+	br label %5
+
+21:
+	%22 = load i32, i32* %i
+	%23 = add i32 %22, 1
+	store i32 %23, i32* %i
+	br label %1
+
+24:
+	%25 = getelementptr [2 x [3 x i32]], [2 x [3 x i32]]* %m, i64 0, i64 1
+	%26 = getelementptr [3 x i32], [3 x i32]* %25, i64 0, i64 2
+	%27 = load i32, i32* %26
+	%28 = getelementptr [2 x [3 x i32]], [2 x [3 x i32]]* %m, i64 0, i64 0
+	%29 = getelementptr [3 x i32], [3 x i32]* %28, i64 0, i64 1
+	%30 = load i32, i32* %29
+	%31 = add i32 %27, %30
+	ret i32 %31
+}
@@ -0,0 +1,13 @@
+int row(int r[], int n) {
+	return r[n-1];
+}
+
+int f(void) {
+	int m[2][2][2];
+	int *p;
+	m[1][0][1] = 7;
+	m[1][1][0] = 1;
+	m[1][1][1] = 5;
+	p = m[1][1];
+	return row(m[1][0], 2) * 10 + *p + p[1];
+}
@@ -0,0 +1,49 @@
+define i32 @row(i32* %r, i32 %n) {
+0:
+	%1 = alloca i32*
+	store i32* %r, i32** %1
+	%2 = alloca i32
+	store i32 %n, i32* %2
+	%3 = load i32, i32* %2
+	%4 = sub i32 %3, 1
+	%5 = sext i32 %4 to i64
+	%6 = load i32*, i32** %1
+	%7 = getelementptr i32, i32* %6, i64 %5
+	%8 = load i32, i32* %7
+	ret i32 %8
+}
+
+define i32 @f() {
+0:
+	%m = alloca [2 x [2 x [2 x i32]]]
+	%p = alloca i32*
+	%1 = getelementptr [2 x [2 x [2 x i32]]], [2 x [2 x [2 x i32]]]* %m, i64 0, i64 1
+	%2 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %1, i64 0, i64 0
+	%3 = getelementptr [2 x i32], [2 x i32]* %2, i64 0, i64 1
+	store i32 7, i32* %3
+	%4 = getelementptr [2 x [2 x [2 x i32]]], [2 x [2 x [2 x i32]]]* %m, i64 0, i64 1
+	%5 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %4, i64 0, i64 1
+	%6 = getelementptr [2 x i32], [2 x i32]* %5, i64 0, i64 0
+	store i32 1, i32* %6
+	%7 = getelementptr [2 x [2 x [2 x i32]]], [2 x [2 x [2 x i32]]]* %m, i64 0, i64 1
+	%8 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %7, i64 0, i64 1
+	%9 = getelementptr [2 x i32], [2 x i32]* %8, i64 0, i64 1
+	store i32 5, i32* %9
+	%10 = getelementptr [2 x [2 x [2 x i32]]], [2 x [2 x [2 x i32]]]* %m, i64 0, i64 1
+	%11 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %10, i64 0, i64 1
+	%12 = getelementptr [2 x i32], [2 x i32]* %11, i64 0, i64 0
+	store i32* %12, i32** %p
+	%13 = getelementptr [2 x [2 x [2 x i32]]], [2 x [2 x [2 x i32]]]* %m, i64 0, i64 1
+	%14 = getelementptr [2 x [2 x i32]], [2 x [2 x i32]]* %13, i64 0, i64 0
+	%15 = getelementptr [2 x i32], [2 x i32]* %14, i64 0, i64 0
+	%16 = call i32 @row(i32* %15, i32 2)
+	%17 = mul i32 %16, 10
+	%18 = load i32*, i32** %p
+	%19 = load i32, i32* %18
+	%20 = add i32 %17, %19
+	%21 = load i32*, i32** %p
+	%22 = getelementptr i32, i32* %21, i64 1
+	%23 = load i32, i32* %22
+	%24 = add i32 %20, %23
+	ret i32 %24
+}
@@ -0,0 +1,38 @@
+int sum(int n) {
+	int s;
+	int a[4];
+	void add(int x) {
+		s = s + x;
+	}
+	void fill(int i) {
+		if (i < n) {
+			a[i % 4] = i;
+			add(i);
+			fill(i + 1);
+		}
+	}
+	s = 0;
+	fill(0);
+	return s + a[3];
+}
+
+int f(void) {
+	int x;
+	int twice(int y) {
+		int inner(void) {
+			return x + y;
+		}
+		return inner() * 2;
+	}
+	int one(void) {
+		return 1;
+	}
+	x = 3;
+	{
+		int one(void) {
+			return 2;
+		}
+		x = x + one();
+	}
+	return sum(5) + twice(4) + one();
+}
@@ -0,0 +1,134 @@
+define void @sum.add({ i32* }* nest %env, i32 %x) {
+0:
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = getelementptr { i32* }, { i32* }* %env, i32 0, i32 0
+	%s = load i32*, i32** %2
+	%3 = load i32, i32* %s
+	%4 = load i32, i32* %1
+	%5 = add i32 %3, %4
+	store i32 %5, i32* %s
+	ret void
+}
+
+define void @sum.fill({ i32*, [4 x i32]*, i32* }* nest %env, i32 %i) {
+0:
+	%1 = alloca i32
+	store i32 %i, i32* %1
+	%2 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %env, i32 0, i32 0
+	%n = load i32*, i32** %2
+	%3 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %env, i32 0, i32 1
+	%a = load [4 x i32]*, [4 x i32]** %3
+	%4 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %env, i32 0, i32 2
+	%s = load i32*, i32** %4
+	%5 = load i32, i32* %1
+	%6 = load i32, i32* %n
+	%7 = icmp slt i32 %5, %6
+	%8 = alloca { i32* }
+	%9 = alloca { i32*, [4 x i32]*, i32* }
+	br i1 %7, label %10, label %23
+
+10:
+	%11 = load i32, i32* %1
+	%12 = load i32, i32* %1
+	%13 = srem i32 %12, 4
+	%14 = sext i32 %13 to i64
+	%15 = getelementptr [4 x i32], [4 x i32]* %a, i64 0, i64 %14
+	store i32 %11, i32* %15
+	%16 = load i32, i32* %1
+	%17 = getelementptr { i32* }, { i32* }* %8, i32 0, i32 0
+	store i32* %s, i32** %17
+	call void @sum.add({ i32* }* %8, i32 %16)
+	%18 = load i32, i32* %1
+	%19 = add i32 %18, 1
+	%20 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %9, i32 0, i32 0
+	store i32* %n, i32** %20
+	%21 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %9, i32 0, i32 1
+	store [4 x i32]* %a, [4 x i32]** %21
+	%22 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %9, i32 0, i32 2
+	store i32* %s, i32** %22
+	call void @sum.fill({ i32*, [4 x i32]*, i32* }* %9, i32 %19)
+	br label %23
+
+23:
+	ret void
+}
+
+define i32 @sum(i32 %n) {
+0:
+	%1 = alloca i32
+	store i32 %n, i32* %1
+	%s = alloca i32
+	%a = alloca [4 x i32]
+	store i32 0, i32* %s
+	%2 = alloca { i32*, [4 x i32]*, i32* }
+	%3 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %2, i32 0, i32 0
+	store i32* %1, i32** %3
+	%4 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %2, i32 0, i32 1
+	store [4 x i32]* %a, [4 x i32]** %4
+	%5 = getelementptr { i32*, [4 x i32]*, i32* }, { i32*, [4 x i32]*, i32* }* %2, i32 0, i32 2
+	store i32* %s, i32** %5
+	call void @sum.fill({ i32*, [4 x i32]*, i32* }* %2, i32 0)
+	%6 = load i32, i32* %s
+	%7 = getelementptr [4 x i32], [4 x i32]* %a, i64 0, i64 3
+	%8 = load i32, i32* %7
+	%9 = add i32 %6, %8
+	ret i32 %9
+}
+
+define i32 @f.twice.inner({ i32*, i32* }* nest %env) {
+0:
+	%1 = getelementptr { i32*, i32* }, { i32*, i32* }* %env, i32 0, i32 0
+	%x = load i32*, i32** %1
+	%2 = getelementptr { i32*, i32* }, { i32*, i32* }* %env, i32 0, i32 1
+	%y = load i32*, i32** %2
+	%3 = load i32, i32* %x
+	%4 = load i32, i32* %y
+	%5 = add i32 %3, %4
+	ret i32 %5
+}
+
+define i32 @f.twice({ i32* }* nest %env, i32 %y) {
+0:
+	%1 = alloca i32
+	store i32 %y, i32* %1
+	%2 = getelementptr { i32* }, { i32* }* %env, i32 0, i32 0
+	%x = load i32*, i32** %2
+	%3 = alloca { i32*, i32* }
+	%4 = getelementptr { i32*, i32* }, { i32*, i32* }* %3, i32 0, i32 0
+	store i32* %x, i32** %4
+	%5 = getelementptr { i32*, i32* }, { i32*, i32* }* %3, i32 0, i32 1
+	store i32* %1, i32** %5
+	%6 = call i32 @f.twice.inner({ i32*, i32* }* %3)
+	%7 = mul i32 %6, 2
+	ret i32 %7
+}
+
+define i32 @f.one() {
+0:
+	ret i32 1
+}
+
+define i32 @f.one.1() {
+0:
+	ret i32 2
+}
+
+define i32 @f() {
+0:
+	%x = alloca i32
+	store i32 3, i32* %x
+	%1 = load i32, i32* %x
+	%2 = call i32 @f.one.1()
+	%3 = add i32 %1, %2
+	store i32 %3, i32* %x
+	%4 = call i32 @sum(i32 5)
+	%5 = alloca { i32* }
+	%6 = getelementptr { i32* }, { i32* }* %5, i32 0, i32 0
+	store i32* %x, i32** %6
+	%7 = call i32 @f.twice({ i32* }* %5, i32 4)
+	%8 = add i32 %4, %7
+	%9 = call i32 @f.one()
+	%10 = add i32 %8, %9
+	ret i32 %10
+}
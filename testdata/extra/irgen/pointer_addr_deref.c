@@ -0,0 +1,7 @@
+int f(void) {
+	int x;
+	int *p;
+	p = &x;
+	*p = 42;
+	return x;
+}
@@ -0,0 +1,10 @@
+define i32 @f() {
+0:
+	%x = alloca i32
+	%p = alloca i32*
+	store i32* %x, i32** %p
+	%1 = load i32*, i32** %p
+	store i32 42, i32* %1
+	%2 = load i32, i32* %x
+	ret i32 %2
+}
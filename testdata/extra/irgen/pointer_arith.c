@@ -0,0 +1,17 @@
+int f(void) {
+	int a[5];
+	int *p;
+	int *q;
+	int i;
+	for (i = 0; i < 5; i++) {
+		a[i] = i * 10;
+	}
+	p = a;
+	q = p + 4;
+	q--;
+	p += 1;
+	if (p == q || p > q) {
+		return 0;
+	}
+	return *q + p[1] + (q - p);
+}
@@ -0,0 +1,77 @@
+define i32 @f() {
+0:
+	%a = alloca [5 x i32]
+	%p = alloca i32*
+	%q = alloca i32*
+	%i = alloca i32
+	store i32 0, i32* %i
+	br label %1
+
+1:
+	%2 = load i32, i32* %i
+	%3 = icmp slt i32 %2, 5
+	br i1 %3, label %4, label %13
+
+4:
+	%5 = load i32, i32* %i
+	%6 = mul i32 %5, 10
+	%7 = load i32, i32* %i
+	%8 = sext i32 %7 to i64
+	%9 = getelementptr [5 x i32], [5 x i32]* %a, i64 0, i64 %8
+	store i32 %6, i32* %9
+	br label %10
+
+10:
+	%11 = load i32, i32* %i
+	%12 = add i32 %11, 1
+	store i32 %12, i32* %i
+	br label %1
+
+13:
+	%14 = getelementptr [5 x i32], [5 x i32]* %a, i64 0, i64 0
+	store i32* %14, i32** %p
+	%15 = load i32*, i32** %p
+	%16 = getelementptr i32, i32* %15, i64 4
+	store i32* %16, i32** %q
+	%17 = load i32*, i32** %q
+	%18 = getelementptr i32, i32* %17, i64 -1
+	store i32* %18, i32** %q
+	%19 = load i32*, i32** %p
+	%20 = getelementptr i32, i32* %19, i64 1
+	store i32* %20, i32** %p
+	%21 = load i32*, i32** %p
+	%22 = load i32*, i32** %q
+	%23 = icmp eq i32* %21, %22
+	br i1 %23, label %28, label %24
+
+24:
+	%25 = load i32*, i32** %p
+	%26 = load i32*, i32** %q
+	%27 = icmp ugt i32* %25, %26
+	br label %28
+
+28:
+	%29 = phi i1 [ true, %13 ], [ %27, %24 ]
+	br i1 %29, label %30, label %31
+
+30:
+	ret i32 0
+
+31:
+	%32 = load i32*, i32** %q
+	%33 = load i32, i32* %32
+	%34 = load i32*, i32** %p
+	%35 = getelementptr i32, i32* %34, i64 1
+	%36 = load i32, i32* %35
+	%37 = add i32 %33, %36
+	%38 = load i32*, i32** %q
+	%39 = load i32*, i32** %p
+	%40 = ptrtoint i32* %38 to i64
+	%41 = ptrtoint i32* %39 to i64
+	%42 = sub i64 %40, %41
+	%43 = sdiv exact i64 %42, ptrtoint (i32* getelementptr (i32, i32* null, i64 1) to i64)
+	%44 = sext i32 %37 to i64
+	%45 = add i64 %44, %43
+	%46 = trunc i64 %45 to i32
+	ret i32 %46
+}
@@ -0,0 +1,34 @@
+void swap(int *a, int *b) {
+	int t;
+	t = *a;
+	*a = *b;
+	*b = t;
+}
+
+int sum(int *p, int n) {
+	int s;
+	s = 0;
+	while (n > 0) {
+		s += *p++;
+		n--;
+	}
+	return s;
+}
+
+int f(void) {
+	int x;
+	int y;
+	int a[3];
+	char *s;
+	s = 0;
+	if (s != 0) {
+		return 0;
+	}
+	x = 1;
+	y = 2;
+	swap(&x, &y);
+	a[0] = 3;
+	a[1] = 4;
+	a[2] = 5;
+	return x * 100 + y * 10 + sum(a, 3) - !s;
+}
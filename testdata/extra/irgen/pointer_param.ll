@@ -0,0 +1,92 @@
+define void @swap(i32* %a, i32* %b) {
+0:
+	%1 = alloca i32*
+	store i32* %a, i32** %1
+	%2 = alloca i32*
+	store i32* %b, i32** %2
+	%t = alloca i32
+	%3 = load i32*, i32** %1
+	%4 = load i32, i32* %3
+	store i32 %4, i32* %t
+	%5 = load i32*, i32** %2
+	%6 = load i32, i32* %5
+	%7 = load i32*, i32** %1
+	store i32 %6, i32* %7
+	%8 = load i32, i32* %t
+	%9 = load i32*, i32** %2
+	store i32 %8, i32* %9
+	ret void
+}
+
+define i32 @sum(i32* %p, i32 %n) {
+0:
+	%1 = alloca i32*
+	store i32* %p, i32** %1
+	%2 = alloca i32
+	store i32 %n, i32* %2
+	%s = alloca i32
+	store i32 0, i32* %s
+	br label %3
+
+3:
+	%4 = load i32, i32* %2
+	%5 = icmp sgt i32 %4, 0
+	br i1 %5, label %6, label %14
+
+6:
+	%7 = load i32*, i32** %1
+	%8 = getelementptr i32, i32* %7, i64 1
+	store i32* %8, i32** %1
+	%9 = load i32, i32* %7
+	%10 = load i32, i32* %s
+	%11 = add i32 %10, %9
+	store i32 %11, i32* %s
+	%12 = load i32, i32* %2
+	%13 = sub i32 %12, 1
+	store i32 %13, i32* %2
+	br label %3
+
+14:
+	%15 = load i32, i32* %s
+	ret i32 %15
+}
+
+define i32 @f() {
+0:
+	%x = alloca i32
+	%y = alloca i32
+	%a = alloca [3 x i32]
+	%s = alloca i8*
+	store i8* null, i8** %s
+	%1 = load i8*, i8** %s
+	%2 = icmp ne i8* %1, null
+	br i1 %2, label %3, label %4
+
+3:
+	ret i32 0
+
+4:
+	store i32 1, i32* %x
+	store i32 2, i32* %y
+	call void @swap(i32* %x, i32* %y)
+	%5 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 0
+	store i32 3, i32* %5
+	%6 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 1
+	store i32 4, i32* %6
+	%7 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 2
+	store i32 5, i32* %7
+	%8 = load i32, i32* %x
+	%9 = mul i32 %8, 100
+	%10 = load i32, i32* %y
+	%11 = mul i32 %10, 10
+	%12 = add i32 %9, %11
+	%13 = getelementptr [3 x i32], [3 x i32]* %a, i64 0, i64 0
+	%14 = call i32 @sum(i32* %13, i32 3)
+	%15 = add i32 %12, %14
+	%16 = load i8*, i8** %s
+	%17 = icmp ne i8* %16, null
+	%18 = xor i1 %17, true
+	%19 = zext i1 %18 to i32
+	%20 = sub i32 %15, %19
+	ret i32 %20
+}
@@ -0,0 +1,8 @@
+int f() {
+	int x;
+	int y;
+	x = 1;
+	y = x++;
+	y = y + x--;
+	return y;
+}
@@ -0,0 +1,18 @@
+define i32 @f() {
+0:
+	%x = alloca i32
+	%y = alloca i32
+	store i32 1, i32* %x
+	%1 = load i32, i32* %x
+	%2 = add i32 %1, 1
+	store i32 %2, i32* %x
+	store i32 %1, i32* %y
+	%3 = load i32, i32* %y
+	%4 = load i32, i32* %x
+	%5 = sub i32 %4, 1
+	store i32 %5, i32* %x
+	%6 = add i32 %3, %4
+	store i32 %6, i32* %y
+	%7 = load i32, i32* %y
+	ret i32 %7
+}
@@ -0,0 +1,7 @@
+void putstring(char s[]);
+
+int main(void) {
+	putstring("hello\tworld\n");
+	putstring("\"quoted\"\n");
+	return 0;
+}
@@ -0,0 +1,11 @@
+@.str = private unnamed_addr constant [13 x i8] c"hello\09world\0A\00"
+@.str.1 = private unnamed_addr constant [10 x i8] c"\22quoted\22\0A\00"
+
+declare void @putstring(i8* %s)
+
+define i32 @main() {
+0:
+	call void @putstring(i8* getelementptr ([13 x i8], [13 x i8]* @.str, i64 0, i64 0))
+	call void @putstring(i8* getelementptr ([10 x i8], [10 x i8]* @.str.1, i64 0, i64 0))
+	ret i32 0
+}
@@ -0,0 +1,21 @@
+struct point {
+	int x;
+	int y;
+	int tags[2];
+};
+
+int f(void) {
+	struct point a;
+	struct point b;
+	struct point c;
+	int cond;
+	a.x = 1;
+	a.y = 2;
+	a.tags[1] = 5;
+	b.x = 3;
+	b.y = 4;
+	b.tags[1] = 6;
+	cond = 0;
+	c = cond ? a : b;
+	return (cond ? a : b).x * 100 + (!cond ? a : b).y * 10 + (cond ? a : b).tags[1] + c.x;
+}
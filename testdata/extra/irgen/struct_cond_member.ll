@@ -0,0 +1,99 @@
+%struct.point = type { i32, i32, [2 x i32] }
+
+define i32 @f() {
+0:
+	%a = alloca %struct.point
+	%b = alloca %struct.point
+	%c = alloca %struct.point
+	%cond = alloca i32
+	%1 = getelementptr %struct.point, %struct.point* %a, i32 0, i32 0
+	store i32 1, i32* %1
+	%2 = getelementptr %struct.point, %struct.point* %a, i32 0, i32 1
+	store i32 2, i32* %2
+	%3 = getelementptr %struct.point, %struct.point* %a, i32 0, i32 2
+	%4 = getelementptr [2 x i32], [2 x i32]* %3, i64 0, i64 1
+	store i32 5, i32* %4
+	%5 = getelementptr %struct.point, %struct.point* %b, i32 0, i32 0
+	store i32 3, i32* %5
+	%6 = getelementptr %struct.point, %struct.point* %b, i32 0, i32 1
+	store i32 4, i32* %6
+	%7 = getelementptr %struct.point, %struct.point* %b, i32 0, i32 2
+	%8 = getelementptr [2 x i32], [2 x i32]* %7, i64 0, i64 1
+	store i32 6, i32* %8
+	store i32 0, i32* %cond
+	%9 = load i32, i32* %cond
+	%10 = icmp ne i32 %9, 0
+	%11 = alloca %struct.point
+	br i1 %10, label %12, label %14
+
+12:
+	%13 = load %struct.point, %struct.point* %a
+	br label %16
+
+14:
+	%15 = load %struct.point, %struct.point* %b
+	br label %16
+
+16:
+	%17 = phi %struct.point [ %13, %12 ], [ %15, %14 ]
+	store %struct.point %17, %struct.point* %c
+	%18 = load i32, i32* %cond
+	%19 = icmp ne i32 %18, 0
+	br i1 %19, label %20, label %22
+
+20:
+	%21 = load %struct.point, %struct.point* %a
+	br label %24
+
+22:
+	%23 = load %struct.point, %struct.point* %b
+	br label %24
+
+24:
+	%25 = phi %struct.point [ %21, %20 ], [ %23, %22 ]
+	%26 = extractvalue %struct.point %25, 0
+	%27 = mul i32 %26, 100
+	%28 = load i32, i32* %cond
+	%29 = icmp ne i32 %28, 0
+	%30 = xor i1 %29, true
+	%31 = zext i1 %30 to i32
+	%32 = icmp ne i32 %31, 0
+	br i1 %32, label %33, label %35
+
+33:
+	%34 = load %struct.point, %struct.point* %a
+	br label %37
+
+35:
+	%36 = load %struct.point, %struct.point* %b
+	br label %37
+
+37:
+	%38 = phi %struct.point [ %34, %33 ], [ %36, %35 ]
+	%39 = extractvalue %struct.point %38, 1
+	%40 = mul i32 %39, 10
+	%41 = add i32 %27, %40
+	%42 = load i32, i32* %cond
+	%43 = icmp ne i32 %42, 0
+	br i1 %43, label %44, label %46
+
+44:
+	%45 = load %struct.point, %struct.point* %a
+	br label %48
+
+46:
+	%47 = load %struct.point, %struct.point* %b
+	br label %48
+
+48:
+	%49 = phi %struct.point [ %45, %44 ], [ %47, %46 ]
+	store %struct.point %49, %struct.point* %11
+	%50 = getelementptr %struct.point, %struct.point* %11, i32 0, i32 2
+	%51 = getelementptr [2 x i32], [2 x i32]* %50, i64 0, i64 1
+	%52 = load i32, i32* %51
+	%53 = add i32 %41, %52
+	%54 = getelementptr %struct.point, %struct.point* %c, i32 0, i32 0
+	%55 = load i32, i32* %54
+	%56 = add i32 %53, %55
+	ret i32 %56
+}
@@ -0,0 +1,33 @@
+struct b;
+
+struct a {
+	int v;
+	struct b *next;
+};
+
+struct b {
+	int w;
+	struct a *prev;
+	struct c *opaque;
+};
+
+typedef struct node node;
+
+struct node {
+	int v;
+	node *next;
+};
+
+int f(void) {
+	struct a x;
+	struct b y;
+	node n;
+	x.v = 1;
+	y.w = 2;
+	x.next = &y;
+	y.prev = &x;
+	y.opaque = 0;
+	n.v = 3;
+	n.next = &n;
+	return x.next->prev->v * 100 + x.next->w * 10 + n.next->v + sizeof(struct b);
+}
@@ -0,0 +1,47 @@
+%struct.c = type opaque
+%struct.b = type { i32, %struct.a*, %struct.c* }
+%struct.a = type { i32, %struct.b* }
+%struct.node = type { i32, %struct.node* }
+
+define i32 @f() {
+0:
+	%x = alloca %struct.a
+	%y = alloca %struct.b
+	%n = alloca %struct.node
+	%1 = getelementptr %struct.a, %struct.a* %x, i32 0, i32 0
+	store i32 1, i32* %1
+	%2 = getelementptr %struct.b, %struct.b* %y, i32 0, i32 0
+	store i32 2, i32* %2
+	%3 = getelementptr %struct.a, %struct.a* %x, i32 0, i32 1
+	store %struct.b* %y, %struct.b** %3
+	%4 = getelementptr %struct.b, %struct.b* %y, i32 0, i32 1
+	store %struct.a* %x, %struct.a** %4
+	%5 = getelementptr %struct.b, %struct.b* %y, i32 0, i32 2
+	store %struct.c* null, %struct.c** %5
+	%6 = getelementptr %struct.node, %struct.node* %n, i32 0, i32 0
+	store i32 3, i32* %6
+	%7 = getelementptr %struct.node, %struct.node* %n, i32 0, i32 1
+	store %struct.node* %n, %struct.node** %7
+	%8 = getelementptr %struct.a, %struct.a* %x, i32 0, i32 1
+	%9 = load %struct.b*, %struct.b** %8
+	%10 = getelementptr %struct.b, %struct.b* %9, i32 0, i32 1
+	%11 = load %struct.a*, %struct.a** %10
+	%12 = getelementptr %struct.a, %struct.a* %11, i32 0, i32 0
+	%13 = load i32, i32* %12
+	%14 = mul i32 %13, 100
+	%15 = getelementptr %struct.a, %struct.a* %x, i32 0, i32 1
+	%16 = load %struct.b*, %struct.b** %15
+	%17 = getelementptr %struct.b, %struct.b* %16, i32 0, i32 0
+	%18 = load i32, i32* %17
+	%19 = mul i32 %18, 10
+	%20 = add i32 %14, %19
+	%21 = getelementptr %struct.node, %struct.node* %n, i32 0, i32 1
+	%22 = load %struct.node*, %struct.node** %21
+	%23 = getelementptr %struct.node, %struct.node* %22, i32 0, i32 0
+	%24 = load i32, i32* %23
+	%25 = add i32 %20, %24
+	%26 = sext i32 %25 to i64
+	%27 = add i64 %26, 24
+	%28 = trunc i64 %27 to i32
+	ret i32 %28
+}
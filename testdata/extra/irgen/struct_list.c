@@ -0,0 +1,35 @@
+struct node {
+	int val;
+	struct node *next;
+};
+
+int sum(struct node *n) {
+	int s;
+	s = 0;
+	while (n != 0) {
+		s += n->val;
+		n = n->next;
+	}
+	return s;
+}
+
+int f(void) {
+	struct node a;
+	struct node b;
+	struct node c;
+	struct {
+		char tag;
+		int buf[3];
+	} anon;
+	int *p;
+	a.val = 1;
+	a.next = &b;
+	b.val = 2;
+	b.next = &c;
+	c.val = 3;
+	c.next = 0;
+	anon.tag = 4;
+	p = anon.buf;
+	p[2] = 5;
+	return sum(&a) + anon.tag * *(anon.buf + 2);
+}
@@ -0,0 +1,72 @@
+%struct.node = type { i32, %struct.node* }
+
+define i32 @sum(%struct.node* %n) {
+0:
+	%1 = alloca %struct.node*
+	store %struct.node* %n, %struct.node** %1
+	%s = alloca i32
+	store i32 0, i32* %s
+	br label %2
+
+2:
+	%3 = load %struct.node*, %struct.node** %1
+	%4 = icmp ne %struct.node* %3, null
+	br i1 %4, label %5, label %14
+
+5:
+	%6 = load %struct.node*, %struct.node** %1
+	%7 = getelementptr %struct.node, %struct.node* %6, i32 0, i32 0
+	%8 = load i32, i32* %7
+	%9 = load i32, i32* %s
+	%10 = add i32 %9, %8
+	store i32 %10, i32* %s
+	%11 = load %struct.node*, %struct.node** %1
+	%12 = getelementptr %struct.node, %struct.node* %11, i32 0, i32 1
+	%13 = load %struct.node*, %struct.node** %12
+	store %struct.node* %13, %struct.node** %1
+	br label %2
+
+14:
+	%15 = load i32, i32* %s
+	ret i32 %15
+}
+
+define i32 @f() {
+0:
+	%a = alloca %struct.node
+	%b = alloca %struct.node
+	%c = alloca %struct.node
+	%anon = alloca { i8, [3 x i32] }
+	%p = alloca i32*
+	%1 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 0
+	store i32 1, i32* %1
+	%2 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 1
+	store %struct.node* %b, %struct.node** %2
+	%3 = getelementptr %struct.node, %struct.node* %b, i32 0, i32 0
+	store i32 2, i32* %3
+	%4 = getelementptr %struct.node, %struct.node* %b, i32 0, i32 1
+	store %struct.node* %c, %struct.node** %4
+	%5 = getelementptr %struct.node, %struct.node* %c, i32 0, i32 0
+	store i32 3, i32* %5
+	%6 = getelementptr %struct.node, %struct.node* %c, i32 0, i32 1
+	store %struct.node* null, %struct.node** %6
+	%7 = getelementptr { i8, [3 x i32] }, { i8, [3 x i32] }* %anon, i32 0, i32 0
+	store i8 4, i8* %7
+	%8 = getelementptr { i8, [3 x i32] }, { i8, [3 x i32] }* %anon, i32 0, i32 1
+	%9 = getelementptr [3 x i32], [3 x i32]* %8, i64 0, i64 0
+	store i32* %9, i32** %p
+	%10 = load i32*, i32** %p
+	%11 = getelementptr i32, i32* %10, i64 2
+	store i32 5, i32* %11
+	%12 = call i32 @sum(%struct.node* %a)
+	%13 = getelementptr { i8, [3 x i32] }, { i8, [3 x i32] }* %anon, i32 0, i32 0
+	%14 = load i8, i8* %13
+	%15 = getelementptr { i8, [3 x i32] }, { i8, [3 x i32] }* %anon, i32 0, i32 1
+	%16 = getelementptr [3 x i32], [3 x i32]* %15, i64 0, i64 0
+	%17 = getelementptr i32, i32* %16, i64 2
+	%18 = load i32, i32* %17
+	%19 = sext i8 %14 to i32
+	%20 = mul i32 %19, %18
+	%21 = add i32 %12, %20
+	ret i32 %21
+}
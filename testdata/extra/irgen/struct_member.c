@@ -0,0 +1,19 @@
+struct point {
+	int x;
+	int y;
+};
+
+typedef struct point point_t;
+
+struct point origin;
+
+int f(void) {
+	point_t p;
+	struct point *q;
+	p.x = 3;
+	p.y = 4;
+	q = &p;
+	q->x += 10;
+	origin = p;
+	return origin.x * 10 + q->y;
+}
@@ -0,0 +1,29 @@
+%struct.point = type { i32, i32 }
+
+@origin = global %struct.point zeroinitializer
+
+define i32 @f() {
+0:
+	%p = alloca %struct.point
+	%q = alloca %struct.point*
+	%1 = getelementptr %struct.point, %struct.point* %p, i32 0, i32 0
+	store i32 3, i32* %1
+	%2 = getelementptr %struct.point, %struct.point* %p, i32 0, i32 1
+	store i32 4, i32* %2
+	store %struct.point* %p, %struct.point** %q
+	%3 = load %struct.point*, %struct.point** %q
+	%4 = getelementptr %struct.point, %struct.point* %3, i32 0, i32 0
+	%5 = load i32, i32* %4
+	%6 = add i32 %5, 10
+	store i32 %6, i32* %4
+	%7 = load %struct.point, %struct.point* %p
+	store %struct.point %7, %struct.point* @origin
+	%8 = getelementptr %struct.point, %struct.point* @origin, i32 0, i32 0
+	%9 = load i32, i32* %8
+	%10 = mul i32 %9, 10
+	%11 = load %struct.point*, %struct.point** %q
+	%12 = getelementptr %struct.point, %struct.point* %11, i32 0, i32 1
+	%13 = load i32, i32* %12
+	%14 = add i32 %10, %13
+	ret i32 %14
+}
@@ -0,0 +1,31 @@
+struct point {
+	int x;
+	int y;
+};
+
+struct line {
+	struct point from;
+	struct point to;
+	int tags[2];
+};
+
+struct point mk(int x, int y) {
+	struct point p;
+	p.x = x;
+	p.y = y;
+	return p;
+}
+
+struct line mkline(void) {
+	struct line l;
+	l.from = mk(1, 2);
+	l.to = mk(3, 4);
+	l.tags[0] = 5;
+	l.tags[1] = 6;
+	return l;
+}
+
+int f(void) {
+	struct point a;
+	return (mk(10, 20)).x + (a = mk(30, 40)).y + a.x + mkline().to.y + mkline().tags[1];
+}
@@ -0,0 +1,64 @@
+%struct.point = type { i32, i32 }
+%struct.line = type { %struct.point, %struct.point, [2 x i32] }
+
+define %struct.point @mk(i32 %x, i32 %y) {
+0:
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = alloca i32
+	store i32 %y, i32* %2
+	%p = alloca %struct.point
+	%3 = load i32, i32* %1
+	%4 = getelementptr %struct.point, %struct.point* %p, i32 0, i32 0
+	store i32 %3, i32* %4
+	%5 = load i32, i32* %2
+	%6 = getelementptr %struct.point, %struct.point* %p, i32 0, i32 1
+	store i32 %5, i32* %6
+	%7 = load %struct.point, %struct.point* %p
+	ret %struct.point %7
+}
+
+define %struct.line @mkline() {
+0:
+	%l = alloca %struct.line
+	%1 = call %struct.point @mk(i32 1, i32 2)
+	%2 = getelementptr %struct.line, %struct.line* %l, i32 0, i32 0
+	store %struct.point %1, %struct.point* %2
+	%3 = call %struct.point @mk(i32 3, i32 4)
+	%4 = getelementptr %struct.line, %struct.line* %l, i32 0, i32 1
+	store %struct.point %3, %struct.point* %4
+	%5 = getelementptr %struct.line, %struct.line* %l, i32 0, i32 2
+	%6 = getelementptr [2 x i32], [2 x i32]* %5, i64 0, i64 0
+	store i32 5, i32* %6
+	%7 = getelementptr %struct.line, %struct.line* %l, i32 0, i32 2
+	%8 = getelementptr [2 x i32], [2 x i32]* %7, i64 0, i64 1
+	store i32 6, i32* %8
+	%9 = load %struct.line, %struct.line* %l
+	ret %struct.line %9
+}
+
+define i32 @f() {
+0:
+	%a = alloca %struct.point
+	%1 = call %struct.point @mk(i32 10, i32 20)
+	%2 = extractvalue %struct.point %1, 0
+	%3 = call %struct.point @mk(i32 30, i32 40)
+	store %struct.point %3, %struct.point* %a
+	%4 = extractvalue %struct.point %3, 1
+	%5 = add i32 %2, %4
+	%6 = getelementptr %struct.point, %struct.point* %a, i32 0, i32 0
+	%7 = load i32, i32* %6
+	%8 = add i32 %5, %7
+	%9 = call %struct.line @mkline()
+	%10 = extractvalue %struct.line %9, 1
+	%11 = extractvalue %struct.point %10, 1
+	%12 = add i32 %8, %11
+	%13 = call %struct.line @mkline()
+	%14 = alloca %struct.line
+	store %struct.line %13, %struct.line* %14
+	%15 = getelementptr %struct.line, %struct.line* %14, i32 0, i32 2
+	%16 = getelementptr [2 x i32], [2 x i32]* %15, i64 0, i64 1
+	%17 = load i32, i32* %16
+	%18 = add i32 %12, %17
+	ret i32 %18
+}
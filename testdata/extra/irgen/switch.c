@@ -0,0 +1,48 @@
+int classify(int c) {
+	int n;
+	n = 0;
+	switch (c) {
+		int k;
+	case 'a':
+	case 'e':
+		n = 10;
+		break;
+	case 1 + 1:
+		n = 20;
+	case -1:
+		n = n + 1;
+		break;
+	default:
+		k = 5;
+		n = k * 100;
+		break;
+		n = 3;
+	}
+	return n;
+}
+
+int count(unsigned char x) {
+	int i;
+	int total;
+	total = 0;
+	for (i = 0; i < 4; i++) {
+		switch (i) {
+		case 0:
+			continue;
+		case 3:
+			switch (x) {
+			case 255:
+				total = total + 1000;
+				break;
+			}
+			break;
+		default:
+			total = total + i;
+		}
+	}
+	return total;
+}
+
+int f(void) {
+	return classify('a') + classify(2) + classify(-1) + classify(7) + count(255);
+}
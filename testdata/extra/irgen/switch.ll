@@ -0,0 +1,123 @@
+define i32 @classify(i32 %c) {
+0:
+	%1 = alloca i32
+	store i32 %c, i32* %1
+	%n = alloca i32
+	store i32 0, i32* %n
+	%2 = load i32, i32* %1
+	%k = alloca i32
+	switch i32 %2, label %9 [
+		i32 97, label %3
+		i32 101, label %4
+		i32 2, label %5
+		i32 -1, label %6
+	]
+
+3:
+	br label %4
+
+4:
+	store i32 10, i32* %n
+	br label %13
+
+5:
+	store i32 20, i32* %n
+	br label %6
+
+6:
+	%7 = load i32, i32* %n
+	%8 = add i32 %7, 1
+	store i32 %8, i32* %n
+	br label %13
+
+9:
+	store i32 5, i32* %k
+	%10 = load i32, i32* %k
+	%11 = mul i32 %10, 100
+	store i32 %11, i32* %n
+	br label %13
+
+12:
+	store i32 3, i32* %n
+	br label %13
+
+13:
+	%14 = load i32, i32* %n
+	ret i32 %14
+}
+
+define i32 @count(i8 %x) {
+0:
+	%1 = alloca i8
+	store i8 %x, i8* %1
+	%i = alloca i32
+	%total = alloca i32
+	store i32 0, i32* %total
+	store i32 0, i32* %i
+	br label %2
+
+2:
+	%3 = load i32, i32* %i
+	%4 = icmp slt i32 %3, 4
+	br i1 %4, label %5, label %23
+
+5:
+	%6 = load i32, i32* %i
+	switch i32 %6, label %15 [
+		i32 0, label %7
+		i32 3, label %8
+	]
+
+7:
+	br label %20
+
+8:
+	%9 = load i8, i8* %1
+	%10 = zext i8 %9 to i32
+	switch i32 %10, label %14 [
+		i32 255, label %11
+	]
+
+11:
+	%12 = load i32, i32* %total
+	%13 = add i32 %12, 1000
+	store i32 %13, i32* %total
+	br label %14
+
+14:
+	br label %19
+
+15:
+	%16 = load i32, i32* %total
+	%17 = load i32, i32* %i
+	%18 = add i32 %16, %17
+	store i32 %18, i32* %total
+	br label %19
+
+19:
+	br label %20
+
+20:
+	%21 = load i32, i32* %i
+	%22 = add i32 %21, 1
+	store i32 %22, i32* %i
+	br label %2
+
+23:
+	%24 = load i32, i32* %total
+	ret i32 %24
+}
+
+define i32 @f() {
+0:
+	%1 = call i32 @classify(i32 97)
+	%2 = call i32 @classify(i32 2)
+	%3 = add i32 %1, %2
+	%4 = call i32 @classify(i32 -1)
+	%5 = add i32 %3, %4
+	%6 = call i32 @classify(i32 7)
+	%7 = add i32 %5, %6
+	%8 = call i32 @count(i8 -1)
+	%9 = add i32 %7, %8
+	ret i32 %9
+}
@@ -0,0 +1,35 @@
+typedef struct node {
+	int v;
+	struct node *next;
+} node;
+
+typedef int T;
+
+int sum(node *p) {
+	int s;
+	s = 0;
+	while (p) {
+		s = s + p->v;
+		p = p->next;
+	}
+	return s;
+}
+
+int f(void) {
+	node a;
+	node b;
+	char *q;
+	T x;
+	a.v = 1;
+	a.next = &b;
+	b.v = 2;
+	b.next = 0;
+	q = (char *)&a;
+	x = sum((node *)q) + sizeof(node) + (T)1;
+	{
+		int node;
+		node = 3;
+		x = x + node;
+	}
+	return x;
+}
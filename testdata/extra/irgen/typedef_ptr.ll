@@ -0,0 +1,66 @@
+%struct.node = type { i32, %struct.node* }
+
+define i32 @sum(%struct.node* %p) {
+0:
+	%1 = alloca %struct.node*
+	store %struct.node* %p, %struct.node** %1
+	%s = alloca i32
+	store i32 0, i32* %s
+	br label %2
+
+2:
+	%3 = load %struct.node*, %struct.node** %1
+	%4 = icmp ne %struct.node* %3, null
+	br i1 %4, label %5, label %14
+
+5:
+	%6 = load i32, i32* %s
+	%7 = load %struct.node*, %struct.node** %1
+	%8 = getelementptr %struct.node, %struct.node* %7, i32 0, i32 0
+	%9 = load i32, i32* %8
+	%10 = add i32 %6, %9
+	store i32 %10, i32* %s
+	%11 = load %struct.node*, %struct.node** %1
+	%12 = getelementptr %struct.node, %struct.node* %11, i32 0, i32 1
+	%13 = load %struct.node*, %struct.node** %12
+	store %struct.node* %13, %struct.node** %1
+	br label %2
+
+14:
+	%15 = load i32, i32* %s
+	ret i32 %15
+}
+
+define i32 @f() {
+0:
+	%a = alloca %struct.node
+	%b = alloca %struct.node
+	%q = alloca i8*
+	%x = alloca i32
+	%1 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 0
+	store i32 1, i32* %1
+	%2 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 1
+	store %struct.node* %b, %struct.node** %2
+	%3 = getelementptr %struct.node, %struct.node* %b, i32 0, i32 0
+	store i32 2, i32* %3
+	%4 = getelementptr %struct.node, %struct.node* %b, i32 0, i32 1
+	store %struct.node* null, %struct.node** %4
+	%5 = bitcast %struct.node* %a to i8*
+	store i8* %5, i8** %q
+	%6 = load i8*, i8** %q
+	%7 = bitcast i8* %6 to %struct.node*
+	%8 = call i32 @sum(%struct.node* %7)
+	%9 = sext i32 %8 to i64
+	%10 = add i64 %9, 16
+	%11 = add i64 %10, 1
+	%12 = trunc i64 %11 to i32
+	store i32 %12, i32* %x
+	%node = alloca i32
+	store i32 3, i32* %node
+	%13 = load i32, i32* %x
+	%14 = load i32, i32* %node
+	%15 = add i32 %13, %14
+	store i32 %15, i32* %x
+	%16 = load i32, i32* %x
+	ret i32 %16
+}
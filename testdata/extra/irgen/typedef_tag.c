@@ -0,0 +1,25 @@
+struct node {
+	int node;
+	struct node *next;
+};
+
+typedef struct node node;
+
+enum color { RED, GREEN };
+
+typedef int color;
+
+int f(void) {
+	node a;
+	struct node b;
+	struct node *p;
+	enum color c;
+	color d;
+	a.node = 1;
+	a.next = &b;
+	b.node = 2;
+	p = &a;
+	c = GREEN;
+	d = 3;
+	return p->node + p->next->node + c + d;
+}
@@ -0,0 +1,33 @@
+%struct.node = type { i32, %struct.node* }
+
+define i32 @f() {
+0:
+	%a = alloca %struct.node
+	%b = alloca %struct.node
+	%p = alloca %struct.node*
+	%c = alloca i32
+	%d = alloca i32
+	%1 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 0
+	store i32 1, i32* %1
+	%2 = getelementptr %struct.node, %struct.node* %a, i32 0, i32 1
+	store %struct.node* %b, %struct.node** %2
+	%3 = getelementptr %struct.node, %struct.node* %b, i32 0, i32 0
+	store i32 2, i32* %3
+	store %struct.node* %a, %struct.node** %p
+	store i32 1, i32* %c
+	store i32 3, i32* %d
+	%4 = load %struct.node*, %struct.node** %p
+	%5 = getelementptr %struct.node, %struct.node* %4, i32 0, i32 0
+	%6 = load i32, i32* %5
+	%7 = load %struct.node*, %struct.node** %p
+	%8 = getelementptr %struct.node, %struct.node* %7, i32 0, i32 1
+	%9 = load %struct.node*, %struct.node** %8
+	%10 = getelementptr %struct.node, %struct.node* %9, i32 0, i32 0
+	%11 = load i32, i32* %10
+	%12 = add i32 %6, %11
+	%13 = load i32, i32* %c
+	%14 = add i32 %12, %13
+	%15 = load i32, i32* %d
+	%16 = add i32 %14, %15
+	ret i32 %16
+}
@@ -0,0 +1,9 @@
+int f() {
+	int a[2];
+	int x;
+	x = 1;
+	a[0] = ++x;
+	a[1] = --x;
+	++a[0];
+	return a[0] + a[1];
+}
@@ -0,0 +1,26 @@
+define i32 @f() {
+0:
+	%a = alloca [2 x i32]
+	%x = alloca i32
+	store i32 1, i32* %x
+	%1 = load i32, i32* %x
+	%2 = add i32 %1, 1
+	store i32 %2, i32* %x
+	%3 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 0
+	store i32 %2, i32* %3
+	%4 = load i32, i32* %x
+	%5 = sub i32 %4, 1
+	store i32 %5, i32* %x
+	%6 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 1
+	store i32 %5, i32* %6
+	%7 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 0
+	%8 = load i32, i32* %7
+	%9 = add i32 %8, 1
+	store i32 %9, i32* %7
+	%10 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 0
+	%11 = load i32, i32* %10
+	%12 = getelementptr [2 x i32], [2 x i32]* %a, i64 0, i64 1
+	%13 = load i32, i32* %12
+	%14 = add i32 %11, %13
+	ret i32 %14
+}
@@ -0,0 +1,7 @@
+define i32 @f() {
+0:
+	%a = alloca i32
+	%1 = load i32, i32* %a
+	%2 = xor i32 %1, -1
+	ret i32 %2
+}
@@ -0,0 +1,41 @@
+unsigned int big = 0xFFFFFFFF;
+long wide = 0xFFFFFFFF;
+unsigned char uc = 200;
+
+int f(void) {
+	unsigned int u;
+	unsigned short us;
+	short s;
+	long l;
+	long long ll;
+	int n;
+	u = big;
+	us = 65535;
+	s = us;
+	l = u;
+	ll = s;
+	n = 0;
+	if (u / 2 == 2147483647) {
+		n = n + 1;
+	}
+	if (u % 10 == 5) {
+		n = n + 2;
+	}
+	if (u >> 31 == 1) {
+		n = n + 4;
+	}
+	if (-1 < u) {
+		n = n + 100;
+	}
+	if (l == wide && l > 0) {
+		n = n + 8;
+	}
+	if (ll == -1 && s < 0) {
+		n = n + 16;
+	}
+	if (uc + 100 == 300) {
+		n = n + 32;
+	}
+	u >>= 28;
+	return n + u;
+}
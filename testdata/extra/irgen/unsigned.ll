@@ -0,0 +1,134 @@
+@big = global i32 -1
+@wide = global i64 u0xFFFFFFFF
+@uc = global i8 -56
+
+define i32 @f() {
+0:
+	%u = alloca i32
+	%us = alloca i16
+	%s = alloca i16
+	%l = alloca i64
+	%ll = alloca i64
+	%n = alloca i32
+	%1 = load i32, i32* @big
+	store i32 %1, i32* %u
+	store i16 -1, i16* %us
+	%2 = load i16, i16* %us
+	store i16 %2, i16* %s
+	%3 = load i32, i32* %u
+	%4 = zext i32 %3 to i64
+	store i64 %4, i64* %l
+	%5 = load i16, i16* %s
+	%6 = sext i16 %5 to i64
+	store i64 %6, i64* %ll
+	store i32 0, i32* %n
+	%7 = load i32, i32* %u
+	%8 = udiv i32 %7, 2
+	%9 = icmp eq i32 %8, u0x7FFFFFFF
+	br i1 %9, label %10, label %13
+
+10:
+	%11 = load i32, i32* %n
+	%12 = add i32 %11, 1
+	store i32 %12, i32* %n
+	br label %13
+
+13:
+	%14 = load i32, i32* %u
+	%15 = urem i32 %14, 10
+	%16 = icmp eq i32 %15, 5
+	br i1 %16, label %17, label %20
+
+17:
+	%18 = load i32, i32* %n
+	%19 = add i32 %18, 2
+	store i32 %19, i32* %n
+	br label %20
+
+20:
+	%21 = load i32, i32* %u
+	%22 = lshr i32 %21, 31
+	%23 = icmp eq i32 %22, 1
+	br i1 %23, label %24, label %27
+
+24:
+	%25 = load i32, i32* %n
+	%26 = add i32 %25, 4
+	store i32 %26, i32* %n
+	br label %27
+
+27:
+	%28 = load i32, i32* %u
+	%29 = icmp ult i32 -1, %28
+	br i1 %29, label %30, label %33
+
+30:
+	%31 = load i32, i32* %n
+	%32 = add i32 %31, 100
+	store i32 %32, i32* %n
+	br label %33
+
+33:
+	%34 = load i64, i64* %l
+	%35 = load i64, i64* @wide
+	%36 = icmp eq i64 %34, %35
+	br i1 %36, label %37, label %40
+
+37:
+	%38 = load i64, i64* %l
+	%39 = icmp sgt i64 %38, 0
+	br label %40
+
+40:
+	%41 = phi i1 [ false, %33 ], [ %39, %37 ]
+	br i1 %41, label %42, label %45
+
+42:
+	%43 = load i32, i32* %n
+	%44 = add i32 %43, 8
+	store i32 %44, i32* %n
+	br label %45
+
+45:
+	%46 = load i64, i64* %ll
+	%47 = icmp eq i64 %46, -1
+	br i1 %47, label %48, label %52
+
+48:
+	%49 = load i16, i16* %s
+	%50 = sext i16 %49 to i32
+	%51 = icmp slt i32 %50, 0
+	br label %52
+
+52:
+	%53 = phi i1 [ false, %45 ], [ %51, %48 ]
+	br i1 %53, label %54, label %57
+
+54:
+	%55 = load i32, i32* %n
+	%56 = add i32 %55, 16
+	store i32 %56, i32* %n
+	br label %57
+
+57:
+	%58 = load i8, i8* @uc
+	%59 = zext i8 %58 to i32
+	%60 = add i32 %59, 100
+	%61 = icmp eq i32 %60, 300
+	br i1 %61, label %62, label %65
+
+62:
+	%63 = load i32, i32* %n
+	%64 = add i32 %63, 32
+	store i32 %64, i32* %n
+	br label %65
+
+65:
+	%66 = load i32, i32* %u
+	%67 = lshr i32 %66, 28
+	store i32 %67, i32* %u
+	%68 = load i32, i32* %n
+	%69 = load i32, i32* %u
+	%70 = add i32 %68, %69
+	ret i32 %70
+}
@@ -0,0 +1,12 @@
+int printf(char fmt[], ...);
+
+int sum(int n, ...);
+
+int f(void) {
+	char c;
+	int n;
+	c = 'x';
+	n = printf("%c %d %s\n", c, 42, "foo");
+	printf("done\n");
+	return n;
+}
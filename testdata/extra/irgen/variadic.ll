@@ -0,0 +1,21 @@
+@.str = private unnamed_addr constant [10 x i8] c"%c %d %s\0A\00"
+@.str.1 = private unnamed_addr constant [4 x i8] c"foo\00"
+@.str.2 = private unnamed_addr constant [6 x i8] c"done\0A\00"
+
+declare i32 @printf(i8* %fmt, ...)
+
+declare i32 @sum(i32 %n, ...)
+
+define i32 @f() {
+0:
+	%c = alloca i8
+	%n = alloca i32
+	store i8 120, i8* %c
+	%1 = load i8, i8* %c
+	%2 = sext i8 %1 to i32
+	%3 = call i32 (i8*, ...) @printf(i8* getelementptr ([10 x i8], [10 x i8]* @.str, i64 0, i64 0), i32 %2, i32 42, i8* getelementptr ([4 x i8], [4 x i8]* @.str.1, i64 0, i64 0))
+	store i32 %3, i32* %n
+	%4 = call i32 (i8*, ...) @printf(i8* getelementptr ([6 x i8], [6 x i8]* @.str.2, i64 0, i64 0))
+	%5 = load i32, i32* %n
+	ret i32 %5
+}
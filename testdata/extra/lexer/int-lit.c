@@ -0,0 +1,4 @@
+// Integer literals.
+0 42 0x2A 0X2a 052
+09 0x
+10u 1L 0x1FuL 07ll 1lul
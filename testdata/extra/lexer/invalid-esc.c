@@ -1,2 +1,5 @@
 // Test invalid escape sequence.
 char c = '\q';
+char d = '\x';
+char e = '\x100';
+char f = '\400';
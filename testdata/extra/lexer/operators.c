@@ -0,0 +1,11 @@
+// Operators.
++ - * / % & | ^ << >> ~ ! && ||
+= += -= *= /= %= &= |= ^= <<= >>=
+== != < <= > >=
+++ -- a+++b a---b
+p.x p->x a-->b
+f(a, ...) . ..
+case 1: default:
+a ? b : c
+goto l; l:
+enum e { A };
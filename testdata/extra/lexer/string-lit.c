@@ -0,0 +1,4 @@
+// String literals.
+char s[] = "foo\n";
+putstring("a\qb");
+putstring("unterminated);
@@ -0,0 +1 @@
+enum { A, B = 2 };
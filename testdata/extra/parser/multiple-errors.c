@@ -0,0 +1,13 @@
+int a b;
+
+int f(int x) {
+	int y;
+	y = x + ;
+	return y;
+}
+
+int g(void) {
+	return 1 2;
+}
+
+int h;
@@ -0,0 +1,10 @@
+#ifndef DEFS_H
+#define DEFS_H
+
+#include <consts.h>
+
+#define NEG(x) -x
+
+int defs;
+
+#endif
@@ -0,0 +1,12 @@
+#include "missing.h"
+#define ADD(a, b) ((a) + (b))
+int x = ADD(1);
+#define ADD_OF ADD(
+int y = ADD_OF 1);
+int z = ADD(1,
+	2, 3);
+#foo
+#if 1 +
+#endif
+#else
+#ifdef X
@@ -0,0 +1 @@
+#define N 10
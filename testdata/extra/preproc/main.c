@@ -0,0 +1,27 @@
+#include "defs.h"
+
+#define SQUARE(x) ((x) * (x))
+#ifdef DEBUG
+int debug;
+#else
+int release;
+#endif
+
+#if N > 5 && defined(SQUARE)
+int big[N];
+#elif N > 0
+int small[N];
+#endif
+
+#define SQUARE_OF SQUARE(
+int sq = SQUARE_OF 2);
+int multi = SQUARE(
+	3);
+int after;
+
+int main(void) {
+	/* Not a directive:
+#define N 1
+	*/
+	return SQUARE(N + 1) - NEG(N); // N
+}
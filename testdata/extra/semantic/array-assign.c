@@ -0,0 +1,19 @@
+struct S {
+	int arr[2];
+};
+
+int main(void) {
+	struct S s;
+	int m[2][3];
+	int *p;
+	s.arr = 1;
+	s.arr += 1;
+	m[0] = 1;
+	m[0] += 1;
+	s.arr[0] += 1;
+	m[0][1] += 1;
+	p = s.arr;
+	p = m[0];
+	p = &m[0][1];
+	return 0;
+}
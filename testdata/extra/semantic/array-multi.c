@@ -0,0 +1,18 @@
+void v[2][3];
+
+int sum(int a[][4]) {
+	return a[0][0];
+}
+
+int main(void) {
+	int x[3];
+	int m[2][3];
+	int n[3][4];
+	int *p;
+	x[1][2] = 1;
+	p = m[1];
+	m[1] = p;
+	sum(n);
+	sum(m);
+	return m[0][1][2];
+}
@@ -0,0 +1,8 @@
+int main(void) {
+	int a[10];
+	int b;
+	b = a % 2;
+	b = 1 << a;
+	b = ~a;
+	return b;
+}
@@ -0,0 +1,13 @@
+int main(void) {
+	int i;
+	i = 0;
+	while (i < 10) {
+		i = i + 1;
+		continue;
+	}
+	break;
+	if (i) {
+		continue;
+	}
+	return 0;
+}
@@ -0,0 +1,26 @@
+struct s {
+	int x;
+};
+
+int f(void) {
+	return 0;
+}
+
+int main(void) {
+	struct s v;
+	char *p;
+	int n;
+	char c;
+	n = (int)v;                // Invalid cast of structure
+	v = (struct s)n;           // Invalid cast to structure
+	(int)n = 3;                // Cast is not an lvalue
+	n = (int)p;                // Cast to smaller integer type
+	c = (char)300;             // Cast changes value
+	c = (char)n;
+	p = (char *)(void *)0;
+	p = (void *)0;
+	n = sizeof(void);          // Sizeof incomplete type
+	n = sizeof f;              // Sizeof function type
+	n = sizeof v + sizeof(struct s *) + sizeof(char);
+	return n;
+}
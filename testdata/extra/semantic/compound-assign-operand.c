@@ -0,0 +1,27 @@
+struct S {
+	int x;
+};
+
+int f(void);
+
+int main(void) {
+	struct S s;
+	struct S t;
+	int a[2];
+	int *p;
+	int i;
+	s += 1;
+	i += s;
+	s += t;
+	p += p;
+	i -= p;
+	i <<= s;
+	s *= 2;
+	p *= 2;
+	i += a;
+	p += f();
+	i *= f();
+	p -= 1;
+	i %= 3;
+	return 0;
+}
@@ -0,0 +1,28 @@
+struct s {
+	int x;
+};
+
+int main(void) {
+	int x;
+	int *p;
+	char *q;
+	struct s a;
+	struct s b;
+	int arr[1 ? 2 : 3];        // Constant conditional expression
+	x = 1;
+	a.x = 0;
+	b = a;
+	x = x ? 1 : 2;
+	p = x ? p : 0;
+	b = x ? a : b;
+	x = a ? 1 : 2;             // Condition of structure type
+	x = x ? p : q;             // Pointer type mismatch
+	x = x ? a : 2;             // Structure and integer operands
+	(x ? x : x) = 3;           // Not an lvalue
+	switch (x) {
+	case 1 ? 2 : 3:
+	case 2:                    // Duplicate case value
+		break;
+	}
+	return arr[0];
+}
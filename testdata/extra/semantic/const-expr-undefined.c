@@ -0,0 +1,18 @@
+int a[1 || 1 / 0];
+int b = 0 && 1 / 0;
+int c = 1 ? 2 : 1 << 32;
+int d[sizeof(1 / 0)];
+
+enum { E = 1 << 40 };
+
+int main(void) {
+	int k;
+	k = 0 && 1 / 0;
+	k = 1 ? 2 : 1 % 0;
+	k = (1 / 0) + 1;
+	switch (k) {
+	case 2 / 0:
+		break;
+	}
+	return 0;
+}
@@ -0,0 +1,15 @@
+int n;
+int a[2-2];
+int b[n];
+int c[1/0];
+int d = 1 % (2 - 2);
+int e = 1 << 32;
+int f = n + 1;
+
+int main(void) {
+	char buf[3*-1];
+	int x;
+	x = 10 / 0;
+	x = x / 0;
+	return 0;
+}
@@ -0,0 +1,18 @@
+struct s {
+	enum color { RED, GREEN } c;
+	int x;
+};
+
+int f(void) {
+	struct t {
+		enum { X, Y } e;
+	} t;
+	enum color c;
+	c = GREEN;
+	t.e = Y;
+	return c + t.e + RED + X;
+}
+
+int g(void) {
+	return X;
+}
@@ -0,0 +1,20 @@
+enum color { RED, GREEN = 5, BLUE };
+
+int x;
+enum { A = x };                // Enumerator value not constant
+enum { B, RED };               // Redefinition of enumerator
+enum { C = 2147483647, D };    // Enumerator value not representable as int
+int BLUE;                      // Redefinition of enumerator as variable
+
+struct color;                  // Wrong kind of tag
+
+int f(enum shape s) {          // Undeclared enumeration tag
+	int a[BLUE];
+	RED = 1;                   // Assignment to enumerator
+	switch (s) {
+	case GREEN:
+	case 5:                    // Duplicate case value
+		break;
+	}
+	return a[0];
+}
@@ -0,0 +1,17 @@
+int f(int x) {
+	int out;                   // Labels have a separate name space
+	goto out;
+out:
+	if (x)
+		goto done;
+	goto missing;              // Undeclared label
+out:                           // Duplicate label
+	x++;
+done:
+	return x;
+}
+
+int main(void) {
+	goto done;                 // Labels have function scope
+	return f(0);
+}
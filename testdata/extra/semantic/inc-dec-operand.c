@@ -0,0 +1,13 @@
+int f(void) {
+	return 0;
+}
+
+int main(void) {
+	int a[10];
+	int x;
+	1 += x;
+	a++;
+	--f();
+	x <<= a;
+	return x++;
+}
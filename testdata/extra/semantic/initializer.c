@@ -0,0 +1,23 @@
+struct point {
+	int x;
+	int y;
+};
+
+int g = 1;
+int h = g;
+int a[2] = {1, 2, 3};
+char s[2] = "abc";
+int b[3] = 4;
+struct point p = {1, 2, 3};
+int *q = 1;
+int x = {1, 2};
+int c[2] = {1, "foo"};
+
+int main(void) {
+	int d[] = {1, 2};
+	int e[] = 5;
+	int y = g;
+	struct point r = p;
+	int z = r;
+	return 0;
+}
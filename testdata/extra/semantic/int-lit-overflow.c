@@ -0,0 +1,10 @@
+int main(void) {
+	int x;
+	x = 2147483647;
+	x = 0x7FFFFFFF;
+	x = 017777777777;
+	x = 2147483648;            // Integer constant of type long
+	x = 0x80000000;            // Integer constant of type unsigned int
+	x = 99999999999999999999;  // Integer constant too large
+	return 0;
+}
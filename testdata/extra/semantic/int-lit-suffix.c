@@ -0,0 +1,13 @@
+int main(void) {
+	unsigned int u;
+	long l;
+	u = 10u;
+	u = 0xFFFFFFFFu;
+	l = 1L;
+	l = 077LL;
+	l = 08;                    // Invalid digit in octal constant
+	l = 1lul;                  // Invalid integer suffix
+	l = 1lL;                   // Invalid integer suffix
+	u = 18446744073709551616u; // Integer constant too large
+	return 0;
+}
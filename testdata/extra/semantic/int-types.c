@@ -0,0 +1,12 @@
+int main(void) {
+	unsigned u;
+	unsigned char uc;
+	long long int ll;
+	short int *p;
+	u = "foo";                 // Type mismatch
+	p = &ll;                   // Type mismatch
+	ll = 0x8000000000000000;
+	ll = 18446744073709551616; // Integer constant too large
+	uc = u + ll;
+	return 0;
+}
@@ -0,0 +1,53 @@
+// Terminating loop statements.
+//
+//    missing return at end of non-void function "f3"
+//    missing return at end of non-void function "f4"
+//    missing return at end of non-void function "f5"
+//    missing return at end of non-void function "f6"
+
+int f1(int a) {
+	for (;;) {
+		if (a) {
+			return a;
+		}
+		while (a) {
+			break;
+		}
+	}
+}
+
+int f2(int a) {
+	do {
+		a = a + 1;
+		return a;
+	} while (a);
+}
+
+int f3(int a) {
+	for (;;) {
+		if (a) {
+			break;
+		}
+	}
+}
+
+int f4(int a) {
+	for (; a;) {
+		return a;
+	}
+}
+
+int f5(int a) {
+	do {
+		if (a) {
+			continue;
+		}
+		return a;
+	} while (a);
+}
+
+int f6(int a) {
+	while (a) {
+		return a;
+	}
+}
@@ -0,0 +1,38 @@
+// Terminating switch statements.
+//
+//    missing return at end of non-void function "f2"
+//    missing return at end of non-void function "f3"
+
+int f1(int a) {
+	switch (a) {
+	case 1:
+		a = 2;
+	case 2:
+		for (;;) {
+			if (a) {
+				break;
+			}
+		}
+		return a;
+	default:
+		return 0;
+	}
+}
+
+int f2(int a) {
+	switch (a) {
+	case 1:
+		return 1;
+	case 2:
+		return 2;
+	}
+}
+
+int f3(int a) {
+	switch (a) {
+	case 1:
+		break;
+	default:
+		return 0;
+	}
+}
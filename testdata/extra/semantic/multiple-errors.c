@@ -0,0 +1,12 @@
+int f(int a) {
+	return a;
+}
+
+int main(void) {
+	int x;
+	x = y + 1;    // Variable 'y' not defined
+	x = y * 2;    // Only reported once
+	f(1, 2);      // Too many arguments to function 'f'
+	x = f;        // Type mismatch
+	return x;
+}
@@ -0,0 +1,20 @@
+int main(void) {
+	int x;
+	char c;
+	int *p;
+	char *q;
+	void *v;
+	p = &x;
+	v = p;
+	q = v;
+	q = p;
+	x = *x;
+	p = &1;
+	p = p + p;
+	p = p * 2;
+	x = p < q;
+	x = p - q;
+	p = 42;
+	p -= p;
+	return *v;
+}
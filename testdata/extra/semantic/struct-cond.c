@@ -0,0 +1,22 @@
+struct S {
+	int x;
+};
+
+int main(void) {
+	struct S s;
+	s.x = 1;
+	if (s) {
+		s.x = 2;
+	}
+	while (s) {
+	}
+	do {
+	} while (s);
+	for (; s;) {
+	}
+	for (;;) {
+		if (s.x) {
+			return 0;
+		}
+	}
+}
@@ -0,0 +1,34 @@
+struct point {
+	int x;
+	int y;
+};
+
+struct point {
+	int z;
+};
+
+struct pair {
+	int a;
+	char a;
+};
+
+int main(void) {
+	struct point p;
+	struct point *q;
+	struct line l;
+	struct {
+		int x;
+	} anon;
+	int i;
+	q = &p;
+	p.x = 1;
+	q->y = 2;
+	i = p.z;
+	i = q.x;
+	i = p->x;
+	i = i.x;
+	p = anon;
+	p = *q;
+	i = p + 1;
+	return p.x;
+}
@@ -0,0 +1,26 @@
+struct list;
+
+struct list *head;
+
+int length(struct list l);
+
+struct list x;
+
+struct list {
+	int v;
+	struct list *next;
+};
+
+struct list y;
+
+int f(struct tree *t) {
+	struct tree u;
+	int n;
+	n = sizeof(struct tree);
+	n = t->v;
+	return y.next->v;
+}
+
+struct a {
+	struct b b;
+};
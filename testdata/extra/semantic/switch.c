@@ -0,0 +1,28 @@
+int main(void) {
+	int x;
+	int y;
+	char *p;
+	x = 1;
+	switch (x) {
+	case 1:
+		y = 1;
+		break;
+	case 0 + 1:                // Duplicate case value
+		break;
+	case y:                    // Case label not constant
+		break;
+	default:
+		break;
+	default:                   // Multiple default labels
+		break;
+	}
+	switch (p) {               // Non-integer controlling expression
+	}
+	switch (x) {
+	case 257:
+	case (char)257:
+		continue;              // Continue not within loop
+	}
+	case 2:                    // Case label outside switch
+	return 0;
+}
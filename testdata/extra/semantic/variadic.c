@@ -0,0 +1,10 @@
+int printf(char fmt[], ...);
+void g(void, ...);
+void h(void);
+
+int main(void) {
+	printf("%d\n", 42);
+	printf();
+	printf("%d\n", h());
+	return 0;
+}
@@ -4,9 +4,9 @@ package token
 
 import "fmt"
 
-const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitCharLitliteralEndoperatorStartAddSubMulDivAssignEqNeLtLeGtGeLandNotLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonoperatorEndkeywordStartKwElseKwIfKwReturnKwWhilekeywordEnd"
+const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitCharLitStringLitliteralEndoperatorStartAddSubMulDivRemAndOrXorShlShrAssignAddAssignSubAssignMulAssignDivAssignRemAssignAndAssignOrAssignXorAssignShlAssignShrAssignIncDecEqNeLtLeGtGeLandLorNotTildeLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonColonQuestionPeriodArrowEllipsisoperatorEndkeywordStartKwBreakKwCaseKwContinueKwDefaultKwDoKwElseKwEnumKwForKwGotoKwIfKwReturnKwSizeofKwStructKwSwitchKwTypedefKwWhilekeywordEnd"
 
-var _Kind_index = [...]uint8{0, 3, 8, 15, 27, 32, 38, 45, 55, 68, 71, 74, 77, 80, 86, 88, 90, 92, 94, 96, 98, 102, 105, 111, 117, 125, 133, 139, 145, 150, 159, 170, 182, 188, 192, 200, 207, 217}
+var _Kind_index = [...]uint16{0, 3, 8, 15, 27, 32, 38, 45, 54, 64, 77, 80, 83, 86, 89, 92, 95, 97, 100, 103, 106, 112, 121, 130, 139, 148, 157, 166, 174, 183, 192, 201, 204, 207, 209, 211, 213, 215, 217, 219, 223, 226, 229, 234, 240, 246, 254, 262, 268, 274, 279, 288, 293, 301, 307, 312, 320, 331, 343, 350, 356, 366, 375, 379, 385, 391, 396, 402, 406, 414, 422, 430, 438, 447, 454, 464}
 
 func (kind Kind) GoString() string {
 	if kind >= Kind(len(_Kind_index)-1) {
@@ -0,0 +1,296 @@
+package token
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Pos is a compact encoding of a source position within a file set. It can be
+// converted into a Position for a more convenient, but much larger,
+// representation.
+//
+// The Pos value for a given file is a number in the range [base, base+size],
+// where base and size are specified when adding the file to the file set. The
+// first file added to a file set has base 0, so the positions of single-file
+// inputs are equal to their byte offsets.
+type Pos int
+
+// NoPos is the invalid position; there is no file and line information
+// associated with it, and NoPos.IsValid() is false. As the first file added to
+// a file set has base 0, the zero value of Pos is a valid position, and NoPos is
+// -1. NoPos is used as pseudo-position of identifiers declared in the universe
+// scope.
+const NoPos Pos = -1
+
+// IsValid reports whether the position is valid.
+func (p Pos) IsValid() bool {
+	return p != NoPos
+}
+
+// A Position describes an arbitrary source position including the file, line,
+// and column location.
+type Position struct {
+	// File name; or empty.
+	Filename string
+	// Offset in bytes, starting at 0.
+	Offset int
+	// Line number, starting at 1.
+	Line int
+	// Column number, starting at 1 (byte count).
+	Column int
+}
+
+// IsValid reports whether the position is valid.
+func (pos Position) IsValid() bool {
+	return pos.Line > 0
+}
+
+// String returns a string in one of several forms.
+//
+//    file:line:column    valid position with file name
+//    line:column         valid position without file name
+//    file                invalid position with file name
+//    -                   invalid position without file name
+func (pos Position) String() string {
+	s := pos.Filename
+	if pos.IsValid() {
+		if len(s) > 0 {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d:%d", pos.Line, pos.Column)
+	}
+	if len(s) == 0 {
+		s = "-"
+	}
+	return s
+}
+
+// A File is a handle for a file belonging to a FileSet.
+type File struct {
+	// File name as provided to AddFile.
+	name string
+	// Pos value range for this file is [base, base+size].
+	base int
+	// File size as provided to AddFile.
+	size int
+	// Lines contains the offset of the first character for each line (the
+	// first entry is always 0).
+	lines []int
+	// Alternative file and line information, sorted by offset; e.g. as
+	// provided by the preprocessor.
+	infos []lineInfo
+}
+
+// A lineInfo describes alternative file and line number information for a
+// given file offset, such as provided by the preprocessor for source code
+// originating from another file (e.g. an included file).
+type lineInfo struct {
+	// Offset of the line in the file.
+	Offset int
+	// Alternative file name and line number of the offset.
+	Filename string
+	Line     int
+}
+
+// NewFile returns a new file of the given name and size which does not belong
+// to any file set; its base is 0.
+func NewFile(name string, size int) *File {
+	return &File{name: name, size: size, lines: []int{0}}
+}
+
+// Name returns the file name of file f as registered with AddFile.
+func (f *File) Name() string {
+	return f.name
+}
+
+// Base returns the base offset of file f as registered with AddFile.
+func (f *File) Base() int {
+	return f.base
+}
+
+// Size returns the size of file f as registered with AddFile.
+func (f *File) Size() int {
+	return f.size
+}
+
+// LineCount returns the number of lines in file f.
+func (f *File) LineCount() int {
+	return len(f.lines)
+}
+
+// AddLine adds the line offset for a new line. The line offset must be larger
+// than the offset for the previous line and smaller than the file size;
+// otherwise the line offset is ignored.
+func (f *File) AddLine(offset int) {
+	if i := len(f.lines); (i == 0 || f.lines[i-1] < offset) && offset < f.size {
+		f.lines = append(f.lines, offset)
+	}
+}
+
+// SetLinesForContent sets the line offsets for the given file content.
+func (f *File) SetLinesForContent(content []byte) {
+	var lines []int
+	line := 0
+	for offset, b := range content {
+		if line >= 0 {
+			lines = append(lines, line)
+		}
+		line = -1
+		if b == '\n' {
+			line = offset + 1
+		}
+	}
+	f.lines = lines
+}
+
+// AddLineInfo adds alternative file and line number information for a given
+// file offset. The offset must be larger than the offset of the previously
+// added alternative line information and smaller than the file size;
+// otherwise the information is ignored.
+func (f *File) AddLineInfo(offset int, filename string, line int) {
+	if i := len(f.infos); (i == 0 || f.infos[i-1].Offset < offset) && offset < f.size {
+		f.infos = append(f.infos, lineInfo{Offset: offset, Filename: filename, Line: line})
+	}
+}
+
+// LineStart returns the offset of the first character of the given line (1-
+// based).
+func (f *File) LineStart(line int) int {
+	if line < 1 || line > len(f.lines) {
+		panic(fmt.Sprintf("invalid line number %d (should be >= 1 and <= %d)", line, len(f.lines)))
+	}
+	return f.lines[line-1]
+}
+
+// Pos returns the Pos value for the given file offset.
+func (f *File) Pos(offset int) Pos {
+	if offset < 0 || offset > f.size {
+		panic(fmt.Sprintf("invalid file offset %d (should be >= 0 and <= %d)", offset, f.size))
+	}
+	return Pos(f.base + offset)
+}
+
+// Offset returns the offset for the given file position p.
+func (f *File) Offset(p Pos) int {
+	if int(p) < f.base || int(p) > f.base+f.size {
+		panic(fmt.Sprintf("invalid Pos value %d (should be in [%d, %d])", p, f.base, f.base+f.size))
+	}
+	return int(p) - f.base
+}
+
+// Line returns the line number for the given file position p.
+func (f *File) Line(p Pos) int {
+	return f.Position(p).Line
+}
+
+// Position returns the Position value for the given file position p. The
+// position is adjusted by alternative line information, if any; use
+// PositionFor to retrieve the unadjusted position.
+func (f *File) Position(p Pos) Position {
+	return f.PositionFor(p, true)
+}
+
+// PositionFor returns the Position value for the given file position p. If
+// adjusted is set, the file name and line number of the position are adjusted
+// by the alternative line information added through AddLineInfo.
+func (f *File) PositionFor(p Pos, adjusted bool) Position {
+	if !p.IsValid() {
+		return Position{}
+	}
+	offset := f.Offset(p)
+	pos := Position{Filename: f.name, Offset: offset}
+	pos.Line, pos.Column = f.lineColumn(offset)
+	if adjusted {
+		i := sort.Search(len(f.infos), func(i int) bool {
+			return f.infos[i].Offset > offset
+		}) - 1
+		if i >= 0 {
+			info := f.infos[i]
+			infoLine, _ := f.lineColumn(info.Offset)
+			pos.Filename = info.Filename
+			pos.Line = info.Line + pos.Line - infoLine
+		}
+	}
+	return pos
+}
+
+// lineColumn returns the line and column number (1-based) of the given file
+// offset.
+func (f *File) lineColumn(offset int) (line, column int) {
+	if i := sort.SearchInts(f.lines, offset+1) - 1; i >= 0 {
+		return i + 1, offset - f.lines[i] + 1
+	}
+	return 0, 0
+}
+
+// A FileSet represents a set of source files. Positions of files within a file
+// set are disjoint, so several files may be compiled together while keeping
+// separate positions.
+type FileSet struct {
+	// Base offset for the next file.
+	base int
+	// List of files in the order added to the set.
+	files []*File
+	// Cache of last file looked up.
+	last *File
+}
+
+// NewFileSet returns a new file set.
+func NewFileSet() *FileSet {
+	return &FileSet{}
+}
+
+// Base returns the minimum base offset that must be provided to AddFile when
+// adding the next file.
+func (s *FileSet) Base() int {
+	return s.base
+}
+
+// AddFile adds a new file with a given filename and file size to the file set,
+// and returns the file. The file is assigned the next free base offset of the
+// file set.
+func (s *FileSet) AddFile(filename string, size int) *File {
+	f := &File{name: filename, base: s.base, size: size, lines: []int{0}}
+	// +1 since EOF is a valid position within the file.
+	s.base += size + 1
+	s.files = append(s.files, f)
+	s.last = f
+	return f
+}
+
+// File returns the file that contains the position p. If no such file is
+// found, File returns nil.
+func (s *FileSet) File(p Pos) *File {
+	if !p.IsValid() {
+		return nil
+	}
+	if f := s.last; f != nil && f.base <= int(p) && int(p) <= f.base+f.size {
+		return f
+	}
+	i := sort.Search(len(s.files), func(i int) bool {
+		return s.files[i].base > int(p)
+	}) - 1
+	if i >= 0 {
+		if f := s.files[i]; int(p) <= f.base+f.size {
+			s.last = f
+			return f
+		}
+	}
+	return nil
+}
+
+// Position converts the position p within the file set into a Position value,
+// adjusted by alternative line information, if any.
+func (s *FileSet) Position(p Pos) Position {
+	return s.PositionFor(p, true)
+}
+
+// PositionFor converts the position p within the file set into a Position
+// value. If adjusted is set, the position is adjusted by alternative line
+// information, if any.
+func (s *FileSet) PositionFor(p Pos, adjusted bool) Position {
+	if f := s.File(p); f != nil {
+		return f.PositionFor(p, adjusted)
+	}
+	return Position{}
+}
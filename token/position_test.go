@@ -0,0 +1,67 @@
+package token
+
+import "testing"
+
+func TestFileSetPosition(t *testing.T) {
+	fset := NewFileSet()
+	src1 := "int x;\nint y;\n"
+	src2 := "void f(void) {\n\treturn;\n}\n"
+	f1 := fset.AddFile("a.c", len(src1))
+	f1.SetLinesForContent([]byte(src1))
+	f2 := fset.AddFile("b.c", len(src2))
+	f2.SetLinesForContent([]byte(src2))
+
+	golden := []struct {
+		file   *File
+		offset int
+		want   string
+	}{
+		{file: f1, offset: 0, want: "a.c:1:1"},
+		{file: f1, offset: 4, want: "a.c:1:5"},
+		{file: f1, offset: 11, want: "a.c:2:5"},
+		{file: f2, offset: 0, want: "b.c:1:1"},
+		{file: f2, offset: 16, want: "b.c:2:2"},
+		{file: f2, offset: 24, want: "b.c:3:1"},
+	}
+	for _, g := range golden {
+		p := g.file.Pos(g.offset)
+		if got := fset.Position(p).String(); got != g.want {
+			t.Errorf("position mismatch for offset %d of %q; expected %q, got %q", g.offset, g.file.Name(), g.want, got)
+		}
+		if got := g.file.Offset(p); got != g.offset {
+			t.Errorf("offset mismatch for %q; expected %d, got %d", g.file.Name(), g.offset, got)
+		}
+	}
+	if got := fset.Position(NoPos).String(); got != "-" {
+		t.Errorf("position mismatch for NoPos; expected %q, got %q", "-", got)
+	}
+}
+
+func TestFileLineInfo(t *testing.T) {
+	fset := NewFileSet()
+	src := "int x;\nint y;\nint z;\n"
+	f := fset.AddFile("a.c", len(src))
+	f.SetLinesForContent([]byte(src))
+	// Line 2 originates from line 5 of b.h.
+	f.AddLineInfo(7, "b.h", 5)
+	f.AddLineInfo(14, "a.c", 2)
+
+	golden := []struct {
+		offset     int
+		want       string
+		unadjusted string
+	}{
+		{offset: 4, want: "a.c:1:5", unadjusted: "a.c:1:5"},
+		{offset: 11, want: "b.h:5:5", unadjusted: "a.c:2:5"},
+		{offset: 14, want: "a.c:2:1", unadjusted: "a.c:3:1"},
+	}
+	for _, g := range golden {
+		p := f.Pos(g.offset)
+		if got := fset.Position(p).String(); got != g.want {
+			t.Errorf("position mismatch for offset %d; expected %q, got %q", g.offset, g.want, got)
+		}
+		if got := fset.PositionFor(p, false).String(); got != g.unadjusted {
+			t.Errorf("unadjusted position mismatch for offset %d; expected %q, got %q", g.offset, g.unadjusted, got)
+		}
+	}
+}
@@ -11,7 +11,7 @@ type Token struct {
 	// The string value of the token.
 	Val string
 	// Start position in the input string.
-	Pos int
+	Pos Pos
 }
 
 func (tok Token) String() string {
@@ -35,9 +35,10 @@ const (
 	literalStart
 
 	// Identifiers and basic literals.
-	Ident   // main (also includes type names)
-	IntLit  // 123
-	CharLit // 'a', '\n'
+	Ident     // main (also includes type names)
+	IntLit    // 123
+	CharLit   // 'a', '\n'
+	StringLit // "foo\n"
 
 	literalEnd
 
@@ -48,7 +49,25 @@ const (
 	Sub       // -
 	Mul       // *
 	Div       // /
+	Rem       // %
+	And       // &
+	Or        // |
+	Xor       // ^
+	Shl       // <<
+	Shr       // >>
 	Assign    // =
+	AddAssign // +=
+	SubAssign // -=
+	MulAssign // *=
+	DivAssign // /=
+	RemAssign // %=
+	AndAssign // &=
+	OrAssign  // |=
+	XorAssign // ^=
+	ShlAssign // <<=
+	ShrAssign // >>=
+	Inc       // ++
+	Dec       // --
 	Eq        // ==
 	Ne        // !=
 	Lt        // <
@@ -56,7 +75,9 @@ const (
 	Gt        // >
 	Ge        // >=
 	Land      // &&
+	Lor       // ||
 	Not       // !
+	Tilde     // ~
 	Lparen    // (
 	Rparen    // )
 	Lbracket  // [
@@ -65,55 +86,108 @@ const (
 	Rbrace    // }
 	Comma     // ,
 	Semicolon // ;
+	Colon     // :
+	Question  // ?
+	Period    // .
+	Arrow     // ->
+	Ellipsis  // ...
 
 	operatorEnd
 
 	keywordStart
 
 	// Keywords.
-	KwElse    // else
-	KwIf      // if
-	KwReturn  // return
-	KwTypedef // typedef
-	KwWhile   // while
+	KwBreak    // break
+	KwCase     // case
+	KwContinue // continue
+	KwDefault  // default
+	KwDo       // do
+	KwElse     // else
+	KwEnum     // enum
+	KwFor      // for
+	KwGoto     // goto
+	KwIf       // if
+	KwReturn   // return
+	KwSizeof   // sizeof
+	KwStruct   // struct
+	KwSwitch   // switch
+	KwTypedef  // typedef
+	KwWhile    // while
 
 	keywordEnd
 )
 
 func (kind Kind) String() string {
 	names := map[Kind]string{
-		EOF:       "EOF",
-		Error:     "error",
-		Comment:   "comment",
-		Ident:     "identifier",
-		IntLit:    "integer literal",
-		CharLit:   "character literal",
-		Add:       "+",
-		Sub:       "-",
-		Mul:       "*",
-		Div:       "/",
-		Assign:    "=",
-		Eq:        "==",
-		Ne:        "!=",
-		Lt:        "<",
-		Le:        "<=",
-		Gt:        ">",
-		Ge:        ">=",
-		Land:      "&&",
-		Not:       "!",
-		Lparen:    "(",
-		Rparen:    ")",
-		Lbracket:  "[",
-		Rbracket:  "]",
-		Lbrace:    "{",
-		Rbrace:    "}",
-		Comma:     ",",
-		Semicolon: ";",
-		KwElse:    "else",
-		KwIf:      "if",
-		KwReturn:  "return",
-		KwTypedef: "typedef",
-		KwWhile:   "while",
+		EOF:        "EOF",
+		Error:      "error",
+		Comment:    "comment",
+		Ident:      "identifier",
+		IntLit:     "integer literal",
+		CharLit:    "character literal",
+		StringLit:  "string literal",
+		Add:        "+",
+		Sub:        "-",
+		Mul:        "*",
+		Div:        "/",
+		Rem:        "%",
+		And:        "&",
+		Or:         "|",
+		Xor:        "^",
+		Shl:        "<<",
+		Shr:        ">>",
+		Assign:     "=",
+		AddAssign:  "+=",
+		SubAssign:  "-=",
+		MulAssign:  "*=",
+		DivAssign:  "/=",
+		RemAssign:  "%=",
+		AndAssign:  "&=",
+		OrAssign:   "|=",
+		XorAssign:  "^=",
+		ShlAssign:  "<<=",
+		ShrAssign:  ">>=",
+		Inc:        "++",
+		Dec:        "--",
+		Eq:         "==",
+		Ne:         "!=",
+		Lt:         "<",
+		Le:         "<=",
+		Gt:         ">",
+		Ge:         ">=",
+		Land:       "&&",
+		Lor:        "||",
+		Not:        "!",
+		Tilde:      "~",
+		Lparen:     "(",
+		Rparen:     ")",
+		Lbracket:   "[",
+		Rbracket:   "]",
+		Lbrace:     "{",
+		Rbrace:     "}",
+		Comma:      ",",
+		Semicolon:  ";",
+		Colon:      ":",
+		Question:   "?",
+		Period:     ".",
+		Arrow:      "->",
+		Ellipsis:   "...",
+		KwBreak:    "break",
+		KwCase:     "case",
+		KwContinue: "continue",
+		KwDefault:  "default",
+		KwDo:       "do",
+		KwElse:     "else",
+		KwEnum:     "enum",
+		KwFor:      "for",
+		KwGoto:     "goto",
+		KwIf:       "if",
+		KwReturn:   "return",
+		KwSizeof:   "sizeof",
+		KwStruct:   "struct",
+		KwSwitch:   "switch",
+		KwTypedef:  "typedef",
+		KwWhile:    "while",
 	}
 	return names[kind]
 }
@@ -133,11 +207,51 @@ func (kind Kind) IsOperator() bool {
 	return operatorStart < kind && kind < operatorEnd
 }
 
+// IsAssign reports whether kind is an assignment operator; either a simple
+// assignment operator (=) or a compound assignment operator (e.g. +=).
+func (kind Kind) IsAssign() bool {
+	return Assign <= kind && kind <= ShrAssign
+}
+
+// CompoundOp returns the binary operator of the given compound assignment
+// operator (e.g. Add for AddAssign), and a boolean indicating whether kind is a
+// compound assignment operator.
+func (kind Kind) CompoundOp() (Kind, bool) {
+	op, ok := compoundOps[kind]
+	return op, ok
+}
+
+// compoundOps maps from compound assignment operators to their corresponding
+// binary operators.
+var compoundOps = map[Kind]Kind{
+	AddAssign: Add,
+	SubAssign: Sub,
+	MulAssign: Mul,
+	DivAssign: Div,
+	RemAssign: Rem,
+	AndAssign: And,
+	OrAssign:  Or,
+	XorAssign: Xor,
+	ShlAssign: Shl,
+	ShrAssign: Shr,
+}
+
 // Keywords is the set of valid keywords in the µC programming language.
 var Keywords = map[string]Kind{
-	"else":    KwElse,
-	"if":      KwIf,
-	"return":  KwReturn,
-	"typedef": KwTypedef,
-	"while":   KwWhile,
+	"break":    KwBreak,
+	"case":     KwCase,
+	"continue": KwContinue,
+	"default":  KwDefault,
+	"do":       KwDo,
+	"else":     KwElse,
+	"enum":     KwEnum,
+	"for":      KwFor,
+	"goto":     KwGoto,
+	"if":       KwIf,
+	"return":   KwReturn,
+	"sizeof":   KwSizeof,
+	"struct":   KwStruct,
+	"switch":   KwSwitch,
+	"typedef":  KwTypedef,
+	"while":    KwWhile,
 }
@@ -17,3 +17,22 @@ func TestKeywords(t *testing.T) {
 		}
 	}
 }
+
+func TestCompoundOp(t *testing.T) {
+	for kind := Assign + 1; kind <= ShrAssign; kind++ {
+		if !kind.IsAssign() {
+			t.Errorf("%v incorrectly classified as non-assignment operator", kind)
+		}
+		op, ok := kind.CompoundOp()
+		if !ok {
+			t.Errorf("compoundOps map is missing %v", kind)
+			continue
+		}
+		if want := op.String() + "="; kind.String() != want {
+			t.Errorf("binary operator mismatch of %v; expected %q, got %q", kind, want, op)
+		}
+	}
+	if _, ok := Assign.CompoundOp(); ok {
+		t.Errorf("%v incorrectly classified as compound assignment operator", Assign)
+	}
+}
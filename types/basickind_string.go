@@ -4,9 +4,9 @@ package types
 
 import "fmt"
 
-const _BasicKind_name = "InvalidCharIntVoid"
+const _BasicKind_name = "InvalidCharUnsignedCharShortUnsignedShortIntUnsignedIntLongUnsignedLongLongLongUnsignedLongLongVoid"
 
-var _BasicKind_index = [...]uint8{0, 7, 11, 14, 18}
+var _BasicKind_index = [...]uint8{0, 7, 11, 23, 28, 41, 44, 55, 59, 71, 79, 95, 99}
 
 func (kind BasicKind) String() string {
 	if kind < 0 || kind >= BasicKind(len(_BasicKind_index)-1) {
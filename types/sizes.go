@@ -0,0 +1,102 @@
+package types
+
+import "fmt"
+
+// Sizes describes the memory layout of types on a target platform.
+type Sizes interface {
+	// Sizeof returns the size in bytes of a value of the given type. The type
+	// must be a complete object type.
+	Sizeof(t Type) int64
+	// Alignof returns the alignment in bytes of a value of the given type. The
+	// type must be a complete object type.
+	Alignof(t Type) int64
+}
+
+// StdSizes is a memory layout where integer types and pointers are aligned to
+// their size.
+//
+// The elements of arrays are laid out consecutively, and unsized arrays (e.g.
+// array parameters) are laid out as pointers. The fields of structures are laid
+// out in order, each at the first offset aligned to the alignment of the field.
+// The alignment of a structure is the largest alignment of its fields, and its
+// size is padded to a multiple thereof.
+type StdSizes struct {
+	// Size in bytes of short, int, long and long long respectively. The size of
+	// char is 1, and unsigned integer types have the same size as their
+	// corresponding signed integer types.
+	ShortSize, IntSize, LongSize, LongLongSize int64
+	// Size in bytes of pointers.
+	PtrSize int64
+}
+
+// LP64 is the memory layout of the LP64 data model used by Clang and GCC on
+// x86-64; where int is 32 bits wide, and long and pointers are 64 bits wide.
+var LP64 = &StdSizes{ShortSize: 2, IntSize: 4, LongSize: 8, LongLongSize: 8, PtrSize: 8}
+
+// DefaultSizes specifies the memory layout of the target platform.
+var DefaultSizes Sizes = LP64
+
+// Sizeof returns the size in bytes of a value of the given type.
+func (s *StdSizes) Sizeof(t Type) int64 {
+	switch t := t.(type) {
+	case *Basic:
+		switch t.Kind {
+		case Char, UnsignedChar:
+			return 1
+		case Short, UnsignedShort:
+			return s.ShortSize
+		case Int, UnsignedInt:
+			return s.IntSize
+		case Long, UnsignedLong:
+			return s.LongSize
+		case LongLong, UnsignedLongLong:
+			return s.LongLongSize
+		}
+	case *Array:
+		if t.Len == 0 {
+			return s.PtrSize
+		}
+		return int64(t.Len) * s.Sizeof(t.Elem)
+	case *Pointer:
+		return s.PtrSize
+	case *Struct:
+		if t.Incomplete {
+			break
+		}
+		var size, align int64 = 0, 1
+		for _, field := range t.Fields {
+			a := s.Alignof(field.Type)
+			size = alignTo(size, a) + s.Sizeof(field.Type)
+			if a > align {
+				align = a
+			}
+		}
+		return alignTo(size, align)
+	}
+	panic(fmt.Sprintf("invalid size of incomplete or invalid type %q", t))
+}
+
+// Alignof returns the alignment in bytes of a value of the given type.
+func (s *StdSizes) Alignof(t Type) int64 {
+	switch t := t.(type) {
+	case *Array:
+		if t.Len == 0 {
+			return s.PtrSize
+		}
+		return s.Alignof(t.Elem)
+	case *Struct:
+		var align int64 = 1
+		for _, field := range t.Fields {
+			if a := s.Alignof(field.Type); a > align {
+				align = a
+			}
+		}
+		return align
+	}
+	return s.Sizeof(t)
+}
+
+// alignTo returns the given offset rounded up to a multiple of align.
+func alignTo(offset, align int64) int64 {
+	return (offset + align - 1) / align * align
+}
@@ -12,6 +12,8 @@ import (
 //    *Basic
 //    *Array
 //    *Func
+//    *Pointer
+//    *Struct
 type Type interface {
 	// Equal reports whether t and u are of equal type.
 	Equal(u Type) bool
@@ -32,6 +34,7 @@ type (
 	//
 	//    char
 	//    int
+	//    unsigned long
 	Basic struct {
 		// Kind of basic type.
 		Kind BasicKind
@@ -56,11 +59,42 @@ type (
 	//
 	//    int(void)
 	//    int(int a, int b)
+	//    int(char fmt[], ...)
 	Func struct {
 		// Return type.
 		Result Type
 		// Function parameter types; or nil if void parameter.
 		Params []*Field
+		// Variadic specifies whether the function takes a variable number of
+		// arguments, following the last parameter.
+		Variadic bool
+	}
+
+	// A Pointer represents a pointer type.
+	//
+	// Examples.
+	//
+	//    int*
+	//    char**
+	Pointer struct {
+		// Element type.
+		Elem Type
+	}
+
+	// A Struct represents a structure type.
+	//
+	// Examples.
+	//
+	//    struct point
+	//    struct {int x; int y;}
+	Struct struct {
+		// Structure tag; or empty if anonymous.
+		Tag string
+		// Structure fields.
+		Fields []*Field
+		// Incomplete reports whether the tagged structure type has been declared
+		// but not yet defined; e.g. `struct node;`.
+		Incomplete bool
 	}
 )
 
@@ -74,11 +108,37 @@ type BasicKind int
 const (
 	Invalid BasicKind = iota // invalid type
 
-	Char // "char"
-	Int  // "int"
+	// Integer types, in order of integer conversion rank.
+	Char             // "char"
+	UnsignedChar     // "unsigned char"
+	Short            // "short"
+	UnsignedShort    // "unsigned short"
+	Int              // "int"
+	UnsignedInt      // "unsigned int"
+	Long             // "long"
+	UnsignedLong     // "unsigned long"
+	LongLong         // "long long"
+	UnsignedLongLong // "unsigned long long"
+
 	Void // "void"
 )
 
+// BasicKinds specifies the kinds of basic types declared in the universe
+// scope.
+var BasicKinds = []BasicKind{
+	Char,
+	UnsignedChar,
+	Short,
+	UnsignedShort,
+	Int,
+	UnsignedInt,
+	Long,
+	UnsignedLong,
+	LongLong,
+	UnsignedLongLong,
+	Void,
+}
+
 // A Field represents a field declaration in a struct type, or a parameter
 // declaration in a function signature.
 //
@@ -122,7 +182,7 @@ func (t *Func) Equal(u Type) bool {
 		if !Equal(t.Result, u.Result) {
 			return false
 		}
-		if len(u.Params) != len(t.Params) {
+		if len(u.Params) != len(t.Params) || t.Variadic != u.Variadic {
 			return false
 		}
 		for i := range t.Params {
@@ -135,6 +195,50 @@ func (t *Func) Equal(u Type) bool {
 	return false
 }
 
+// Equal reports whether t and u are of equal type.
+func (t *Pointer) Equal(u Type) bool {
+	if u, ok := u.(*Pointer); ok {
+		return Equal(t.Elem, u.Elem)
+	}
+	return false
+}
+
+// Equal reports whether t and u are of equal type.
+//
+// Tagged structure types are equal if their tags are equal. Anonymous structure
+// types are equal if their fields are equal.
+func (t *Struct) Equal(u Type) bool {
+	if u, ok := u.(*Struct); ok {
+		if t.Tag != "" || u.Tag != "" {
+			// The fields of tagged structure types are not compared, as they may
+			// refer to the structure type itself (e.g. through a pointer).
+			return t.Tag == u.Tag
+		}
+		if len(t.Fields) != len(u.Fields) {
+			return false
+		}
+		for i := range t.Fields {
+			if t.Fields[i].Name != u.Fields[i].Name || !Equal(t.Fields[i].Type, u.Fields[i].Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Field returns the field of the structure type with the given name and its
+// index. The returned boolean variable reports whether such a field was
+// located.
+func (t *Struct) Field(name string) (*Field, int, bool) {
+	for i, field := range t.Fields {
+		if field.Name == name {
+			return field, i, true
+		}
+	}
+	return nil, 0, false
+}
+
 // Equal reports whether t and u are of equal type.
 func Equal(t, u Type) bool {
 	return t.Equal(u)
@@ -148,24 +252,172 @@ func IsVoid(t Type) bool {
 	return false
 }
 
-// IsInteger reports whether the given type is an integer (i.e. "int" or
-// "char").
+// IsIncomplete reports whether the given type is an incomplete type; i.e. void
+// or a structure type which has been declared but not defined.
+//
+// "The void type comprises an empty set of values; it is an incomplete type
+// that cannot be completed." [C99 draft 6.2.5.19]
+//
+// "A structure or union type of unknown content is an incomplete type." [C99
+// draft 6.2.5.22]
+func IsIncomplete(t Type) bool {
+	if t, ok := t.(*Struct); ok {
+		return t.Incomplete
+	}
+	return IsVoid(t)
+}
+
+// IsInvalid reports whether the given type is an invalid type. Invalid types
+// are used as placeholders for the types of erroneous declarations and
+// expressions, to prevent the same error from being reported more than once.
+func IsInvalid(t Type) bool {
+	if t, ok := t.(*Basic); ok {
+		return t.Kind == Invalid
+	}
+	return false
+}
+
+// IsInteger reports whether the given type is an integer (e.g. "char", "int"
+// or "unsigned long").
 func IsInteger(t Type) bool {
+	if t, ok := t.(*Basic); ok {
+		return Char <= t.Kind && t.Kind <= UnsignedLongLong
+	}
+	return false
+}
+
+// IsUnsigned reports whether the given type is an unsigned integer type.
+func IsUnsigned(t Type) bool {
 	if t, ok := t.(*Basic); ok {
 		switch t.Kind {
-		case Int, Char:
+		case UnsignedChar, UnsignedShort, UnsignedInt, UnsignedLong, UnsignedLongLong:
 			return true
 		}
 	}
 	return false
 }
 
+// Promote returns the type of the given type after integer promotion. Types
+// other than integer types of lower rank than int are returned unchanged.
+//
+// "If an int can represent all values of the original type, the value is
+// converted to an int; otherwise, it is converted to an unsigned int. These are
+// called the integer promotions." [C99 draft 6.3.1.1.2]
+//
+// An int can represent all values of char, unsigned char, short and unsigned
+// short.
+func Promote(t Type) Type {
+	if IsInteger(t) && rank(t.(*Basic).Kind) < rank(Int) {
+		return &Basic{Kind: Int}
+	}
+	return t
+}
+
+// ArithmeticConversion returns the common type of the given integer types, as
+// determined by the usual arithmetic conversions.
+//
+// "If both operands have the same type, then no further conversion is needed.
+//
+// Otherwise, if both operands have signed integer types or both have unsigned
+// integer types, the operand with the type of lesser integer conversion rank is
+// converted to the type of the operand with greater rank.
+//
+// Otherwise, if the operand that has unsigned integer type has rank greater or
+// equal to the rank of the type of the other operand, then the operand with
+// signed integer type is converted to the type of the operand with unsigned
+// integer type.
+//
+// Otherwise, if the type of the operand with signed integer type can represent
+// all of the values of the type of the operand with unsigned integer type, then
+// the operand with unsigned integer type is converted to the type of the
+// operand with signed integer type.
+//
+// Otherwise, both operands are converted to the unsigned integer type
+// corresponding to the type of the operand with signed integer type." [C99
+// draft 6.3.1.8.1]
+func ArithmeticConversion(t, u Type) Type {
+	t, u = Promote(t), Promote(u)
+	if !IsInteger(t) || !IsInteger(u) {
+		panic(fmt.Sprintf("invalid arithmetic conversion of non-integer type %q or %q", t, u))
+	}
+	tKind, uKind := t.(*Basic).Kind, u.(*Basic).Kind
+	if tKind == uKind {
+		return t
+	}
+	if IsUnsigned(t) == IsUnsigned(u) {
+		if rank(tKind) > rank(uKind) {
+			return t
+		}
+		return u
+	}
+	signed, unsigned := tKind, uKind
+	if IsUnsigned(t) {
+		signed, unsigned = uKind, tKind
+	}
+	switch {
+	case rank(unsigned) >= rank(signed):
+		return &Basic{Kind: unsigned}
+	case bitSize(signed) > bitSize(unsigned):
+		return &Basic{Kind: signed}
+	default:
+		// The unsigned integer type corresponding to a signed integer type
+		// directly follows it.
+		return &Basic{Kind: signed + 1}
+	}
+}
+
+// rank returns the integer conversion rank of the given integer type. Signed
+// and unsigned integer types of the same width have the same rank.
+func rank(kind BasicKind) int {
+	return int(kind-Char) / 2
+}
+
+// bitSize returns the size in bits of the given integer type, in accordance
+// with the memory layout of the target platform.
+func bitSize(kind BasicKind) int64 {
+	return 8 * DefaultSizes.Sizeof(&Basic{Kind: kind})
+}
+
+// IsPointer reports whether the given type is a pointer type.
+func IsPointer(t Type) bool {
+	_, ok := t.(*Pointer)
+	return ok
+}
+
+// IsScalar reports whether the given type is a scalar type (i.e. an integer or
+// a pointer).
+func IsScalar(t Type) bool {
+	return IsInteger(t) || IsPointer(t)
+}
+
+// Decay returns the type of an expression of the given type when used as a
+// value. Arrays decay to pointers to their first element; other types are
+// returned unchanged.
+//
+// "Except when it is the operand of the sizeof operator or the unary &
+// operator, or is a string literal used to initialize an array, an expression
+// that has type 'array of type' is converted to an expression with type
+// 'pointer to type' that points to the initial element of the array object and
+// is not an lvalue." [C99 draft 6.3.2.1.3]
+func Decay(t Type) Type {
+	if t, ok := t.(*Array); ok {
+		return &Pointer{Elem: t.Elem}
+	}
+	return t
+}
+
+// IsStruct reports whether the given type is a structure type.
+func IsStruct(t Type) bool {
+	_, ok := t.(*Struct)
+	return ok
+}
+
 // IsNumerical reports whether the given type is numerical.
 func (t *Basic) IsNumerical() bool {
-	switch t.Kind {
-	case Int, Char:
+	switch {
+	case IsInteger(t):
 		return true
-	case Void:
+	case t.Kind == Invalid, t.Kind == Void:
 		return false
 	default:
 		panic(fmt.Sprintf("types.Basic.IsNumerical: unknown basic type (%d)", int(t.Kind)))
@@ -174,9 +426,18 @@ func (t *Basic) IsNumerical() bool {
 
 func (t *Basic) String() string {
 	names := map[BasicKind]string{
-		Char: "char",
-		Int:  "int",
-		Void: "void",
+		Invalid:          "invalid type",
+		Char:             "char",
+		UnsignedChar:     "unsigned char",
+		Short:            "short",
+		UnsignedShort:    "unsigned short",
+		Int:              "int",
+		UnsignedInt:      "unsigned int",
+		Long:             "long",
+		UnsignedLong:     "unsigned long",
+		LongLong:         "long long",
+		UnsignedLongLong: "unsigned long long",
+		Void:             "void",
 	}
 	if s, ok := names[t.Kind]; ok {
 		return s
@@ -185,25 +446,66 @@ func (t *Basic) String() string {
 }
 
 func (t *Array) String() string {
-	if t.Len > 0 {
-		return fmt.Sprintf("%v[%d]", t.Elem, t.Len)
+	// The dimensions of nested arrays are printed in declaration order; e.g.
+	// int[2][3] for an array of 2 arrays of 3 integers.
+	dims := new(bytes.Buffer)
+	var elem Type = t
+	for {
+		array, ok := elem.(*Array)
+		if !ok {
+			break
+		}
+		if array.Len > 0 {
+			fmt.Fprintf(dims, "[%d]", array.Len)
+		} else {
+			dims.WriteString("[]")
+		}
+		elem = array.Elem
 	}
-	return fmt.Sprintf("%v[]", t.Elem)
+	return fmt.Sprintf("%v%v", elem, dims)
 }
 
 func (t *Func) String() string {
 	buf := new(bytes.Buffer)
 	fmt.Fprintf(buf, "%v(", t.Result)
-	for _, param := range t.Params {
+	for i, param := range t.Params {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
 		buf.WriteString(param.String())
 	}
+	if t.Variadic {
+		buf.WriteString(", ...")
+	}
 	buf.WriteString(")")
 	return buf.String()
 }
 
+func (t *Pointer) String() string {
+	return fmt.Sprintf("%v*", t.Elem)
+}
+
+func (t *Struct) String() string {
+	if len(t.Tag) > 0 {
+		return fmt.Sprintf("struct %v", t.Tag)
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString("struct {")
+	for i, field := range t.Fields {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		fmt.Fprintf(buf, "%v;", field)
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
 // Verify that the µC types implement the Type interface.
 var (
 	_ Type = &Basic{}
 	_ Type = &Array{}
 	_ Type = &Func{}
+	_ Type = &Pointer{}
+	_ Type = &Struct{}
 )